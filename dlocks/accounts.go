@@ -67,6 +67,8 @@ func (c *AccountsCore) CreateAccount(request *corepb.CreateAccountRequest) (*cor
 		CreatedAt:             request.Now,
 		UpdatedAt:             request.Now,
 		MaxNumberOfNamespaces: request.MaxNumberOfNamespaces,
+
+		MaxNumberOfLocksPerNamespace: request.MaxNumberOfLocksPerNamespace,
 	}
 
 	err = c.createAccount(txn, account)
@@ -134,13 +134,41 @@ func (a *LocksCoreAdapter) Update(request []byte) []byte {
 		r, err := a.locksCore.ReleaseLock(req.ReleaseLockRequest)
 		updateResponse.Response = &corepb.UpdateResponse_ReleaseLockResponse{ReleaseLockResponse: r}
 		updateResponse.Error = monsterax.WrapError(err)
+	case *corepb.UpdateRequest_CancelLockWaitRequest:
+		r, err := a.locksCore.CancelLockWait(req.CancelLockWaitRequest)
+		updateResponse.Response = &corepb.UpdateResponse_CancelLockWaitResponse{CancelLockWaitResponse: r}
+		updateResponse.Error = monsterax.WrapError(err)
 	case *corepb.UpdateRequest_DeleteLockRequest:
 		r, err := a.locksCore.DeleteLock(req.DeleteLockRequest)
 		updateResponse.Response = &corepb.UpdateResponse_DeleteLockResponse{DeleteLockResponse: r}
 		updateResponse.Error = monsterax.WrapError(err)
-	case *corepb.UpdateRequest_GetLockRequest:
-		r, err := a.locksCore.GetLock(req.GetLockRequest)
-		updateResponse.Response = &corepb.UpdateResponse_GetLockResponse{GetLockResponse: r}
+	case *corepb.UpdateRequest_ConvertLockRequest:
+		r, err := a.locksCore.ConvertLock(req.ConvertLockRequest)
+		updateResponse.Response = &corepb.UpdateResponse_ConvertLockResponse{ConvertLockResponse: r}
+		updateResponse.Error = monsterax.WrapError(err)
+	case *corepb.UpdateRequest_AcquireLocksRequest:
+		r, err := a.locksCore.AcquireLocks(req.AcquireLocksRequest)
+		updateResponse.Response = &corepb.UpdateResponse_AcquireLocksResponse{AcquireLocksResponse: r}
+		updateResponse.Error = monsterax.WrapError(err)
+	case *corepb.UpdateRequest_ReleaseLocksRequest:
+		r, err := a.locksCore.ReleaseLocks(req.ReleaseLocksRequest)
+		updateResponse.Response = &corepb.UpdateResponse_ReleaseLocksResponse{ReleaseLocksResponse: r}
+		updateResponse.Error = monsterax.WrapError(err)
+	case *corepb.UpdateRequest_SweepExpiredLocksRequest:
+		r, err := a.locksCore.SweepExpiredLocks(req.SweepExpiredLocksRequest)
+		updateResponse.Response = &corepb.UpdateResponse_SweepExpiredLocksResponse{SweepExpiredLocksResponse: r}
+		updateResponse.Error = monsterax.WrapError(err)
+	case *corepb.UpdateRequest_AcquirePermitsRequest:
+		r, err := a.locksCore.AcquirePermits(req.AcquirePermitsRequest)
+		updateResponse.Response = &corepb.UpdateResponse_AcquirePermitsResponse{AcquirePermitsResponse: r}
+		updateResponse.Error = monsterax.WrapError(err)
+	case *corepb.UpdateRequest_ReleasePermitsRequest:
+		r, err := a.locksCore.ReleasePermits(req.ReleasePermitsRequest)
+		updateResponse.Response = &corepb.UpdateResponse_ReleasePermitsResponse{ReleasePermitsResponse: r}
+		updateResponse.Error = monsterax.WrapError(err)
+	case *corepb.UpdateRequest_SetSemaphoreCapacityRequest:
+		r, err := a.locksCore.SetSemaphoreCapacity(req.SetSemaphoreCapacityRequest)
+		updateResponse.Response = &corepb.UpdateResponse_SetSemaphoreCapacityResponse{SetSemaphoreCapacityResponse: r}
 		updateResponse.Error = monsterax.WrapError(err)
 	default:
 		panic("no matching handlers")
@@ -154,7 +182,44 @@ func (a *LocksCoreAdapter) Update(request []byte) []byte {
 }
 
 func (a *LocksCoreAdapter) Read(request []byte) []byte {
-	panic("no matching handlers")
+	readRequest := &corepb.ReadRequest{}
+	readResponse := &corepb.ReadResponse{}
+
+	err := proto.Unmarshal(request, readRequest)
+	if err != nil {
+		panic(err)
+	}
+
+	switch req := readRequest.Request.(type) {
+	case *corepb.ReadRequest_ValidateFencingTokenRequest:
+		r, err := a.locksCore.ValidateFencingToken(req.ValidateFencingTokenRequest)
+		readResponse.Response = &corepb.ReadResponse_ValidateFencingTokenResponse{ValidateFencingTokenResponse: r}
+		readResponse.Error = monsterax.WrapError(err)
+	case *corepb.ReadRequest_ListLocksRequest:
+		r, err := a.locksCore.ListLocks(req.ListLocksRequest)
+		readResponse.Response = &corepb.ReadResponse_ListLocksResponse{ListLocksResponse: r}
+		readResponse.Error = monsterax.WrapError(err)
+	case *corepb.ReadRequest_GetLockRequest:
+		r, err := a.locksCore.GetLock(req.GetLockRequest)
+		readResponse.Response = &corepb.ReadResponse_GetLockResponse{GetLockResponse: r}
+		readResponse.Error = monsterax.WrapError(err)
+	case *corepb.ReadRequest_GetLockFromFollowerRequest:
+		r, err := a.locksCore.GetLockFromFollower(req.GetLockFromFollowerRequest)
+		readResponse.Response = &corepb.ReadResponse_GetLockFromFollowerResponse{GetLockFromFollowerResponse: r}
+		readResponse.Error = monsterax.WrapError(err)
+	case *corepb.ReadRequest_GetSemaphoreRequest:
+		r, err := a.locksCore.GetSemaphore(req.GetSemaphoreRequest)
+		readResponse.Response = &corepb.ReadResponse_GetSemaphoreResponse{GetSemaphoreResponse: r}
+		readResponse.Error = monsterax.WrapError(err)
+	default:
+		panic("no matching handlers")
+	}
+	response, err := proto.Marshal(readResponse)
+	if err != nil {
+		panic(err)
+	}
+
+	return response
 }
 
 type NamespacesCoreAdapter struct {
@@ -22,10 +22,22 @@ type LocksServiceCoreApi interface {
 	UpdateNamespace(ctx context.Context, request *corepb.UpdateNamespaceRequest) (*corepb.UpdateNamespaceResponse, error)
 	DeleteNamespace(ctx context.Context, request *corepb.DeleteNamespaceRequest) (*corepb.DeleteNamespaceResponse, error)
 
+	ValidateFencingToken(ctx context.Context, request *corepb.ValidateFencingTokenRequest) (*corepb.ValidateFencingTokenResponse, error)
+	ListLocks(ctx context.Context, request *corepb.ListLocksRequest) (*corepb.ListLocksResponse, error)
+	GetLock(ctx context.Context, request *corepb.GetLockRequest) (*corepb.GetLockResponse, error)
+	GetLockFromFollower(ctx context.Context, request *corepb.GetLockFromFollowerRequest) (*corepb.GetLockFromFollowerResponse, error)
+	GetSemaphore(ctx context.Context, request *corepb.GetSemaphoreRequest) (*corepb.GetSemaphoreResponse, error)
 	AcquireLock(ctx context.Context, request *corepb.AcquireLockRequest) (*corepb.AcquireLockResponse, error)
 	ReleaseLock(ctx context.Context, request *corepb.ReleaseLockRequest) (*corepb.ReleaseLockResponse, error)
+	CancelLockWait(ctx context.Context, request *corepb.CancelLockWaitRequest) (*corepb.CancelLockWaitResponse, error)
 	DeleteLock(ctx context.Context, request *corepb.DeleteLockRequest) (*corepb.DeleteLockResponse, error)
-	GetLock(ctx context.Context, request *corepb.GetLockRequest) (*corepb.GetLockResponse, error)
+	ConvertLock(ctx context.Context, request *corepb.ConvertLockRequest) (*corepb.ConvertLockResponse, error)
+	AcquireLocks(ctx context.Context, request *corepb.AcquireLocksRequest) (*corepb.AcquireLocksResponse, error)
+	ReleaseLocks(ctx context.Context, request *corepb.ReleaseLocksRequest) (*corepb.ReleaseLocksResponse, error)
+	SweepExpiredLocks(ctx context.Context, request *corepb.SweepExpiredLocksRequest, shardId string) (*corepb.SweepExpiredLocksResponse, error)
+	AcquirePermits(ctx context.Context, request *corepb.AcquirePermitsRequest) (*corepb.AcquirePermitsResponse, error)
+	ReleasePermits(ctx context.Context, request *corepb.ReleasePermitsRequest) (*corepb.ReleasePermitsResponse, error)
+	SetSemaphoreCapacity(ctx context.Context, request *corepb.SetSemaphoreCapacityRequest) (*corepb.SetSemaphoreCapacityResponse, error)
 }
 
 var _ LocksServiceCoreApi = &UnimplementedLocksServiceCoreApi{}
@@ -72,6 +84,26 @@ func (a *UnimplementedLocksServiceCoreApi) DeleteNamespace(ctx context.Context,
 	panic("not implemented")
 }
 
+func (a *UnimplementedLocksServiceCoreApi) ValidateFencingToken(ctx context.Context, request *corepb.ValidateFencingTokenRequest) (*corepb.ValidateFencingTokenResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLocksServiceCoreApi) ListLocks(ctx context.Context, request *corepb.ListLocksRequest) (*corepb.ListLocksResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLocksServiceCoreApi) GetLock(ctx context.Context, request *corepb.GetLockRequest) (*corepb.GetLockResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLocksServiceCoreApi) GetLockFromFollower(ctx context.Context, request *corepb.GetLockFromFollowerRequest) (*corepb.GetLockFromFollowerResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLocksServiceCoreApi) GetSemaphore(ctx context.Context, request *corepb.GetSemaphoreRequest) (*corepb.GetSemaphoreResponse, error) {
+	panic("not implemented")
+}
+
 func (a *UnimplementedLocksServiceCoreApi) AcquireLock(ctx context.Context, request *corepb.AcquireLockRequest) (*corepb.AcquireLockResponse, error) {
 	panic("not implemented")
 }
@@ -80,11 +112,39 @@ func (a *UnimplementedLocksServiceCoreApi) ReleaseLock(ctx context.Context, requ
 	panic("not implemented")
 }
 
+func (a *UnimplementedLocksServiceCoreApi) CancelLockWait(ctx context.Context, request *corepb.CancelLockWaitRequest) (*corepb.CancelLockWaitResponse, error) {
+	panic("not implemented")
+}
+
 func (a *UnimplementedLocksServiceCoreApi) DeleteLock(ctx context.Context, request *corepb.DeleteLockRequest) (*corepb.DeleteLockResponse, error) {
 	panic("not implemented")
 }
 
-func (a *UnimplementedLocksServiceCoreApi) GetLock(ctx context.Context, request *corepb.GetLockRequest) (*corepb.GetLockResponse, error) {
+func (a *UnimplementedLocksServiceCoreApi) ConvertLock(ctx context.Context, request *corepb.ConvertLockRequest) (*corepb.ConvertLockResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLocksServiceCoreApi) AcquireLocks(ctx context.Context, request *corepb.AcquireLocksRequest) (*corepb.AcquireLocksResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLocksServiceCoreApi) ReleaseLocks(ctx context.Context, request *corepb.ReleaseLocksRequest) (*corepb.ReleaseLocksResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLocksServiceCoreApi) SweepExpiredLocks(ctx context.Context, request *corepb.SweepExpiredLocksRequest, shardId string) (*corepb.SweepExpiredLocksResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLocksServiceCoreApi) AcquirePermits(ctx context.Context, request *corepb.AcquirePermitsRequest) (*corepb.AcquirePermitsResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLocksServiceCoreApi) ReleasePermits(ctx context.Context, request *corepb.ReleasePermitsRequest) (*corepb.ReleasePermitsResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLocksServiceCoreApi) SetSemaphoreCapacity(ctx context.Context, request *corepb.SetSemaphoreCapacityRequest) (*corepb.SetSemaphoreCapacityResponse, error) {
 	panic("not implemented")
 }
 
@@ -103,10 +163,22 @@ type LocksCoreApi interface {
 	Snapshot() monstera.ApplicationCoreSnapshot
 	Restore(reader io.ReadCloser) error
 	Close()
+	ValidateFencingToken(request *corepb.ValidateFencingTokenRequest) (*corepb.ValidateFencingTokenResponse, error)
+	ListLocks(request *corepb.ListLocksRequest) (*corepb.ListLocksResponse, error)
+	GetLock(request *corepb.GetLockRequest) (*corepb.GetLockResponse, error)
+	GetLockFromFollower(request *corepb.GetLockFromFollowerRequest) (*corepb.GetLockFromFollowerResponse, error)
+	GetSemaphore(request *corepb.GetSemaphoreRequest) (*corepb.GetSemaphoreResponse, error)
 	AcquireLock(request *corepb.AcquireLockRequest) (*corepb.AcquireLockResponse, error)
 	ReleaseLock(request *corepb.ReleaseLockRequest) (*corepb.ReleaseLockResponse, error)
+	CancelLockWait(request *corepb.CancelLockWaitRequest) (*corepb.CancelLockWaitResponse, error)
 	DeleteLock(request *corepb.DeleteLockRequest) (*corepb.DeleteLockResponse, error)
-	GetLock(request *corepb.GetLockRequest) (*corepb.GetLockResponse, error)
+	ConvertLock(request *corepb.ConvertLockRequest) (*corepb.ConvertLockResponse, error)
+	AcquireLocks(request *corepb.AcquireLocksRequest) (*corepb.AcquireLocksResponse, error)
+	ReleaseLocks(request *corepb.ReleaseLocksRequest) (*corepb.ReleaseLocksResponse, error)
+	SweepExpiredLocks(request *corepb.SweepExpiredLocksRequest) (*corepb.SweepExpiredLocksResponse, error)
+	AcquirePermits(request *corepb.AcquirePermitsRequest) (*corepb.AcquirePermitsResponse, error)
+	ReleasePermits(request *corepb.ReleasePermitsRequest) (*corepb.ReleasePermitsResponse, error)
+	SetSemaphoreCapacity(request *corepb.SetSemaphoreCapacityRequest) (*corepb.SetSemaphoreCapacityResponse, error)
 }
 
 type NamespacesCoreApi interface {
@@ -59,7 +59,7 @@ var scenario1Cmd = &cobra.Command{
 		resp1, err := client.AcquireLock(ctx, &gatewaypb.AcquireLockRequest{
 			NamespaceName: "my-namespace",
 			LockName:      "my-lock-1",
-			ExpiresAt:     time.Now().Add(1 * time.Minute).UnixNano(),
+			Ttl:           time.Minute.Milliseconds(),
 			WriteLock:     true,
 			ProcessId:     "process-id-1",
 		})
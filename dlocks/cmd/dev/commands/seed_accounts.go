@@ -49,6 +49,8 @@ var seedAccountsCmd = &cobra.Command{
 				Email:                 email,
 				Now:                   now.UnixNano(),
 				MaxNumberOfNamespaces: 25,
+
+				MaxNumberOfLocksPerNamespace: 1000,
 			})
 			if err != nil {
 				log.Fatalf("could not create account: %v", err)
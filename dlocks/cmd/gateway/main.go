@@ -9,6 +9,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/evrblk/monstera"
 	"github.com/evrblk/monstera-example/dlocks"
@@ -19,6 +20,7 @@ import (
 var (
 	port               = flag.Int("port", 0, "The server port")
 	monsteraConfigPath = flag.String("monstera-config", "", "Monstera cluster config path")
+	sweepInterval      = flag.Duration("sweep-interval", time.Minute, "Interval between sweeps of expired locks (0 to disable)")
 )
 
 func main() {
@@ -72,6 +74,22 @@ func main() {
 		cancel()
 	}()
 
+	// Start sweeper of expired locks on all shards of Locks
+	if *sweepInterval > 0 {
+		shards, err := monsteraClient.ListShards("Locks")
+		if err != nil {
+			log.Fatal(err)
+		}
+		shardIds := make([]string, len(shards))
+		for i, shard := range shards {
+			shardIds[i] = shard.Id
+		}
+
+		sweeper := dlocks.NewExpiredLocksSweeper(locksServiceCoreApiClient, shardIds, *sweepInterval)
+		sweeper.Start()
+		defer sweeper.Stop()
+	}
+
 	// Create and register Gateway server
 	locksServiceApiGatewayServer := dlocks.NewLocksServiceApiServer(locksServiceCoreApiClient)
 	defer locksServiceApiGatewayServer.Close()
@@ -29,6 +29,11 @@ type ReadRequest struct {
 	//	*ReadRequest_ListAccountsRequest
 	//	*ReadRequest_GetNamespaceRequest
 	//	*ReadRequest_ListNamespacesRequest
+	//	*ReadRequest_ValidateFencingTokenRequest
+	//	*ReadRequest_ListLocksRequest
+	//	*ReadRequest_GetLockRequest
+	//	*ReadRequest_GetLockFromFollowerRequest
+	//	*ReadRequest_GetSemaphoreRequest
 	Request       isReadRequest_Request `protobuf_oneof:"request"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -107,6 +112,51 @@ func (x *ReadRequest) GetListNamespacesRequest() *ListNamespacesRequest {
 	return nil
 }
 
+func (x *ReadRequest) GetValidateFencingTokenRequest() *ValidateFencingTokenRequest {
+	if x != nil {
+		if x, ok := x.Request.(*ReadRequest_ValidateFencingTokenRequest); ok {
+			return x.ValidateFencingTokenRequest
+		}
+	}
+	return nil
+}
+
+func (x *ReadRequest) GetListLocksRequest() *ListLocksRequest {
+	if x != nil {
+		if x, ok := x.Request.(*ReadRequest_ListLocksRequest); ok {
+			return x.ListLocksRequest
+		}
+	}
+	return nil
+}
+
+func (x *ReadRequest) GetGetLockRequest() *GetLockRequest {
+	if x != nil {
+		if x, ok := x.Request.(*ReadRequest_GetLockRequest); ok {
+			return x.GetLockRequest
+		}
+	}
+	return nil
+}
+
+func (x *ReadRequest) GetGetLockFromFollowerRequest() *GetLockFromFollowerRequest {
+	if x != nil {
+		if x, ok := x.Request.(*ReadRequest_GetLockFromFollowerRequest); ok {
+			return x.GetLockFromFollowerRequest
+		}
+	}
+	return nil
+}
+
+func (x *ReadRequest) GetGetSemaphoreRequest() *GetSemaphoreRequest {
+	if x != nil {
+		if x, ok := x.Request.(*ReadRequest_GetSemaphoreRequest); ok {
+			return x.GetSemaphoreRequest
+		}
+	}
+	return nil
+}
+
 type isReadRequest_Request interface {
 	isReadRequest_Request()
 }
@@ -127,6 +177,26 @@ type ReadRequest_ListNamespacesRequest struct {
 	ListNamespacesRequest *ListNamespacesRequest `protobuf:"bytes,5,opt,name=list_namespaces_request,json=listNamespacesRequest,proto3,oneof"`
 }
 
+type ReadRequest_ValidateFencingTokenRequest struct {
+	ValidateFencingTokenRequest *ValidateFencingTokenRequest `protobuf:"bytes,6,opt,name=validate_fencing_token_request,json=validateFencingTokenRequest,proto3,oneof"`
+}
+
+type ReadRequest_ListLocksRequest struct {
+	ListLocksRequest *ListLocksRequest `protobuf:"bytes,7,opt,name=list_locks_request,json=listLocksRequest,proto3,oneof"`
+}
+
+type ReadRequest_GetLockRequest struct {
+	GetLockRequest *GetLockRequest `protobuf:"bytes,8,opt,name=get_lock_request,json=getLockRequest,proto3,oneof"`
+}
+
+type ReadRequest_GetLockFromFollowerRequest struct {
+	GetLockFromFollowerRequest *GetLockFromFollowerRequest `protobuf:"bytes,9,opt,name=get_lock_from_follower_request,json=getLockFromFollowerRequest,proto3,oneof"`
+}
+
+type ReadRequest_GetSemaphoreRequest struct {
+	GetSemaphoreRequest *GetSemaphoreRequest `protobuf:"bytes,10,opt,name=get_semaphore_request,json=getSemaphoreRequest,proto3,oneof"`
+}
+
 func (*ReadRequest_GetAccountRequest) isReadRequest_Request() {}
 
 func (*ReadRequest_ListAccountsRequest) isReadRequest_Request() {}
@@ -135,6 +205,16 @@ func (*ReadRequest_GetNamespaceRequest) isReadRequest_Request() {}
 
 func (*ReadRequest_ListNamespacesRequest) isReadRequest_Request() {}
 
+func (*ReadRequest_ValidateFencingTokenRequest) isReadRequest_Request() {}
+
+func (*ReadRequest_ListLocksRequest) isReadRequest_Request() {}
+
+func (*ReadRequest_GetLockRequest) isReadRequest_Request() {}
+
+func (*ReadRequest_GetLockFromFollowerRequest) isReadRequest_Request() {}
+
+func (*ReadRequest_GetSemaphoreRequest) isReadRequest_Request() {}
+
 type ReadResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Error *x.Error               `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
@@ -144,6 +224,11 @@ type ReadResponse struct {
 	//	*ReadResponse_ListAccountsResponse
 	//	*ReadResponse_GetNamespaceResponse
 	//	*ReadResponse_ListNamespacesResponse
+	//	*ReadResponse_ValidateFencingTokenResponse
+	//	*ReadResponse_ListLocksResponse
+	//	*ReadResponse_GetLockResponse
+	//	*ReadResponse_GetLockFromFollowerResponse
+	//	*ReadResponse_GetSemaphoreResponse
 	Response      isReadResponse_Response `protobuf_oneof:"response"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -229,6 +314,51 @@ func (x *ReadResponse) GetListNamespacesResponse() *ListNamespacesResponse {
 	return nil
 }
 
+func (x *ReadResponse) GetValidateFencingTokenResponse() *ValidateFencingTokenResponse {
+	if x != nil {
+		if x, ok := x.Response.(*ReadResponse_ValidateFencingTokenResponse); ok {
+			return x.ValidateFencingTokenResponse
+		}
+	}
+	return nil
+}
+
+func (x *ReadResponse) GetListLocksResponse() *ListLocksResponse {
+	if x != nil {
+		if x, ok := x.Response.(*ReadResponse_ListLocksResponse); ok {
+			return x.ListLocksResponse
+		}
+	}
+	return nil
+}
+
+func (x *ReadResponse) GetGetLockResponse() *GetLockResponse {
+	if x != nil {
+		if x, ok := x.Response.(*ReadResponse_GetLockResponse); ok {
+			return x.GetLockResponse
+		}
+	}
+	return nil
+}
+
+func (x *ReadResponse) GetGetLockFromFollowerResponse() *GetLockFromFollowerResponse {
+	if x != nil {
+		if x, ok := x.Response.(*ReadResponse_GetLockFromFollowerResponse); ok {
+			return x.GetLockFromFollowerResponse
+		}
+	}
+	return nil
+}
+
+func (x *ReadResponse) GetGetSemaphoreResponse() *GetSemaphoreResponse {
+	if x != nil {
+		if x, ok := x.Response.(*ReadResponse_GetSemaphoreResponse); ok {
+			return x.GetSemaphoreResponse
+		}
+	}
+	return nil
+}
+
 type isReadResponse_Response interface {
 	isReadResponse_Response()
 }
@@ -249,6 +379,26 @@ type ReadResponse_ListNamespacesResponse struct {
 	ListNamespacesResponse *ListNamespacesResponse `protobuf:"bytes,5,opt,name=list_namespaces_response,json=listNamespacesResponse,proto3,oneof"`
 }
 
+type ReadResponse_ValidateFencingTokenResponse struct {
+	ValidateFencingTokenResponse *ValidateFencingTokenResponse `protobuf:"bytes,6,opt,name=validate_fencing_token_response,json=validateFencingTokenResponse,proto3,oneof"`
+}
+
+type ReadResponse_ListLocksResponse struct {
+	ListLocksResponse *ListLocksResponse `protobuf:"bytes,7,opt,name=list_locks_response,json=listLocksResponse,proto3,oneof"`
+}
+
+type ReadResponse_GetLockResponse struct {
+	GetLockResponse *GetLockResponse `protobuf:"bytes,8,opt,name=get_lock_response,json=getLockResponse,proto3,oneof"`
+}
+
+type ReadResponse_GetLockFromFollowerResponse struct {
+	GetLockFromFollowerResponse *GetLockFromFollowerResponse `protobuf:"bytes,9,opt,name=get_lock_from_follower_response,json=getLockFromFollowerResponse,proto3,oneof"`
+}
+
+type ReadResponse_GetSemaphoreResponse struct {
+	GetSemaphoreResponse *GetSemaphoreResponse `protobuf:"bytes,10,opt,name=get_semaphore_response,json=getSemaphoreResponse,proto3,oneof"`
+}
+
 func (*ReadResponse_GetAccountResponse) isReadResponse_Response() {}
 
 func (*ReadResponse_ListAccountsResponse) isReadResponse_Response() {}
@@ -257,6 +407,16 @@ func (*ReadResponse_GetNamespaceResponse) isReadResponse_Response() {}
 
 func (*ReadResponse_ListNamespacesResponse) isReadResponse_Response() {}
 
+func (*ReadResponse_ValidateFencingTokenResponse) isReadResponse_Response() {}
+
+func (*ReadResponse_ListLocksResponse) isReadResponse_Response() {}
+
+func (*ReadResponse_GetLockResponse) isReadResponse_Response() {}
+
+func (*ReadResponse_GetLockFromFollowerResponse) isReadResponse_Response() {}
+
+func (*ReadResponse_GetSemaphoreResponse) isReadResponse_Response() {}
+
 type UpdateRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Request:
@@ -264,13 +424,20 @@ type UpdateRequest struct {
 	//	*UpdateRequest_AcquireLockRequest
 	//	*UpdateRequest_ReleaseLockRequest
 	//	*UpdateRequest_DeleteLockRequest
-	//	*UpdateRequest_GetLockRequest
 	//	*UpdateRequest_CreateNamespaceRequest
 	//	*UpdateRequest_UpdateNamespaceRequest
 	//	*UpdateRequest_DeleteNamespaceRequest
 	//	*UpdateRequest_CreateAccountRequest
 	//	*UpdateRequest_UpdateAccountRequest
 	//	*UpdateRequest_DeleteAccountRequest
+	//	*UpdateRequest_SweepExpiredLocksRequest
+	//	*UpdateRequest_AcquirePermitsRequest
+	//	*UpdateRequest_ReleasePermitsRequest
+	//	*UpdateRequest_SetSemaphoreCapacityRequest
+	//	*UpdateRequest_ConvertLockRequest
+	//	*UpdateRequest_AcquireLocksRequest
+	//	*UpdateRequest_ReleaseLocksRequest
+	//	*UpdateRequest_CancelLockWaitRequest
 	Request       isUpdateRequest_Request `protobuf_oneof:"request"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -340,15 +507,6 @@ func (x *UpdateRequest) GetDeleteLockRequest() *DeleteLockRequest {
 	return nil
 }
 
-func (x *UpdateRequest) GetGetLockRequest() *GetLockRequest {
-	if x != nil {
-		if x, ok := x.Request.(*UpdateRequest_GetLockRequest); ok {
-			return x.GetLockRequest
-		}
-	}
-	return nil
-}
-
 func (x *UpdateRequest) GetCreateNamespaceRequest() *CreateNamespaceRequest {
 	if x != nil {
 		if x, ok := x.Request.(*UpdateRequest_CreateNamespaceRequest); ok {
@@ -403,6 +561,78 @@ func (x *UpdateRequest) GetDeleteAccountRequest() *DeleteAccountRequest {
 	return nil
 }
 
+func (x *UpdateRequest) GetSweepExpiredLocksRequest() *SweepExpiredLocksRequest {
+	if x != nil {
+		if x, ok := x.Request.(*UpdateRequest_SweepExpiredLocksRequest); ok {
+			return x.SweepExpiredLocksRequest
+		}
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetAcquirePermitsRequest() *AcquirePermitsRequest {
+	if x != nil {
+		if x, ok := x.Request.(*UpdateRequest_AcquirePermitsRequest); ok {
+			return x.AcquirePermitsRequest
+		}
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetReleasePermitsRequest() *ReleasePermitsRequest {
+	if x != nil {
+		if x, ok := x.Request.(*UpdateRequest_ReleasePermitsRequest); ok {
+			return x.ReleasePermitsRequest
+		}
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetSetSemaphoreCapacityRequest() *SetSemaphoreCapacityRequest {
+	if x != nil {
+		if x, ok := x.Request.(*UpdateRequest_SetSemaphoreCapacityRequest); ok {
+			return x.SetSemaphoreCapacityRequest
+		}
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetConvertLockRequest() *ConvertLockRequest {
+	if x != nil {
+		if x, ok := x.Request.(*UpdateRequest_ConvertLockRequest); ok {
+			return x.ConvertLockRequest
+		}
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetAcquireLocksRequest() *AcquireLocksRequest {
+	if x != nil {
+		if x, ok := x.Request.(*UpdateRequest_AcquireLocksRequest); ok {
+			return x.AcquireLocksRequest
+		}
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetReleaseLocksRequest() *ReleaseLocksRequest {
+	if x != nil {
+		if x, ok := x.Request.(*UpdateRequest_ReleaseLocksRequest); ok {
+			return x.ReleaseLocksRequest
+		}
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetCancelLockWaitRequest() *CancelLockWaitRequest {
+	if x != nil {
+		if x, ok := x.Request.(*UpdateRequest_CancelLockWaitRequest); ok {
+			return x.CancelLockWaitRequest
+		}
+	}
+	return nil
+}
+
 type isUpdateRequest_Request interface {
 	isUpdateRequest_Request()
 }
@@ -419,10 +649,6 @@ type UpdateRequest_DeleteLockRequest struct {
 	DeleteLockRequest *DeleteLockRequest `protobuf:"bytes,4,opt,name=delete_lock_request,json=deleteLockRequest,proto3,oneof"`
 }
 
-type UpdateRequest_GetLockRequest struct {
-	GetLockRequest *GetLockRequest `protobuf:"bytes,5,opt,name=get_lock_request,json=getLockRequest,proto3,oneof"`
-}
-
 type UpdateRequest_CreateNamespaceRequest struct {
 	CreateNamespaceRequest *CreateNamespaceRequest `protobuf:"bytes,6,opt,name=create_namespace_request,json=createNamespaceRequest,proto3,oneof"`
 }
@@ -447,14 +673,44 @@ type UpdateRequest_DeleteAccountRequest struct {
 	DeleteAccountRequest *DeleteAccountRequest `protobuf:"bytes,11,opt,name=delete_account_request,json=deleteAccountRequest,proto3,oneof"`
 }
 
+type UpdateRequest_SweepExpiredLocksRequest struct {
+	SweepExpiredLocksRequest *SweepExpiredLocksRequest `protobuf:"bytes,12,opt,name=sweep_expired_locks_request,json=sweepExpiredLocksRequest,proto3,oneof"`
+}
+
+type UpdateRequest_AcquirePermitsRequest struct {
+	AcquirePermitsRequest *AcquirePermitsRequest `protobuf:"bytes,13,opt,name=acquire_permits_request,json=acquirePermitsRequest,proto3,oneof"`
+}
+
+type UpdateRequest_ReleasePermitsRequest struct {
+	ReleasePermitsRequest *ReleasePermitsRequest `protobuf:"bytes,14,opt,name=release_permits_request,json=releasePermitsRequest,proto3,oneof"`
+}
+
+type UpdateRequest_SetSemaphoreCapacityRequest struct {
+	SetSemaphoreCapacityRequest *SetSemaphoreCapacityRequest `protobuf:"bytes,15,opt,name=set_semaphore_capacity_request,json=setSemaphoreCapacityRequest,proto3,oneof"`
+}
+
+type UpdateRequest_ConvertLockRequest struct {
+	ConvertLockRequest *ConvertLockRequest `protobuf:"bytes,16,opt,name=convert_lock_request,json=convertLockRequest,proto3,oneof"`
+}
+
+type UpdateRequest_AcquireLocksRequest struct {
+	AcquireLocksRequest *AcquireLocksRequest `protobuf:"bytes,17,opt,name=acquire_locks_request,json=acquireLocksRequest,proto3,oneof"`
+}
+
+type UpdateRequest_ReleaseLocksRequest struct {
+	ReleaseLocksRequest *ReleaseLocksRequest `protobuf:"bytes,18,opt,name=release_locks_request,json=releaseLocksRequest,proto3,oneof"`
+}
+
+type UpdateRequest_CancelLockWaitRequest struct {
+	CancelLockWaitRequest *CancelLockWaitRequest `protobuf:"bytes,19,opt,name=cancel_lock_wait_request,json=cancelLockWaitRequest,proto3,oneof"`
+}
+
 func (*UpdateRequest_AcquireLockRequest) isUpdateRequest_Request() {}
 
 func (*UpdateRequest_ReleaseLockRequest) isUpdateRequest_Request() {}
 
 func (*UpdateRequest_DeleteLockRequest) isUpdateRequest_Request() {}
 
-func (*UpdateRequest_GetLockRequest) isUpdateRequest_Request() {}
-
 func (*UpdateRequest_CreateNamespaceRequest) isUpdateRequest_Request() {}
 
 func (*UpdateRequest_UpdateNamespaceRequest) isUpdateRequest_Request() {}
@@ -467,6 +723,22 @@ func (*UpdateRequest_UpdateAccountRequest) isUpdateRequest_Request() {}
 
 func (*UpdateRequest_DeleteAccountRequest) isUpdateRequest_Request() {}
 
+func (*UpdateRequest_SweepExpiredLocksRequest) isUpdateRequest_Request() {}
+
+func (*UpdateRequest_AcquirePermitsRequest) isUpdateRequest_Request() {}
+
+func (*UpdateRequest_ReleasePermitsRequest) isUpdateRequest_Request() {}
+
+func (*UpdateRequest_SetSemaphoreCapacityRequest) isUpdateRequest_Request() {}
+
+func (*UpdateRequest_ConvertLockRequest) isUpdateRequest_Request() {}
+
+func (*UpdateRequest_AcquireLocksRequest) isUpdateRequest_Request() {}
+
+func (*UpdateRequest_ReleaseLocksRequest) isUpdateRequest_Request() {}
+
+func (*UpdateRequest_CancelLockWaitRequest) isUpdateRequest_Request() {}
+
 type UpdateResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Error *x.Error               `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
@@ -475,13 +747,20 @@ type UpdateResponse struct {
 	//	*UpdateResponse_AcquireLockResponse
 	//	*UpdateResponse_ReleaseLockResponse
 	//	*UpdateResponse_DeleteLockResponse
-	//	*UpdateResponse_GetLockResponse
 	//	*UpdateResponse_CreateNamespaceResponse
 	//	*UpdateResponse_UpdateNamespaceResponse
 	//	*UpdateResponse_DeleteNamespaceResponse
 	//	*UpdateResponse_CreateAccountResponse
 	//	*UpdateResponse_UpdateAccountResponse
 	//	*UpdateResponse_DeleteAccountResponse
+	//	*UpdateResponse_SweepExpiredLocksResponse
+	//	*UpdateResponse_AcquirePermitsResponse
+	//	*UpdateResponse_ReleasePermitsResponse
+	//	*UpdateResponse_SetSemaphoreCapacityResponse
+	//	*UpdateResponse_ConvertLockResponse
+	//	*UpdateResponse_AcquireLocksResponse
+	//	*UpdateResponse_ReleaseLocksResponse
+	//	*UpdateResponse_CancelLockWaitResponse
 	Response      isUpdateResponse_Response `protobuf_oneof:"response"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -558,15 +837,6 @@ func (x *UpdateResponse) GetDeleteLockResponse() *DeleteLockResponse {
 	return nil
 }
 
-func (x *UpdateResponse) GetGetLockResponse() *GetLockResponse {
-	if x != nil {
-		if x, ok := x.Response.(*UpdateResponse_GetLockResponse); ok {
-			return x.GetLockResponse
-		}
-	}
-	return nil
-}
-
 func (x *UpdateResponse) GetCreateNamespaceResponse() *CreateNamespaceResponse {
 	if x != nil {
 		if x, ok := x.Response.(*UpdateResponse_CreateNamespaceResponse); ok {
@@ -621,6 +891,78 @@ func (x *UpdateResponse) GetDeleteAccountResponse() *DeleteAccountResponse {
 	return nil
 }
 
+func (x *UpdateResponse) GetSweepExpiredLocksResponse() *SweepExpiredLocksResponse {
+	if x != nil {
+		if x, ok := x.Response.(*UpdateResponse_SweepExpiredLocksResponse); ok {
+			return x.SweepExpiredLocksResponse
+		}
+	}
+	return nil
+}
+
+func (x *UpdateResponse) GetAcquirePermitsResponse() *AcquirePermitsResponse {
+	if x != nil {
+		if x, ok := x.Response.(*UpdateResponse_AcquirePermitsResponse); ok {
+			return x.AcquirePermitsResponse
+		}
+	}
+	return nil
+}
+
+func (x *UpdateResponse) GetReleasePermitsResponse() *ReleasePermitsResponse {
+	if x != nil {
+		if x, ok := x.Response.(*UpdateResponse_ReleasePermitsResponse); ok {
+			return x.ReleasePermitsResponse
+		}
+	}
+	return nil
+}
+
+func (x *UpdateResponse) GetSetSemaphoreCapacityResponse() *SetSemaphoreCapacityResponse {
+	if x != nil {
+		if x, ok := x.Response.(*UpdateResponse_SetSemaphoreCapacityResponse); ok {
+			return x.SetSemaphoreCapacityResponse
+		}
+	}
+	return nil
+}
+
+func (x *UpdateResponse) GetConvertLockResponse() *ConvertLockResponse {
+	if x != nil {
+		if x, ok := x.Response.(*UpdateResponse_ConvertLockResponse); ok {
+			return x.ConvertLockResponse
+		}
+	}
+	return nil
+}
+
+func (x *UpdateResponse) GetAcquireLocksResponse() *AcquireLocksResponse {
+	if x != nil {
+		if x, ok := x.Response.(*UpdateResponse_AcquireLocksResponse); ok {
+			return x.AcquireLocksResponse
+		}
+	}
+	return nil
+}
+
+func (x *UpdateResponse) GetReleaseLocksResponse() *ReleaseLocksResponse {
+	if x != nil {
+		if x, ok := x.Response.(*UpdateResponse_ReleaseLocksResponse); ok {
+			return x.ReleaseLocksResponse
+		}
+	}
+	return nil
+}
+
+func (x *UpdateResponse) GetCancelLockWaitResponse() *CancelLockWaitResponse {
+	if x != nil {
+		if x, ok := x.Response.(*UpdateResponse_CancelLockWaitResponse); ok {
+			return x.CancelLockWaitResponse
+		}
+	}
+	return nil
+}
+
 type isUpdateResponse_Response interface {
 	isUpdateResponse_Response()
 }
@@ -637,10 +979,6 @@ type UpdateResponse_DeleteLockResponse struct {
 	DeleteLockResponse *DeleteLockResponse `protobuf:"bytes,4,opt,name=delete_lock_response,json=deleteLockResponse,proto3,oneof"`
 }
 
-type UpdateResponse_GetLockResponse struct {
-	GetLockResponse *GetLockResponse `protobuf:"bytes,5,opt,name=get_lock_response,json=getLockResponse,proto3,oneof"`
-}
-
 type UpdateResponse_CreateNamespaceResponse struct {
 	CreateNamespaceResponse *CreateNamespaceResponse `protobuf:"bytes,6,opt,name=create_namespace_response,json=createNamespaceResponse,proto3,oneof"`
 }
@@ -665,14 +1003,44 @@ type UpdateResponse_DeleteAccountResponse struct {
 	DeleteAccountResponse *DeleteAccountResponse `protobuf:"bytes,11,opt,name=delete_account_response,json=deleteAccountResponse,proto3,oneof"`
 }
 
+type UpdateResponse_SweepExpiredLocksResponse struct {
+	SweepExpiredLocksResponse *SweepExpiredLocksResponse `protobuf:"bytes,12,opt,name=sweep_expired_locks_response,json=sweepExpiredLocksResponse,proto3,oneof"`
+}
+
+type UpdateResponse_AcquirePermitsResponse struct {
+	AcquirePermitsResponse *AcquirePermitsResponse `protobuf:"bytes,13,opt,name=acquire_permits_response,json=acquirePermitsResponse,proto3,oneof"`
+}
+
+type UpdateResponse_ReleasePermitsResponse struct {
+	ReleasePermitsResponse *ReleasePermitsResponse `protobuf:"bytes,14,opt,name=release_permits_response,json=releasePermitsResponse,proto3,oneof"`
+}
+
+type UpdateResponse_SetSemaphoreCapacityResponse struct {
+	SetSemaphoreCapacityResponse *SetSemaphoreCapacityResponse `protobuf:"bytes,15,opt,name=set_semaphore_capacity_response,json=setSemaphoreCapacityResponse,proto3,oneof"`
+}
+
+type UpdateResponse_ConvertLockResponse struct {
+	ConvertLockResponse *ConvertLockResponse `protobuf:"bytes,16,opt,name=convert_lock_response,json=convertLockResponse,proto3,oneof"`
+}
+
+type UpdateResponse_AcquireLocksResponse struct {
+	AcquireLocksResponse *AcquireLocksResponse `protobuf:"bytes,17,opt,name=acquire_locks_response,json=acquireLocksResponse,proto3,oneof"`
+}
+
+type UpdateResponse_ReleaseLocksResponse struct {
+	ReleaseLocksResponse *ReleaseLocksResponse `protobuf:"bytes,18,opt,name=release_locks_response,json=releaseLocksResponse,proto3,oneof"`
+}
+
+type UpdateResponse_CancelLockWaitResponse struct {
+	CancelLockWaitResponse *CancelLockWaitResponse `protobuf:"bytes,19,opt,name=cancel_lock_wait_response,json=cancelLockWaitResponse,proto3,oneof"`
+}
+
 func (*UpdateResponse_AcquireLockResponse) isUpdateResponse_Response() {}
 
 func (*UpdateResponse_ReleaseLockResponse) isUpdateResponse_Response() {}
 
 func (*UpdateResponse_DeleteLockResponse) isUpdateResponse_Response() {}
 
-func (*UpdateResponse_GetLockResponse) isUpdateResponse_Response() {}
-
 func (*UpdateResponse_CreateNamespaceResponse) isUpdateResponse_Response() {}
 
 func (*UpdateResponse_UpdateNamespaceResponse) isUpdateResponse_Response() {}
@@ -685,6 +1053,22 @@ func (*UpdateResponse_UpdateAccountResponse) isUpdateResponse_Response() {}
 
 func (*UpdateResponse_DeleteAccountResponse) isUpdateResponse_Response() {}
 
+func (*UpdateResponse_SweepExpiredLocksResponse) isUpdateResponse_Response() {}
+
+func (*UpdateResponse_AcquirePermitsResponse) isUpdateResponse_Response() {}
+
+func (*UpdateResponse_ReleasePermitsResponse) isUpdateResponse_Response() {}
+
+func (*UpdateResponse_SetSemaphoreCapacityResponse) isUpdateResponse_Response() {}
+
+func (*UpdateResponse_ConvertLockResponse) isUpdateResponse_Response() {}
+
+func (*UpdateResponse_AcquireLocksResponse) isUpdateResponse_Response() {}
+
+func (*UpdateResponse_ReleaseLocksResponse) isUpdateResponse_Response() {}
+
+func (*UpdateResponse_CancelLockWaitResponse) isUpdateResponse_Response() {}
+
 var File_corepb_cloud_proto protoreflect.FileDescriptor
 
 var file_corepb_cloud_proto_rawDesc = []byte{
@@ -694,7 +1078,7 @@ var file_corepb_cloud_proto_rawDesc = []byte{
 	0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x1a,
 	0x10, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2f, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x0e, 0x78, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xf6, 0x03, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x6f, 0x22, 0xde, 0x08, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x6e, 0x0a, 0x13, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
 	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c,
 	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73,
@@ -724,206 +1108,399 @@ var file_corepb_cloud_proto_rawDesc = []byte{
 	0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e,
 	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x48, 0x00, 0x52, 0x15, 0x6c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0xba, 0x04, 0x0a, 0x0c, 0x52,
-	0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61,
-	0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x78, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x71, 0x0a, 0x14, 0x67, 0x65, 0x74, 0x5f, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x8d, 0x01, 0x0a, 0x1e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x65, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x46, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e,
+	0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x46, 0x65, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x1b, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x46, 0x65, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x6b, 0x0a, 0x12, 0x6c, 0x69, 0x73,
+	0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62,
 	0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d,
 	0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70,
-	0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x12, 0x67, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x16, 0x6c, 0x69,
-	0x73, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x63, 0x6f, 0x6d,
+	0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x10, 0x6c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x65, 0x0a, 0x10, 0x67, 0x65, 0x74, 0x5f, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x39, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f,
+	0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x67,
+	0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x8b, 0x01,
+	0x0a, 0x1e, 0x67, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
+	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72,
+	0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
+	0x1a, 0x67, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x74, 0x0a, 0x15, 0x67,
+	0x65, 0x74, 0x5f, 0x73, 0x65, 0x6d, 0x61, 0x70, 0x68, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x63, 0x6f, 0x6d,
 	0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61,
 	0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x14, 0x6c,
+	0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6d, 0x61, 0x70, 0x68,
+	0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x13, 0x67, 0x65,
+	0x74, 0x53, 0x65, 0x6d, 0x61, 0x70, 0x68, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x42, 0x09, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4a, 0x04, 0x08, 0x01,
+	0x10, 0x02, 0x22, 0xb1, 0x09, 0x0a, 0x0c, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e,
+	0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72,
+	0x61, 0x78, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x71, 0x0a, 0x14, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74,
+	0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x12,
+	0x67, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x77, 0x0a, 0x16, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e,
+	0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x4c,
 	0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x16, 0x67, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b,
+	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x14, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x16, 0x67,
+	0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72,
+	0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x14,
+	0x67, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x18, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72,
+	0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x16, 0x6c, 0x69, 0x73,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x90, 0x01, 0x0a, 0x1f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x5f, 0x66, 0x65, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x47, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74,
+	0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x46, 0x65, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x1c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x46, 0x65, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x13, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b,
 	0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
 	0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x14, 0x67, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x18,
-	0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x5f,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73,
-	0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f,
-	0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x48, 0x00, 0x52, 0x16, 0x6c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc5, 0x09, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x71, 0x0a, 0x14, 0x61, 0x63, 0x71,
-	0x75, 0x69, 0x72, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76,
-	0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x12, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72,
-	0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x71, 0x0a, 0x14,
-	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x48, 0x00, 0x52, 0x11, 0x6c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x11, 0x67, 0x65, 0x74, 0x5f, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x3a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d,
+	0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
+	0x0f, 0x67, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x8e, 0x01, 0x0a, 0x1f, 0x67, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x66, 0x72,
+	0x6f, 0x6d, 0x5f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x63, 0x6f, 0x6d,
 	0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61,
 	0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x6f,
-	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x12, 0x72, 0x65, 0x6c,
-	0x65, 0x61, 0x73, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x6e, 0x0a, 0x13, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65,
-	0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c,
-	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x11, 0x64, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x65, 0x0a, 0x10, 0x67, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x46, 0x72,
+	0x6f, 0x6d, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x1b, 0x67, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x46, 0x72, 0x6f,
+	0x6d, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x77, 0x0a, 0x16, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x65, 0x6d, 0x61, 0x70, 0x68, 0x6f,
+	0x72, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x3f, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d,
+	0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x6d, 0x61, 0x70, 0x68, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x14, 0x67, 0x65, 0x74, 0x53, 0x65, 0x6d, 0x61, 0x70, 0x68, 0x6f,
+	0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xcf, 0x10, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x71, 0x0a, 0x14, 0x61, 0x63, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72,
+	0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x70, 0x62, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x12, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x71, 0x0a, 0x14, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
 	0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f,
 	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x67, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7d, 0x0a, 0x18, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65,
-	0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x16, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7d, 0x0a, 0x18, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76,
+	0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x12, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x6e,
+	0x0a, 0x13, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72,
+	0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x11, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7d,
+	0x0a, 0x18, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x41, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f,
+	0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x16, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7d, 0x0a,
+	0x18, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x41, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7d, 0x0a, 0x18,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73,
+	0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x16, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x77, 0x0a, 0x16, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72,
+	0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x14,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x77, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c,
+	0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x14, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x77, 0x0a,
+	0x16, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74,
+	0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x14, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x84, 0x01, 0x0a, 0x1b, 0x73, 0x77, 0x65, 0x65, 0x70,
+	0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65,
+	0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x53, 0x77, 0x65, 0x65, 0x70, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x18, 0x73, 0x77, 0x65, 0x65, 0x70, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7a, 0x0a,
+	0x17, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x73,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73,
+	0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x15, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7a, 0x0a, 0x17, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61,
+	0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x15,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x8d, 0x01, 0x0a, 0x1e, 0x73, 0x65, 0x74, 0x5f, 0x73, 0x65,
+	0x6d, 0x61, 0x70, 0x68, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x46,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73,
+	0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x65,
+	0x6d, 0x61, 0x70, 0x68, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x1b, 0x73, 0x65, 0x74, 0x53, 0x65, 0x6d,
+	0x61, 0x70, 0x68, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x71, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x4c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x74, 0x0a, 0x15, 0x61, 0x63, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76,
 	0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78,
 	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x16, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x7d, 0x0a, 0x18, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72,
+	0x65, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x13, 0x61, 0x63, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x74,
+	0x0a, 0x15, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74,
+	0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
+	0x13, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x7b, 0x0a, 0x18, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72,
 	0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61,
 	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x16, 0x64, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x77, 0x0a, 0x16, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b,
-	0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x77, 0x0a, 0x16,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x63,
+	0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4c, 0x6f, 0x63, 0x6b, 0x57, 0x61, 0x69,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x15, 0x63, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x4c, 0x6f, 0x63, 0x6b, 0x57, 0x61, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x42, 0x09, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4a, 0x04, 0x08, 0x01,
+	0x10, 0x02, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x22, 0xbd, 0x11, 0x0a, 0x0e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x78, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x74, 0x0a, 0x15, 0x61, 0x63, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72,
+	0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x70, 0x62, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x13, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a,
+	0x15, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x63,
 	0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65,
 	0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
-	0x14, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x77, 0x0a, 0x16, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62,
-	0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d,
-	0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70,
-	0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x14, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x09,
-	0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22,
-	0x9e, 0x0a, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d,
-	0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61,
-	0x78, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x74,
-	0x0a, 0x15, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x13,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x14, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x3d, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d,
+	0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x00, 0x52, 0x12, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x19, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61,
+	0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00,
+	0x52, 0x17, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x19, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e,
 	0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74,
 	0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63,
-	0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72,
-	0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
-	0x13, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x15, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b,
-	0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e,
-	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x13, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x6f,
-	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x14, 0x64, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65,
-	0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x12, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a,
-	0x11, 0x67, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65,
+	0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x48, 0x00, 0x52, 0x17, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a,
+	0x19, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x42, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f,
+	0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x17, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x7a, 0x0a, 0x17, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x40, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f,
+	0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x15, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x17, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65,
+	0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00,
+	0x52, 0x15, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x17, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65,
 	0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65,
 	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x67, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x19, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72,
-	0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
-	0x00, 0x52, 0x17, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x19, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42,
+	0x72, 0x65, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x15, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x87, 0x01, 0x0a, 0x1c, 0x73, 0x77, 0x65, 0x65, 0x70, 0x5f, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61,
+	0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x53, 0x77, 0x65, 0x65, 0x70, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x00, 0x52, 0x19, 0x73, 0x77, 0x65, 0x65, 0x70, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64,
+	0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a,
+	0x18, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x73,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x41, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x16, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x18,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x73, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41,
 	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73,
 	0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f,
-	0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x48, 0x00, 0x52, 0x17, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01,
-	0x0a, 0x19, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x42, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d,
-	0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
-	0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x17, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x7a, 0x0a, 0x17, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x40, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d,
-	0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
-	0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x15, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x17,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e,
+	0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x48, 0x00, 0x52, 0x16, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x90, 0x01, 0x0a, 0x1f,
+	0x73, 0x65, 0x74, 0x5f, 0x73, 0x65, 0x6d, 0x61, 0x70, 0x68, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62,
+	0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70,
+	0x62, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x65, 0x6d, 0x61, 0x70, 0x68, 0x6f, 0x72, 0x65, 0x43, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00,
+	0x52, 0x1c, 0x73, 0x65, 0x74, 0x53, 0x65, 0x6d, 0x61, 0x70, 0x68, 0x6f, 0x72, 0x65, 0x43, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74,
+	0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e,
 	0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74,
 	0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63,
-	0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
-	0x00, 0x52, 0x15, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x17, 0x64, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
+	0x13, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x16, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c,
+	0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62,
+	0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x14, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a,
+	0x16, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74,
+	0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00,
+	0x52, 0x14, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7e, 0x0a, 0x19, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
 	0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f,
 	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x15, 0x64,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65,
-	0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2f, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x2d, 0x65,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2f, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2f, 0x63, 0x6f,
-	0x72, 0x65, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4c, 0x6f, 0x63, 0x6b,
+	0x57, 0x61, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x16,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4c, 0x6f, 0x63, 0x6b, 0x57, 0x61, 0x69, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2f, 0x6d, 0x6f,
+	0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x2d, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2f, 0x64,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -940,76 +1517,124 @@ func file_corepb_cloud_proto_rawDescGZIP() []byte {
 
 var file_corepb_cloud_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_corepb_cloud_proto_goTypes = []any{
-	(*ReadRequest)(nil),             // 0: com.evrblk.monstera_example.dlocks.corepb.ReadRequest
-	(*ReadResponse)(nil),            // 1: com.evrblk.monstera_example.dlocks.corepb.ReadResponse
-	(*UpdateRequest)(nil),           // 2: com.evrblk.monstera_example.dlocks.corepb.UpdateRequest
-	(*UpdateResponse)(nil),          // 3: com.evrblk.monstera_example.dlocks.corepb.UpdateResponse
-	(*GetAccountRequest)(nil),       // 4: com.evrblk.monstera_example.dlocks.corepb.GetAccountRequest
-	(*ListAccountsRequest)(nil),     // 5: com.evrblk.monstera_example.dlocks.corepb.ListAccountsRequest
-	(*GetNamespaceRequest)(nil),     // 6: com.evrblk.monstera_example.dlocks.corepb.GetNamespaceRequest
-	(*ListNamespacesRequest)(nil),   // 7: com.evrblk.monstera_example.dlocks.corepb.ListNamespacesRequest
-	(*x.Error)(nil),                 // 8: com.evrblk.monstera.monsterax.Error
-	(*GetAccountResponse)(nil),      // 9: com.evrblk.monstera_example.dlocks.corepb.GetAccountResponse
-	(*ListAccountsResponse)(nil),    // 10: com.evrblk.monstera_example.dlocks.corepb.ListAccountsResponse
-	(*GetNamespaceResponse)(nil),    // 11: com.evrblk.monstera_example.dlocks.corepb.GetNamespaceResponse
-	(*ListNamespacesResponse)(nil),  // 12: com.evrblk.monstera_example.dlocks.corepb.ListNamespacesResponse
-	(*AcquireLockRequest)(nil),      // 13: com.evrblk.monstera_example.dlocks.corepb.AcquireLockRequest
-	(*ReleaseLockRequest)(nil),      // 14: com.evrblk.monstera_example.dlocks.corepb.ReleaseLockRequest
-	(*DeleteLockRequest)(nil),       // 15: com.evrblk.monstera_example.dlocks.corepb.DeleteLockRequest
-	(*GetLockRequest)(nil),          // 16: com.evrblk.monstera_example.dlocks.corepb.GetLockRequest
-	(*CreateNamespaceRequest)(nil),  // 17: com.evrblk.monstera_example.dlocks.corepb.CreateNamespaceRequest
-	(*UpdateNamespaceRequest)(nil),  // 18: com.evrblk.monstera_example.dlocks.corepb.UpdateNamespaceRequest
-	(*DeleteNamespaceRequest)(nil),  // 19: com.evrblk.monstera_example.dlocks.corepb.DeleteNamespaceRequest
-	(*CreateAccountRequest)(nil),    // 20: com.evrblk.monstera_example.dlocks.corepb.CreateAccountRequest
-	(*UpdateAccountRequest)(nil),    // 21: com.evrblk.monstera_example.dlocks.corepb.UpdateAccountRequest
-	(*DeleteAccountRequest)(nil),    // 22: com.evrblk.monstera_example.dlocks.corepb.DeleteAccountRequest
-	(*AcquireLockResponse)(nil),     // 23: com.evrblk.monstera_example.dlocks.corepb.AcquireLockResponse
-	(*ReleaseLockResponse)(nil),     // 24: com.evrblk.monstera_example.dlocks.corepb.ReleaseLockResponse
-	(*DeleteLockResponse)(nil),      // 25: com.evrblk.monstera_example.dlocks.corepb.DeleteLockResponse
-	(*GetLockResponse)(nil),         // 26: com.evrblk.monstera_example.dlocks.corepb.GetLockResponse
-	(*CreateNamespaceResponse)(nil), // 27: com.evrblk.monstera_example.dlocks.corepb.CreateNamespaceResponse
-	(*UpdateNamespaceResponse)(nil), // 28: com.evrblk.monstera_example.dlocks.corepb.UpdateNamespaceResponse
-	(*DeleteNamespaceResponse)(nil), // 29: com.evrblk.monstera_example.dlocks.corepb.DeleteNamespaceResponse
-	(*CreateAccountResponse)(nil),   // 30: com.evrblk.monstera_example.dlocks.corepb.CreateAccountResponse
-	(*UpdateAccountResponse)(nil),   // 31: com.evrblk.monstera_example.dlocks.corepb.UpdateAccountResponse
-	(*DeleteAccountResponse)(nil),   // 32: com.evrblk.monstera_example.dlocks.corepb.DeleteAccountResponse
+	(*ReadRequest)(nil),                  // 0: com.evrblk.monstera_example.dlocks.corepb.ReadRequest
+	(*ReadResponse)(nil),                 // 1: com.evrblk.monstera_example.dlocks.corepb.ReadResponse
+	(*UpdateRequest)(nil),                // 2: com.evrblk.monstera_example.dlocks.corepb.UpdateRequest
+	(*UpdateResponse)(nil),               // 3: com.evrblk.monstera_example.dlocks.corepb.UpdateResponse
+	(*GetAccountRequest)(nil),            // 4: com.evrblk.monstera_example.dlocks.corepb.GetAccountRequest
+	(*ListAccountsRequest)(nil),          // 5: com.evrblk.monstera_example.dlocks.corepb.ListAccountsRequest
+	(*GetNamespaceRequest)(nil),          // 6: com.evrblk.monstera_example.dlocks.corepb.GetNamespaceRequest
+	(*ListNamespacesRequest)(nil),        // 7: com.evrblk.monstera_example.dlocks.corepb.ListNamespacesRequest
+	(*ValidateFencingTokenRequest)(nil),  // 8: com.evrblk.monstera_example.dlocks.corepb.ValidateFencingTokenRequest
+	(*ListLocksRequest)(nil),             // 9: com.evrblk.monstera_example.dlocks.corepb.ListLocksRequest
+	(*GetLockRequest)(nil),               // 10: com.evrblk.monstera_example.dlocks.corepb.GetLockRequest
+	(*GetLockFromFollowerRequest)(nil),   // 11: com.evrblk.monstera_example.dlocks.corepb.GetLockFromFollowerRequest
+	(*GetSemaphoreRequest)(nil),          // 12: com.evrblk.monstera_example.dlocks.corepb.GetSemaphoreRequest
+	(*x.Error)(nil),                      // 13: com.evrblk.monstera.monsterax.Error
+	(*GetAccountResponse)(nil),           // 14: com.evrblk.monstera_example.dlocks.corepb.GetAccountResponse
+	(*ListAccountsResponse)(nil),         // 15: com.evrblk.monstera_example.dlocks.corepb.ListAccountsResponse
+	(*GetNamespaceResponse)(nil),         // 16: com.evrblk.monstera_example.dlocks.corepb.GetNamespaceResponse
+	(*ListNamespacesResponse)(nil),       // 17: com.evrblk.monstera_example.dlocks.corepb.ListNamespacesResponse
+	(*ValidateFencingTokenResponse)(nil), // 18: com.evrblk.monstera_example.dlocks.corepb.ValidateFencingTokenResponse
+	(*ListLocksResponse)(nil),            // 19: com.evrblk.monstera_example.dlocks.corepb.ListLocksResponse
+	(*GetLockResponse)(nil),              // 20: com.evrblk.monstera_example.dlocks.corepb.GetLockResponse
+	(*GetLockFromFollowerResponse)(nil),  // 21: com.evrblk.monstera_example.dlocks.corepb.GetLockFromFollowerResponse
+	(*GetSemaphoreResponse)(nil),         // 22: com.evrblk.monstera_example.dlocks.corepb.GetSemaphoreResponse
+	(*AcquireLockRequest)(nil),           // 23: com.evrblk.monstera_example.dlocks.corepb.AcquireLockRequest
+	(*ReleaseLockRequest)(nil),           // 24: com.evrblk.monstera_example.dlocks.corepb.ReleaseLockRequest
+	(*DeleteLockRequest)(nil),            // 25: com.evrblk.monstera_example.dlocks.corepb.DeleteLockRequest
+	(*CreateNamespaceRequest)(nil),       // 26: com.evrblk.monstera_example.dlocks.corepb.CreateNamespaceRequest
+	(*UpdateNamespaceRequest)(nil),       // 27: com.evrblk.monstera_example.dlocks.corepb.UpdateNamespaceRequest
+	(*DeleteNamespaceRequest)(nil),       // 28: com.evrblk.monstera_example.dlocks.corepb.DeleteNamespaceRequest
+	(*CreateAccountRequest)(nil),         // 29: com.evrblk.monstera_example.dlocks.corepb.CreateAccountRequest
+	(*UpdateAccountRequest)(nil),         // 30: com.evrblk.monstera_example.dlocks.corepb.UpdateAccountRequest
+	(*DeleteAccountRequest)(nil),         // 31: com.evrblk.monstera_example.dlocks.corepb.DeleteAccountRequest
+	(*SweepExpiredLocksRequest)(nil),     // 32: com.evrblk.monstera_example.dlocks.corepb.SweepExpiredLocksRequest
+	(*AcquirePermitsRequest)(nil),        // 33: com.evrblk.monstera_example.dlocks.corepb.AcquirePermitsRequest
+	(*ReleasePermitsRequest)(nil),        // 34: com.evrblk.monstera_example.dlocks.corepb.ReleasePermitsRequest
+	(*SetSemaphoreCapacityRequest)(nil),  // 35: com.evrblk.monstera_example.dlocks.corepb.SetSemaphoreCapacityRequest
+	(*ConvertLockRequest)(nil),           // 36: com.evrblk.monstera_example.dlocks.corepb.ConvertLockRequest
+	(*AcquireLocksRequest)(nil),          // 37: com.evrblk.monstera_example.dlocks.corepb.AcquireLocksRequest
+	(*ReleaseLocksRequest)(nil),          // 38: com.evrblk.monstera_example.dlocks.corepb.ReleaseLocksRequest
+	(*CancelLockWaitRequest)(nil),        // 39: com.evrblk.monstera_example.dlocks.corepb.CancelLockWaitRequest
+	(*AcquireLockResponse)(nil),          // 40: com.evrblk.monstera_example.dlocks.corepb.AcquireLockResponse
+	(*ReleaseLockResponse)(nil),          // 41: com.evrblk.monstera_example.dlocks.corepb.ReleaseLockResponse
+	(*DeleteLockResponse)(nil),           // 42: com.evrblk.monstera_example.dlocks.corepb.DeleteLockResponse
+	(*CreateNamespaceResponse)(nil),      // 43: com.evrblk.monstera_example.dlocks.corepb.CreateNamespaceResponse
+	(*UpdateNamespaceResponse)(nil),      // 44: com.evrblk.monstera_example.dlocks.corepb.UpdateNamespaceResponse
+	(*DeleteNamespaceResponse)(nil),      // 45: com.evrblk.monstera_example.dlocks.corepb.DeleteNamespaceResponse
+	(*CreateAccountResponse)(nil),        // 46: com.evrblk.monstera_example.dlocks.corepb.CreateAccountResponse
+	(*UpdateAccountResponse)(nil),        // 47: com.evrblk.monstera_example.dlocks.corepb.UpdateAccountResponse
+	(*DeleteAccountResponse)(nil),        // 48: com.evrblk.monstera_example.dlocks.corepb.DeleteAccountResponse
+	(*SweepExpiredLocksResponse)(nil),    // 49: com.evrblk.monstera_example.dlocks.corepb.SweepExpiredLocksResponse
+	(*AcquirePermitsResponse)(nil),       // 50: com.evrblk.monstera_example.dlocks.corepb.AcquirePermitsResponse
+	(*ReleasePermitsResponse)(nil),       // 51: com.evrblk.monstera_example.dlocks.corepb.ReleasePermitsResponse
+	(*SetSemaphoreCapacityResponse)(nil), // 52: com.evrblk.monstera_example.dlocks.corepb.SetSemaphoreCapacityResponse
+	(*ConvertLockResponse)(nil),          // 53: com.evrblk.monstera_example.dlocks.corepb.ConvertLockResponse
+	(*AcquireLocksResponse)(nil),         // 54: com.evrblk.monstera_example.dlocks.corepb.AcquireLocksResponse
+	(*ReleaseLocksResponse)(nil),         // 55: com.evrblk.monstera_example.dlocks.corepb.ReleaseLocksResponse
+	(*CancelLockWaitResponse)(nil),       // 56: com.evrblk.monstera_example.dlocks.corepb.CancelLockWaitResponse
 }
 var file_corepb_cloud_proto_depIdxs = []int32{
 	4,  // 0: com.evrblk.monstera_example.dlocks.corepb.ReadRequest.get_account_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.GetAccountRequest
 	5,  // 1: com.evrblk.monstera_example.dlocks.corepb.ReadRequest.list_accounts_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.ListAccountsRequest
 	6,  // 2: com.evrblk.monstera_example.dlocks.corepb.ReadRequest.get_namespace_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.GetNamespaceRequest
 	7,  // 3: com.evrblk.monstera_example.dlocks.corepb.ReadRequest.list_namespaces_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.ListNamespacesRequest
-	8,  // 4: com.evrblk.monstera_example.dlocks.corepb.ReadResponse.error:type_name -> com.evrblk.monstera.monsterax.Error
-	9,  // 5: com.evrblk.monstera_example.dlocks.corepb.ReadResponse.get_account_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.GetAccountResponse
-	10, // 6: com.evrblk.monstera_example.dlocks.corepb.ReadResponse.list_accounts_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.ListAccountsResponse
-	11, // 7: com.evrblk.monstera_example.dlocks.corepb.ReadResponse.get_namespace_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.GetNamespaceResponse
-	12, // 8: com.evrblk.monstera_example.dlocks.corepb.ReadResponse.list_namespaces_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.ListNamespacesResponse
-	13, // 9: com.evrblk.monstera_example.dlocks.corepb.UpdateRequest.acquire_lock_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.AcquireLockRequest
-	14, // 10: com.evrblk.monstera_example.dlocks.corepb.UpdateRequest.release_lock_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.ReleaseLockRequest
-	15, // 11: com.evrblk.monstera_example.dlocks.corepb.UpdateRequest.delete_lock_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.DeleteLockRequest
-	16, // 12: com.evrblk.monstera_example.dlocks.corepb.UpdateRequest.get_lock_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.GetLockRequest
-	17, // 13: com.evrblk.monstera_example.dlocks.corepb.UpdateRequest.create_namespace_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.CreateNamespaceRequest
-	18, // 14: com.evrblk.monstera_example.dlocks.corepb.UpdateRequest.update_namespace_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.UpdateNamespaceRequest
-	19, // 15: com.evrblk.monstera_example.dlocks.corepb.UpdateRequest.delete_namespace_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.DeleteNamespaceRequest
-	20, // 16: com.evrblk.monstera_example.dlocks.corepb.UpdateRequest.create_account_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.CreateAccountRequest
-	21, // 17: com.evrblk.monstera_example.dlocks.corepb.UpdateRequest.update_account_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.UpdateAccountRequest
-	22, // 18: com.evrblk.monstera_example.dlocks.corepb.UpdateRequest.delete_account_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.DeleteAccountRequest
-	8,  // 19: com.evrblk.monstera_example.dlocks.corepb.UpdateResponse.error:type_name -> com.evrblk.monstera.monsterax.Error
-	23, // 20: com.evrblk.monstera_example.dlocks.corepb.UpdateResponse.acquire_lock_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.AcquireLockResponse
-	24, // 21: com.evrblk.monstera_example.dlocks.corepb.UpdateResponse.release_lock_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.ReleaseLockResponse
-	25, // 22: com.evrblk.monstera_example.dlocks.corepb.UpdateResponse.delete_lock_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.DeleteLockResponse
-	26, // 23: com.evrblk.monstera_example.dlocks.corepb.UpdateResponse.get_lock_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.GetLockResponse
-	27, // 24: com.evrblk.monstera_example.dlocks.corepb.UpdateResponse.create_namespace_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.CreateNamespaceResponse
-	28, // 25: com.evrblk.monstera_example.dlocks.corepb.UpdateResponse.update_namespace_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.UpdateNamespaceResponse
-	29, // 26: com.evrblk.monstera_example.dlocks.corepb.UpdateResponse.delete_namespace_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.DeleteNamespaceResponse
-	30, // 27: com.evrblk.monstera_example.dlocks.corepb.UpdateResponse.create_account_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.CreateAccountResponse
-	31, // 28: com.evrblk.monstera_example.dlocks.corepb.UpdateResponse.update_account_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.UpdateAccountResponse
-	32, // 29: com.evrblk.monstera_example.dlocks.corepb.UpdateResponse.delete_account_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.DeleteAccountResponse
-	30, // [30:30] is the sub-list for method output_type
-	30, // [30:30] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+	8,  // 4: com.evrblk.monstera_example.dlocks.corepb.ReadRequest.validate_fencing_token_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.ValidateFencingTokenRequest
+	9,  // 5: com.evrblk.monstera_example.dlocks.corepb.ReadRequest.list_locks_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.ListLocksRequest
+	10, // 6: com.evrblk.monstera_example.dlocks.corepb.ReadRequest.get_lock_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.GetLockRequest
+	11, // 7: com.evrblk.monstera_example.dlocks.corepb.ReadRequest.get_lock_from_follower_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.GetLockFromFollowerRequest
+	12, // 8: com.evrblk.monstera_example.dlocks.corepb.ReadRequest.get_semaphore_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.GetSemaphoreRequest
+	13, // 9: com.evrblk.monstera_example.dlocks.corepb.ReadResponse.error:type_name -> com.evrblk.monstera.monsterax.Error
+	14, // 10: com.evrblk.monstera_example.dlocks.corepb.ReadResponse.get_account_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.GetAccountResponse
+	15, // 11: com.evrblk.monstera_example.dlocks.corepb.ReadResponse.list_accounts_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.ListAccountsResponse
+	16, // 12: com.evrblk.monstera_example.dlocks.corepb.ReadResponse.get_namespace_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.GetNamespaceResponse
+	17, // 13: com.evrblk.monstera_example.dlocks.corepb.ReadResponse.list_namespaces_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.ListNamespacesResponse
+	18, // 14: com.evrblk.monstera_example.dlocks.corepb.ReadResponse.validate_fencing_token_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.ValidateFencingTokenResponse
+	19, // 15: com.evrblk.monstera_example.dlocks.corepb.ReadResponse.list_locks_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.ListLocksResponse
+	20, // 16: com.evrblk.monstera_example.dlocks.corepb.ReadResponse.get_lock_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.GetLockResponse
+	21, // 17: com.evrblk.monstera_example.dlocks.corepb.ReadResponse.get_lock_from_follower_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.GetLockFromFollowerResponse
+	22, // 18: com.evrblk.monstera_example.dlocks.corepb.ReadResponse.get_semaphore_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.GetSemaphoreResponse
+	23, // 19: com.evrblk.monstera_example.dlocks.corepb.UpdateRequest.acquire_lock_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.AcquireLockRequest
+	24, // 20: com.evrblk.monstera_example.dlocks.corepb.UpdateRequest.release_lock_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.ReleaseLockRequest
+	25, // 21: com.evrblk.monstera_example.dlocks.corepb.UpdateRequest.delete_lock_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.DeleteLockRequest
+	26, // 22: com.evrblk.monstera_example.dlocks.corepb.UpdateRequest.create_namespace_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.CreateNamespaceRequest
+	27, // 23: com.evrblk.monstera_example.dlocks.corepb.UpdateRequest.update_namespace_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.UpdateNamespaceRequest
+	28, // 24: com.evrblk.monstera_example.dlocks.corepb.UpdateRequest.delete_namespace_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.DeleteNamespaceRequest
+	29, // 25: com.evrblk.monstera_example.dlocks.corepb.UpdateRequest.create_account_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.CreateAccountRequest
+	30, // 26: com.evrblk.monstera_example.dlocks.corepb.UpdateRequest.update_account_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.UpdateAccountRequest
+	31, // 27: com.evrblk.monstera_example.dlocks.corepb.UpdateRequest.delete_account_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.DeleteAccountRequest
+	32, // 28: com.evrblk.monstera_example.dlocks.corepb.UpdateRequest.sweep_expired_locks_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.SweepExpiredLocksRequest
+	33, // 29: com.evrblk.monstera_example.dlocks.corepb.UpdateRequest.acquire_permits_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.AcquirePermitsRequest
+	34, // 30: com.evrblk.monstera_example.dlocks.corepb.UpdateRequest.release_permits_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.ReleasePermitsRequest
+	35, // 31: com.evrblk.monstera_example.dlocks.corepb.UpdateRequest.set_semaphore_capacity_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.SetSemaphoreCapacityRequest
+	36, // 32: com.evrblk.monstera_example.dlocks.corepb.UpdateRequest.convert_lock_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.ConvertLockRequest
+	37, // 33: com.evrblk.monstera_example.dlocks.corepb.UpdateRequest.acquire_locks_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.AcquireLocksRequest
+	38, // 34: com.evrblk.monstera_example.dlocks.corepb.UpdateRequest.release_locks_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.ReleaseLocksRequest
+	39, // 35: com.evrblk.monstera_example.dlocks.corepb.UpdateRequest.cancel_lock_wait_request:type_name -> com.evrblk.monstera_example.dlocks.corepb.CancelLockWaitRequest
+	13, // 36: com.evrblk.monstera_example.dlocks.corepb.UpdateResponse.error:type_name -> com.evrblk.monstera.monsterax.Error
+	40, // 37: com.evrblk.monstera_example.dlocks.corepb.UpdateResponse.acquire_lock_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.AcquireLockResponse
+	41, // 38: com.evrblk.monstera_example.dlocks.corepb.UpdateResponse.release_lock_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.ReleaseLockResponse
+	42, // 39: com.evrblk.monstera_example.dlocks.corepb.UpdateResponse.delete_lock_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.DeleteLockResponse
+	43, // 40: com.evrblk.monstera_example.dlocks.corepb.UpdateResponse.create_namespace_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.CreateNamespaceResponse
+	44, // 41: com.evrblk.monstera_example.dlocks.corepb.UpdateResponse.update_namespace_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.UpdateNamespaceResponse
+	45, // 42: com.evrblk.monstera_example.dlocks.corepb.UpdateResponse.delete_namespace_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.DeleteNamespaceResponse
+	46, // 43: com.evrblk.monstera_example.dlocks.corepb.UpdateResponse.create_account_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.CreateAccountResponse
+	47, // 44: com.evrblk.monstera_example.dlocks.corepb.UpdateResponse.update_account_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.UpdateAccountResponse
+	48, // 45: com.evrblk.monstera_example.dlocks.corepb.UpdateResponse.delete_account_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.DeleteAccountResponse
+	49, // 46: com.evrblk.monstera_example.dlocks.corepb.UpdateResponse.sweep_expired_locks_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.SweepExpiredLocksResponse
+	50, // 47: com.evrblk.monstera_example.dlocks.corepb.UpdateResponse.acquire_permits_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.AcquirePermitsResponse
+	51, // 48: com.evrblk.monstera_example.dlocks.corepb.UpdateResponse.release_permits_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.ReleasePermitsResponse
+	52, // 49: com.evrblk.monstera_example.dlocks.corepb.UpdateResponse.set_semaphore_capacity_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.SetSemaphoreCapacityResponse
+	53, // 50: com.evrblk.monstera_example.dlocks.corepb.UpdateResponse.convert_lock_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.ConvertLockResponse
+	54, // 51: com.evrblk.monstera_example.dlocks.corepb.UpdateResponse.acquire_locks_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.AcquireLocksResponse
+	55, // 52: com.evrblk.monstera_example.dlocks.corepb.UpdateResponse.release_locks_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.ReleaseLocksResponse
+	56, // 53: com.evrblk.monstera_example.dlocks.corepb.UpdateResponse.cancel_lock_wait_response:type_name -> com.evrblk.monstera_example.dlocks.corepb.CancelLockWaitResponse
+	54, // [54:54] is the sub-list for method output_type
+	54, // [54:54] is the sub-list for method input_type
+	54, // [54:54] is the sub-list for extension type_name
+	54, // [54:54] is the sub-list for extension extendee
+	0,  // [0:54] is the sub-list for field type_name
 }
 
 func init() { file_corepb_cloud_proto_init() }
@@ -1023,36 +1648,60 @@ func file_corepb_cloud_proto_init() {
 		(*ReadRequest_ListAccountsRequest)(nil),
 		(*ReadRequest_GetNamespaceRequest)(nil),
 		(*ReadRequest_ListNamespacesRequest)(nil),
+		(*ReadRequest_ValidateFencingTokenRequest)(nil),
+		(*ReadRequest_ListLocksRequest)(nil),
+		(*ReadRequest_GetLockRequest)(nil),
+		(*ReadRequest_GetLockFromFollowerRequest)(nil),
+		(*ReadRequest_GetSemaphoreRequest)(nil),
 	}
 	file_corepb_cloud_proto_msgTypes[1].OneofWrappers = []any{
 		(*ReadResponse_GetAccountResponse)(nil),
 		(*ReadResponse_ListAccountsResponse)(nil),
 		(*ReadResponse_GetNamespaceResponse)(nil),
 		(*ReadResponse_ListNamespacesResponse)(nil),
+		(*ReadResponse_ValidateFencingTokenResponse)(nil),
+		(*ReadResponse_ListLocksResponse)(nil),
+		(*ReadResponse_GetLockResponse)(nil),
+		(*ReadResponse_GetLockFromFollowerResponse)(nil),
+		(*ReadResponse_GetSemaphoreResponse)(nil),
 	}
 	file_corepb_cloud_proto_msgTypes[2].OneofWrappers = []any{
 		(*UpdateRequest_AcquireLockRequest)(nil),
 		(*UpdateRequest_ReleaseLockRequest)(nil),
 		(*UpdateRequest_DeleteLockRequest)(nil),
-		(*UpdateRequest_GetLockRequest)(nil),
 		(*UpdateRequest_CreateNamespaceRequest)(nil),
 		(*UpdateRequest_UpdateNamespaceRequest)(nil),
 		(*UpdateRequest_DeleteNamespaceRequest)(nil),
 		(*UpdateRequest_CreateAccountRequest)(nil),
 		(*UpdateRequest_UpdateAccountRequest)(nil),
 		(*UpdateRequest_DeleteAccountRequest)(nil),
+		(*UpdateRequest_SweepExpiredLocksRequest)(nil),
+		(*UpdateRequest_AcquirePermitsRequest)(nil),
+		(*UpdateRequest_ReleasePermitsRequest)(nil),
+		(*UpdateRequest_SetSemaphoreCapacityRequest)(nil),
+		(*UpdateRequest_ConvertLockRequest)(nil),
+		(*UpdateRequest_AcquireLocksRequest)(nil),
+		(*UpdateRequest_ReleaseLocksRequest)(nil),
+		(*UpdateRequest_CancelLockWaitRequest)(nil),
 	}
 	file_corepb_cloud_proto_msgTypes[3].OneofWrappers = []any{
 		(*UpdateResponse_AcquireLockResponse)(nil),
 		(*UpdateResponse_ReleaseLockResponse)(nil),
 		(*UpdateResponse_DeleteLockResponse)(nil),
-		(*UpdateResponse_GetLockResponse)(nil),
 		(*UpdateResponse_CreateNamespaceResponse)(nil),
 		(*UpdateResponse_UpdateNamespaceResponse)(nil),
 		(*UpdateResponse_DeleteNamespaceResponse)(nil),
 		(*UpdateResponse_CreateAccountResponse)(nil),
 		(*UpdateResponse_UpdateAccountResponse)(nil),
 		(*UpdateResponse_DeleteAccountResponse)(nil),
+		(*UpdateResponse_SweepExpiredLocksResponse)(nil),
+		(*UpdateResponse_AcquirePermitsResponse)(nil),
+		(*UpdateResponse_ReleasePermitsResponse)(nil),
+		(*UpdateResponse_SetSemaphoreCapacityResponse)(nil),
+		(*UpdateResponse_ConvertLockResponse)(nil),
+		(*UpdateResponse_AcquireLocksResponse)(nil),
+		(*UpdateResponse_ReleaseLocksResponse)(nil),
+		(*UpdateResponse_CancelLockWaitResponse)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -19,15 +19,24 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LocksServiceApi_CreateNamespace_FullMethodName = "/com.evrblk.monstera_example.gatewaypb.LocksServiceApi/CreateNamespace"
-	LocksServiceApi_ListNamespaces_FullMethodName  = "/com.evrblk.monstera_example.gatewaypb.LocksServiceApi/ListNamespaces"
-	LocksServiceApi_GetNamespace_FullMethodName    = "/com.evrblk.monstera_example.gatewaypb.LocksServiceApi/GetNamespace"
-	LocksServiceApi_DeleteNamespace_FullMethodName = "/com.evrblk.monstera_example.gatewaypb.LocksServiceApi/DeleteNamespace"
-	LocksServiceApi_UpdateNamespace_FullMethodName = "/com.evrblk.monstera_example.gatewaypb.LocksServiceApi/UpdateNamespace"
-	LocksServiceApi_AcquireLock_FullMethodName     = "/com.evrblk.monstera_example.gatewaypb.LocksServiceApi/AcquireLock"
-	LocksServiceApi_ReleaseLock_FullMethodName     = "/com.evrblk.monstera_example.gatewaypb.LocksServiceApi/ReleaseLock"
-	LocksServiceApi_GetLock_FullMethodName         = "/com.evrblk.monstera_example.gatewaypb.LocksServiceApi/GetLock"
-	LocksServiceApi_DeleteLock_FullMethodName      = "/com.evrblk.monstera_example.gatewaypb.LocksServiceApi/DeleteLock"
+	LocksServiceApi_CreateNamespace_FullMethodName      = "/com.evrblk.monstera_example.gatewaypb.LocksServiceApi/CreateNamespace"
+	LocksServiceApi_ListNamespaces_FullMethodName       = "/com.evrblk.monstera_example.gatewaypb.LocksServiceApi/ListNamespaces"
+	LocksServiceApi_GetNamespace_FullMethodName         = "/com.evrblk.monstera_example.gatewaypb.LocksServiceApi/GetNamespace"
+	LocksServiceApi_DeleteNamespace_FullMethodName      = "/com.evrblk.monstera_example.gatewaypb.LocksServiceApi/DeleteNamespace"
+	LocksServiceApi_UpdateNamespace_FullMethodName      = "/com.evrblk.monstera_example.gatewaypb.LocksServiceApi/UpdateNamespace"
+	LocksServiceApi_AcquireLock_FullMethodName          = "/com.evrblk.monstera_example.gatewaypb.LocksServiceApi/AcquireLock"
+	LocksServiceApi_ReleaseLock_FullMethodName          = "/com.evrblk.monstera_example.gatewaypb.LocksServiceApi/ReleaseLock"
+	LocksServiceApi_ConvertLock_FullMethodName          = "/com.evrblk.monstera_example.gatewaypb.LocksServiceApi/ConvertLock"
+	LocksServiceApi_AcquireLocks_FullMethodName         = "/com.evrblk.monstera_example.gatewaypb.LocksServiceApi/AcquireLocks"
+	LocksServiceApi_ReleaseLocks_FullMethodName         = "/com.evrblk.monstera_example.gatewaypb.LocksServiceApi/ReleaseLocks"
+	LocksServiceApi_GetLock_FullMethodName              = "/com.evrblk.monstera_example.gatewaypb.LocksServiceApi/GetLock"
+	LocksServiceApi_DeleteLock_FullMethodName           = "/com.evrblk.monstera_example.gatewaypb.LocksServiceApi/DeleteLock"
+	LocksServiceApi_ListLocks_FullMethodName            = "/com.evrblk.monstera_example.gatewaypb.LocksServiceApi/ListLocks"
+	LocksServiceApi_ValidateFencingToken_FullMethodName = "/com.evrblk.monstera_example.gatewaypb.LocksServiceApi/ValidateFencingToken"
+	LocksServiceApi_AcquirePermits_FullMethodName       = "/com.evrblk.monstera_example.gatewaypb.LocksServiceApi/AcquirePermits"
+	LocksServiceApi_ReleasePermits_FullMethodName       = "/com.evrblk.monstera_example.gatewaypb.LocksServiceApi/ReleasePermits"
+	LocksServiceApi_GetSemaphore_FullMethodName         = "/com.evrblk.monstera_example.gatewaypb.LocksServiceApi/GetSemaphore"
+	LocksServiceApi_SetSemaphoreCapacity_FullMethodName = "/com.evrblk.monstera_example.gatewaypb.LocksServiceApi/SetSemaphoreCapacity"
 )
 
 // LocksServiceApiClient is the client API for LocksServiceApi service.
@@ -41,8 +50,17 @@ type LocksServiceApiClient interface {
 	UpdateNamespace(ctx context.Context, in *UpdateNamespaceRequest, opts ...grpc.CallOption) (*UpdateNamespaceResponse, error)
 	AcquireLock(ctx context.Context, in *AcquireLockRequest, opts ...grpc.CallOption) (*AcquireLockResponse, error)
 	ReleaseLock(ctx context.Context, in *ReleaseLockRequest, opts ...grpc.CallOption) (*ReleaseLockResponse, error)
+	ConvertLock(ctx context.Context, in *ConvertLockRequest, opts ...grpc.CallOption) (*ConvertLockResponse, error)
+	AcquireLocks(ctx context.Context, in *AcquireLocksRequest, opts ...grpc.CallOption) (*AcquireLocksResponse, error)
+	ReleaseLocks(ctx context.Context, in *ReleaseLocksRequest, opts ...grpc.CallOption) (*ReleaseLocksResponse, error)
 	GetLock(ctx context.Context, in *GetLockRequest, opts ...grpc.CallOption) (*GetLockResponse, error)
 	DeleteLock(ctx context.Context, in *DeleteLockRequest, opts ...grpc.CallOption) (*DeleteLockResponse, error)
+	ListLocks(ctx context.Context, in *ListLocksRequest, opts ...grpc.CallOption) (*ListLocksResponse, error)
+	ValidateFencingToken(ctx context.Context, in *ValidateFencingTokenRequest, opts ...grpc.CallOption) (*ValidateFencingTokenResponse, error)
+	AcquirePermits(ctx context.Context, in *AcquirePermitsRequest, opts ...grpc.CallOption) (*AcquirePermitsResponse, error)
+	ReleasePermits(ctx context.Context, in *ReleasePermitsRequest, opts ...grpc.CallOption) (*ReleasePermitsResponse, error)
+	GetSemaphore(ctx context.Context, in *GetSemaphoreRequest, opts ...grpc.CallOption) (*GetSemaphoreResponse, error)
+	SetSemaphoreCapacity(ctx context.Context, in *SetSemaphoreCapacityRequest, opts ...grpc.CallOption) (*SetSemaphoreCapacityResponse, error)
 }
 
 type locksServiceApiClient struct {
@@ -123,6 +141,36 @@ func (c *locksServiceApiClient) ReleaseLock(ctx context.Context, in *ReleaseLock
 	return out, nil
 }
 
+func (c *locksServiceApiClient) ConvertLock(ctx context.Context, in *ConvertLockRequest, opts ...grpc.CallOption) (*ConvertLockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConvertLockResponse)
+	err := c.cc.Invoke(ctx, LocksServiceApi_ConvertLock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locksServiceApiClient) AcquireLocks(ctx context.Context, in *AcquireLocksRequest, opts ...grpc.CallOption) (*AcquireLocksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcquireLocksResponse)
+	err := c.cc.Invoke(ctx, LocksServiceApi_AcquireLocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locksServiceApiClient) ReleaseLocks(ctx context.Context, in *ReleaseLocksRequest, opts ...grpc.CallOption) (*ReleaseLocksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseLocksResponse)
+	err := c.cc.Invoke(ctx, LocksServiceApi_ReleaseLocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *locksServiceApiClient) GetLock(ctx context.Context, in *GetLockRequest, opts ...grpc.CallOption) (*GetLockResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetLockResponse)
@@ -143,6 +191,66 @@ func (c *locksServiceApiClient) DeleteLock(ctx context.Context, in *DeleteLockRe
 	return out, nil
 }
 
+func (c *locksServiceApiClient) ListLocks(ctx context.Context, in *ListLocksRequest, opts ...grpc.CallOption) (*ListLocksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLocksResponse)
+	err := c.cc.Invoke(ctx, LocksServiceApi_ListLocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locksServiceApiClient) ValidateFencingToken(ctx context.Context, in *ValidateFencingTokenRequest, opts ...grpc.CallOption) (*ValidateFencingTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateFencingTokenResponse)
+	err := c.cc.Invoke(ctx, LocksServiceApi_ValidateFencingToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locksServiceApiClient) AcquirePermits(ctx context.Context, in *AcquirePermitsRequest, opts ...grpc.CallOption) (*AcquirePermitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcquirePermitsResponse)
+	err := c.cc.Invoke(ctx, LocksServiceApi_AcquirePermits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locksServiceApiClient) ReleasePermits(ctx context.Context, in *ReleasePermitsRequest, opts ...grpc.CallOption) (*ReleasePermitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleasePermitsResponse)
+	err := c.cc.Invoke(ctx, LocksServiceApi_ReleasePermits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locksServiceApiClient) GetSemaphore(ctx context.Context, in *GetSemaphoreRequest, opts ...grpc.CallOption) (*GetSemaphoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSemaphoreResponse)
+	err := c.cc.Invoke(ctx, LocksServiceApi_GetSemaphore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locksServiceApiClient) SetSemaphoreCapacity(ctx context.Context, in *SetSemaphoreCapacityRequest, opts ...grpc.CallOption) (*SetSemaphoreCapacityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSemaphoreCapacityResponse)
+	err := c.cc.Invoke(ctx, LocksServiceApi_SetSemaphoreCapacity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LocksServiceApiServer is the server API for LocksServiceApi service.
 // All implementations must embed UnimplementedLocksServiceApiServer
 // for forward compatibility.
@@ -154,8 +262,17 @@ type LocksServiceApiServer interface {
 	UpdateNamespace(context.Context, *UpdateNamespaceRequest) (*UpdateNamespaceResponse, error)
 	AcquireLock(context.Context, *AcquireLockRequest) (*AcquireLockResponse, error)
 	ReleaseLock(context.Context, *ReleaseLockRequest) (*ReleaseLockResponse, error)
+	ConvertLock(context.Context, *ConvertLockRequest) (*ConvertLockResponse, error)
+	AcquireLocks(context.Context, *AcquireLocksRequest) (*AcquireLocksResponse, error)
+	ReleaseLocks(context.Context, *ReleaseLocksRequest) (*ReleaseLocksResponse, error)
 	GetLock(context.Context, *GetLockRequest) (*GetLockResponse, error)
 	DeleteLock(context.Context, *DeleteLockRequest) (*DeleteLockResponse, error)
+	ListLocks(context.Context, *ListLocksRequest) (*ListLocksResponse, error)
+	ValidateFencingToken(context.Context, *ValidateFencingTokenRequest) (*ValidateFencingTokenResponse, error)
+	AcquirePermits(context.Context, *AcquirePermitsRequest) (*AcquirePermitsResponse, error)
+	ReleasePermits(context.Context, *ReleasePermitsRequest) (*ReleasePermitsResponse, error)
+	GetSemaphore(context.Context, *GetSemaphoreRequest) (*GetSemaphoreResponse, error)
+	SetSemaphoreCapacity(context.Context, *SetSemaphoreCapacityRequest) (*SetSemaphoreCapacityResponse, error)
 	mustEmbedUnimplementedLocksServiceApiServer()
 }
 
@@ -187,12 +304,39 @@ func (UnimplementedLocksServiceApiServer) AcquireLock(context.Context, *AcquireL
 func (UnimplementedLocksServiceApiServer) ReleaseLock(context.Context, *ReleaseLockRequest) (*ReleaseLockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReleaseLock not implemented")
 }
+func (UnimplementedLocksServiceApiServer) ConvertLock(context.Context, *ConvertLockRequest) (*ConvertLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertLock not implemented")
+}
+func (UnimplementedLocksServiceApiServer) AcquireLocks(context.Context, *AcquireLocksRequest) (*AcquireLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcquireLocks not implemented")
+}
+func (UnimplementedLocksServiceApiServer) ReleaseLocks(context.Context, *ReleaseLocksRequest) (*ReleaseLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseLocks not implemented")
+}
 func (UnimplementedLocksServiceApiServer) GetLock(context.Context, *GetLockRequest) (*GetLockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLock not implemented")
 }
 func (UnimplementedLocksServiceApiServer) DeleteLock(context.Context, *DeleteLockRequest) (*DeleteLockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteLock not implemented")
 }
+func (UnimplementedLocksServiceApiServer) ListLocks(context.Context, *ListLocksRequest) (*ListLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLocks not implemented")
+}
+func (UnimplementedLocksServiceApiServer) ValidateFencingToken(context.Context, *ValidateFencingTokenRequest) (*ValidateFencingTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateFencingToken not implemented")
+}
+func (UnimplementedLocksServiceApiServer) AcquirePermits(context.Context, *AcquirePermitsRequest) (*AcquirePermitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcquirePermits not implemented")
+}
+func (UnimplementedLocksServiceApiServer) ReleasePermits(context.Context, *ReleasePermitsRequest) (*ReleasePermitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleasePermits not implemented")
+}
+func (UnimplementedLocksServiceApiServer) GetSemaphore(context.Context, *GetSemaphoreRequest) (*GetSemaphoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSemaphore not implemented")
+}
+func (UnimplementedLocksServiceApiServer) SetSemaphoreCapacity(context.Context, *SetSemaphoreCapacityRequest) (*SetSemaphoreCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSemaphoreCapacity not implemented")
+}
 func (UnimplementedLocksServiceApiServer) mustEmbedUnimplementedLocksServiceApiServer() {}
 func (UnimplementedLocksServiceApiServer) testEmbeddedByValue()                         {}
 
@@ -340,6 +484,60 @@ func _LocksServiceApi_ReleaseLock_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LocksServiceApi_ConvertLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocksServiceApiServer).ConvertLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocksServiceApi_ConvertLock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocksServiceApiServer).ConvertLock(ctx, req.(*ConvertLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocksServiceApi_AcquireLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcquireLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocksServiceApiServer).AcquireLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocksServiceApi_AcquireLocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocksServiceApiServer).AcquireLocks(ctx, req.(*AcquireLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocksServiceApi_ReleaseLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocksServiceApiServer).ReleaseLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocksServiceApi_ReleaseLocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocksServiceApiServer).ReleaseLocks(ctx, req.(*ReleaseLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LocksServiceApi_GetLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetLockRequest)
 	if err := dec(in); err != nil {
@@ -376,6 +574,114 @@ func _LocksServiceApi_DeleteLock_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LocksServiceApi_ListLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocksServiceApiServer).ListLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocksServiceApi_ListLocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocksServiceApiServer).ListLocks(ctx, req.(*ListLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocksServiceApi_ValidateFencingToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateFencingTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocksServiceApiServer).ValidateFencingToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocksServiceApi_ValidateFencingToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocksServiceApiServer).ValidateFencingToken(ctx, req.(*ValidateFencingTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocksServiceApi_AcquirePermits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcquirePermitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocksServiceApiServer).AcquirePermits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocksServiceApi_AcquirePermits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocksServiceApiServer).AcquirePermits(ctx, req.(*AcquirePermitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocksServiceApi_ReleasePermits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleasePermitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocksServiceApiServer).ReleasePermits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocksServiceApi_ReleasePermits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocksServiceApiServer).ReleasePermits(ctx, req.(*ReleasePermitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocksServiceApi_GetSemaphore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSemaphoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocksServiceApiServer).GetSemaphore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocksServiceApi_GetSemaphore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocksServiceApiServer).GetSemaphore(ctx, req.(*GetSemaphoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocksServiceApi_SetSemaphoreCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSemaphoreCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocksServiceApiServer).SetSemaphoreCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocksServiceApi_SetSemaphoreCapacity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocksServiceApiServer).SetSemaphoreCapacity(ctx, req.(*SetSemaphoreCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LocksServiceApi_ServiceDesc is the grpc.ServiceDesc for LocksServiceApi service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -411,6 +717,18 @@ var LocksServiceApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReleaseLock",
 			Handler:    _LocksServiceApi_ReleaseLock_Handler,
 		},
+		{
+			MethodName: "ConvertLock",
+			Handler:    _LocksServiceApi_ConvertLock_Handler,
+		},
+		{
+			MethodName: "AcquireLocks",
+			Handler:    _LocksServiceApi_AcquireLocks_Handler,
+		},
+		{
+			MethodName: "ReleaseLocks",
+			Handler:    _LocksServiceApi_ReleaseLocks_Handler,
+		},
 		{
 			MethodName: "GetLock",
 			Handler:    _LocksServiceApi_GetLock_Handler,
@@ -419,6 +737,30 @@ var LocksServiceApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteLock",
 			Handler:    _LocksServiceApi_DeleteLock_Handler,
 		},
+		{
+			MethodName: "ListLocks",
+			Handler:    _LocksServiceApi_ListLocks_Handler,
+		},
+		{
+			MethodName: "ValidateFencingToken",
+			Handler:    _LocksServiceApi_ValidateFencingToken_Handler,
+		},
+		{
+			MethodName: "AcquirePermits",
+			Handler:    _LocksServiceApi_AcquirePermits_Handler,
+		},
+		{
+			MethodName: "ReleasePermits",
+			Handler:    _LocksServiceApi_ReleasePermits_Handler,
+		},
+		{
+			MethodName: "GetSemaphore",
+			Handler:    _LocksServiceApi_GetSemaphore_Handler,
+		},
+		{
+			MethodName: "SetSemaphoreCapacity",
+			Handler:    _LocksServiceApi_SetSemaphoreCapacity_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gatewaypb/api.proto",
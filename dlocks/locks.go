@@ -1,6 +1,7 @@
 package dlocks
 
 import (
+	"fmt"
 	"io"
 
 	"errors"
@@ -13,22 +14,41 @@ import (
 )
 
 type LocksCore struct {
-	badgerStore *monstera.BadgerStore
-	locksTable  *monsterax.CompositeKeyTable[*corepb.Lock, corepb.Lock]
+	badgerStore     *monstera.BadgerStore
+	shardLowerBound []byte
+
+	locksTable            *monsterax.CompositeKeyTable[*corepb.Lock, corepb.Lock]
+	fencingTokens         *monsterax.UniqueUint64Index
+	locksCounters         *monsterax.UniqueUint64Index
+	locksExpiryIndex      *monsterax.CompositeKeyTable[*corepb.LockId, corepb.LockId]
+	semaphoresTable       *monsterax.CompositeKeyTable[*corepb.Semaphore, corepb.Semaphore]
+	semaphoresExpiryIndex *monsterax.CompositeKeyTable[*corepb.SemaphoreId, corepb.SemaphoreId]
 }
 
 var _ LocksCoreApi = &LocksCore{}
 
 func NewLocksCore(badgerStore *monstera.BadgerStore, shardLowerBound []byte, shardUpperBound []byte) *LocksCore {
 	return &LocksCore{
-		badgerStore: badgerStore,
-		locksTable:  monsterax.NewCompositeKeyTable[*corepb.Lock, corepb.Lock](locksTableId, shardLowerBound, shardUpperBound),
+		badgerStore:     badgerStore,
+		shardLowerBound: shardLowerBound,
+
+		locksTable:            monsterax.NewCompositeKeyTable[*corepb.Lock, corepb.Lock](locksTableId, shardLowerBound, shardUpperBound),
+		fencingTokens:         monsterax.NewUniqueUint64Index(fencingTokensTableId, shardLowerBound, shardUpperBound),
+		locksCounters:         monsterax.NewUniqueUint64Index(locksCountersTableId, shardLowerBound, shardUpperBound),
+		locksExpiryIndex:      monsterax.NewCompositeKeyTable[*corepb.LockId, corepb.LockId](locksExpiryIndexId, shardLowerBound, shardUpperBound),
+		semaphoresTable:       monsterax.NewCompositeKeyTable[*corepb.Semaphore, corepb.Semaphore](semaphoresTableId, shardLowerBound, shardUpperBound),
+		semaphoresExpiryIndex: monsterax.NewCompositeKeyTable[*corepb.SemaphoreId, corepb.SemaphoreId](semaphoresExpiryIndexId, shardLowerBound, shardUpperBound),
 	}
 }
 
 func (c *LocksCore) ranges() []monstera.KeyRange {
 	return []monstera.KeyRange{
 		c.locksTable.GetTableKeyRange(),
+		c.fencingTokens.GetTableKeyRange(),
+		c.locksCounters.GetTableKeyRange(),
+		c.locksExpiryIndex.GetTableKeyRange(),
+		c.semaphoresTable.GetTableKeyRange(),
+		c.semaphoresExpiryIndex.GetTableKeyRange(),
 	}
 }
 
@@ -44,15 +64,201 @@ func (c *LocksCore) Close() {
 
 }
 
+// GetLock returns the lock as it is at the moment `now`. Expiration is applied virtually, expired locks are cleaned up
+// by writes and by the sweeper.
 func (c *LocksCore) GetLock(request *corepb.GetLockRequest) (*corepb.GetLockResponse, error) {
+	txn := c.badgerStore.View()
+	defer txn.Discard()
+
+	return &corepb.GetLockResponse{
+		Lock: c.getLockView(txn, request.LockId, request.Now),
+	}, nil
+}
+
+// GetLockFromFollower is the same as GetLock, but it is served by any replica and can be stale.
+func (c *LocksCore) GetLockFromFollower(request *corepb.GetLockFromFollowerRequest) (*corepb.GetLockFromFollowerResponse, error) {
+	txn := c.badgerStore.View()
+	defer txn.Discard()
+
+	return &corepb.GetLockFromFollowerResponse{
+		Lock: c.getLockView(txn, request.LockId, request.Now),
+	}, nil
+}
+
+func (c *LocksCore) DeleteLock(request *corepb.DeleteLockRequest) (*corepb.DeleteLockResponse, error) {
 	txn := c.badgerStore.Update()
 	defer txn.Discard()
 
 	lock, err := c.getLock(txn, request.LockId)
 	if err != nil {
 		if errors.Is(err, monstera.ErrNotFound) {
-			// No lock exists, return an unlocked lock
-			return &corepb.GetLockResponse{
+			// No lock exists, do nothing
+			return &corepb.DeleteLockResponse{}, nil
+		} else {
+			panic(err)
+		}
+	}
+
+	err = c.deleteLock(txn, lock)
+	panicIfNotNil(err)
+
+	err = txn.Commit()
+	panicIfNotNil(err)
+
+	return &corepb.DeleteLockResponse{}, nil
+}
+
+func (c *LocksCore) AcquireLock(request *corepb.AcquireLockRequest) (*corepb.AcquireLockResponse, error) {
+	txn := c.badgerStore.Update()
+	defer txn.Discard()
+
+	lock, exists, err := c.getOrNewLock(txn, request)
+	if err != nil {
+		return nil, err
+	}
+
+	granted, fencingToken := c.grantLock(txn, lock, request)
+
+	if granted {
+		// The process is not waiting anymore
+		lock.Waiters = removeWaiter(lock.Waiters, request.ProcessId)
+	} else if request.WaitUntil > request.Now {
+		// Queue the process (or keep its place in the queue) until the lock can be granted
+		existingWaiter, ok := lo.Find(lock.Waiters, func(w *corepb.LockWaiter) bool {
+			return w.ProcessId == request.ProcessId
+		})
+		if ok {
+			existingWaiter.WriteLock = request.WriteLock
+			existingWaiter.WaitUntil = request.WaitUntil
+		} else {
+			lock.Waiters = append(lock.Waiters, &corepb.LockWaiter{
+				ProcessId:  request.ProcessId,
+				WriteLock:  request.WriteLock,
+				EnqueuedAt: request.Now,
+				WaitUntil:  request.WaitUntil,
+			})
+		}
+	} else {
+		return &corepb.AcquireLockResponse{
+			Lock:    lock,
+			Success: false, // The lock is held by another process or other processes are queued first
+		}, nil
+	}
+
+	err = c.saveLock(txn, lock, exists)
+	panicIfNotNil(err)
+
+	err = txn.Commit()
+	panicIfNotNil(err)
+
+	return &corepb.AcquireLockResponse{
+		Lock:         lock,
+		Success:      granted, // Locked successfully by the given process_id
+		FencingToken: fencingToken,
+		Waiting:      !granted,
+	}, nil
+}
+
+// AcquireLocks acquires several locks of one namespace for a process, all or none. All locks of a namespace live in
+// the same shard, so this is a single update. If any lock cannot be granted right away nothing is changed, and the
+// result of that lock lists processes which block it. Waiting is not supported.
+func (c *LocksCore) AcquireLocks(request *corepb.AcquireLocksRequest) (*corepb.AcquireLocksResponse, error) {
+	txn := c.badgerStore.Update()
+	defer txn.Discard()
+
+	results := make([]*corepb.AcquireLockResult, len(request.LockNames))
+	originalLocks := make([]*corepb.Lock, len(request.LockNames))
+	allGranted := true
+
+	for i, lockName := range request.LockNames {
+		lockRequest := &corepb.AcquireLockRequest{
+			LockId: &corepb.LockId{
+				AccountId:     request.NamespaceId.AccountId,
+				NamespaceName: request.NamespaceId.NamespaceName,
+				LockName:      lockName,
+			},
+			ProcessId: request.ProcessId,
+			WriteLock: request.WriteLock,
+			Now:       request.Now,
+			ExpiresAt: request.ExpiresAt,
+
+			MaxNumberOfLocksPerNamespace: request.MaxNumberOfLocksPerNamespace,
+		}
+
+		lock, exists, err := c.getOrNewLock(txn, lockRequest)
+		if err != nil {
+			return nil, err
+		}
+		originalLocks[i] = proto.Clone(lock).(*corepb.Lock)
+
+		granted, fencingToken := c.grantLock(txn, lock, lockRequest)
+		if granted {
+			lock.Waiters = removeWaiter(lock.Waiters, request.ProcessId)
+
+			err = c.saveLock(txn, lock, exists)
+			panicIfNotNil(err)
+
+			results[i] = &corepb.AcquireLockResult{
+				Lock:         lock,
+				FencingToken: fencingToken,
+			}
+		} else {
+			allGranted = false
+
+			results[i] = &corepb.AcquireLockResult{
+				Lock:      lock,
+				BlockedBy: lockBlockers(lock, request.ProcessId, request.WriteLock),
+			}
+		}
+	}
+
+	if !allGranted {
+		// Transaction is discarded, report locks as they are
+		for i, result := range results {
+			result.Lock = originalLocks[i]
+			result.FencingToken = 0
+		}
+
+		return &corepb.AcquireLocksResponse{
+			Success: false,
+			Results: results,
+		}, nil
+	}
+
+	err := txn.Commit()
+	panicIfNotNil(err)
+
+	return &corepb.AcquireLocksResponse{
+		Success: true,
+		Results: results,
+	}, nil
+}
+
+func (c *LocksCore) ReleaseLock(request *corepb.ReleaseLockRequest) (*corepb.ReleaseLockResponse, error) {
+	txn := c.badgerStore.Update()
+	defer txn.Discard()
+
+	lock := c.releaseLock(txn, request.LockId, request.ProcessId, request.Now)
+
+	err := txn.Commit()
+	panicIfNotNil(err)
+
+	return &corepb.ReleaseLockResponse{
+		Lock: lock,
+	}, nil
+}
+
+// CancelLockWait removes a process from the queue of waiters of the lock. Unlike ReleaseLock, the lock is kept if the
+// process holds it, so a reader which gave up waiting for a write lock is still a reader.
+func (c *LocksCore) CancelLockWait(request *corepb.CancelLockWaitRequest) (*corepb.CancelLockWaitResponse, error) {
+	txn := c.badgerStore.Update()
+	defer txn.Discard()
+
+	lock, err := c.getLock(txn, request.LockId)
+	if err != nil {
+		if errors.Is(err, monstera.ErrNotFound) {
+			// No lock exists, nobody waits for it
+			return &corepb.CancelLockWaitResponse{
 				Lock: &corepb.Lock{
 					Id:       request.LockId,
 					State:    corepb.LockState_UNLOCKED,
@@ -64,97 +270,334 @@ func (c *LocksCore) GetLock(request *corepb.GetLockRequest) (*corepb.GetLockResp
 		}
 	}
 
-	// Check expiration
 	lock = c.checkLockExpiration(lock, request.Now)
-	if lock.State == corepb.LockState_UNLOCKED {
-		// Lock is expired, delete it
-		err = c.deleteLock(txn, lock)
-		panicIfNotNil(err)
-	} else {
-		// Lock is still held, update unexpired holders
-		err = c.updateLock(txn, lock)
-		panicIfNotNil(err)
-	}
+	lock.Waiters = removeWaiter(lock.Waiters, request.ProcessId)
+
+	err = c.saveLock(txn, lock, true)
+	panicIfNotNil(err)
 
 	err = txn.Commit()
 	panicIfNotNil(err)
 
-	return &corepb.GetLockResponse{
-		Lock: c.checkLockExpiration(lock, request.Now),
+	return &corepb.CancelLockWaitResponse{
+		Lock: lock,
 	}, nil
 }
 
-func (c *LocksCore) DeleteLock(request *corepb.DeleteLockRequest) (*corepb.DeleteLockResponse, error) {
+// ReleaseLocks releases several locks of one namespace held by a process in a single update.
+func (c *LocksCore) ReleaseLocks(request *corepb.ReleaseLocksRequest) (*corepb.ReleaseLocksResponse, error) {
+	txn := c.badgerStore.Update()
+	defer txn.Discard()
+
+	locks := make([]*corepb.Lock, len(request.LockNames))
+	for i, lockName := range request.LockNames {
+		lockId := &corepb.LockId{
+			AccountId:     request.NamespaceId.AccountId,
+			NamespaceName: request.NamespaceId.NamespaceName,
+			LockName:      lockName,
+		}
+		locks[i] = c.releaseLock(txn, lockId, request.ProcessId, request.Now)
+	}
+
+	err := txn.Commit()
+	panicIfNotNil(err)
+
+	return &corepb.ReleaseLocksResponse{
+		Locks: locks,
+	}, nil
+}
+
+// ConvertLock atomically changes the mode of a lock held by a process. An upgrade from read to write succeeds only
+// when the process is the sole reader, otherwise the lock is left untouched and Success is false. A downgrade from
+// write to read always succeeds. Converting a lock to the mode it is already held in just extends it. Upgrades are
+// granted ahead of queued waiters, since the process already holds the lock. An upgrade issues a new fencing token,
+// so that writes under the write lock can be told from the earlier read lease. Other conversions keep the token.
+func (c *LocksCore) ConvertLock(request *corepb.ConvertLockRequest) (*corepb.ConvertLockResponse, error) {
 	txn := c.badgerStore.Update()
 	defer txn.Discard()
 
 	lock, err := c.getLock(txn, request.LockId)
 	if err != nil {
 		if errors.Is(err, monstera.ErrNotFound) {
-			// No lock exists, do nothing
-			return &corepb.DeleteLockResponse{}, nil
+			return nil, monsterax.NewErrorWithContext(
+				monsterax.NotFound,
+				"lock is not held by process",
+				map[string]string{"lock_name": request.LockId.LockName, "process_id": request.ProcessId})
 		} else {
 			panic(err)
 		}
 	}
 
-	err = c.deleteLock(txn, lock)
+	// Lock exists, lets check if it expired
+	lock = c.checkLockExpiration(lock, request.Now)
+
+	if !isLockHeldBy(lock, request.ProcessId) {
+		return nil, monsterax.NewErrorWithContext(
+			monsterax.NotFound,
+			"lock is not held by process",
+			map[string]string{"lock_name": request.LockId.LockName, "process_id": request.ProcessId})
+	}
+
+	var holder *corepb.LockHolder
+
+	switch lock.State {
+	case corepb.LockState_READ_LOCKED:
+		holder, _ = lo.Find(lock.ReadLockHolders, func(h *corepb.LockHolder) bool {
+			return h.ProcessId == request.ProcessId
+		})
+
+		if request.WriteLock {
+			if len(lock.ReadLockHolders) > 1 {
+				return &corepb.ConvertLockResponse{
+					Lock:    lock,
+					Success: false, // Other processes hold the lock for reads
+				}, nil
+			}
+
+			// Upgrade
+			fencingToken, err := c.nextFencingToken(txn, request.LockId)
+			panicIfNotNil(err)
+
+			holder.FencingToken = fencingToken
+			lock.State = corepb.LockState_WRITE_LOCKED
+			lock.WriteLockHolder = holder
+			lock.ReadLockHolders = nil
+		}
+	case corepb.LockState_WRITE_LOCKED:
+		holder = lock.WriteLockHolder
+
+		if !request.WriteLock {
+			// Downgrade
+			lock.State = corepb.LockState_READ_LOCKED
+			lock.ReadLockHolders = []*corepb.LockHolder{holder}
+			lock.WriteLockHolder = nil
+		}
+	default:
+		panic("invalid lock state")
+	}
+
+	if request.ExpiresAt > 0 {
+		// Update expiration time (extend lock)
+		holder.ExpiresAt = request.ExpiresAt
+	}
+
+	err = c.saveLock(txn, lock, true)
 	panicIfNotNil(err)
 
 	err = txn.Commit()
 	panicIfNotNil(err)
 
-	return &corepb.DeleteLockResponse{}, nil
+	return &corepb.ConvertLockResponse{
+		Lock:         lock,
+		Success:      true,
+		FencingToken: holder.FencingToken,
+	}, nil
 }
 
-func (c *LocksCore) AcquireLock(request *corepb.AcquireLockRequest) (*corepb.AcquireLockResponse, error) {
+// SweepExpiredLocks cleans up locks of the shard which nobody holds or waits for at the moment `now`, so that
+// abandoned locks do not stay in storage forever. Locks are found via the expiry index in order of expiration.
+// Expired permits of semaphores are released the same way via their own expiry index.
+func (c *LocksCore) SweepExpiredLocks(request *corepb.SweepExpiredLocksRequest) (*corepb.SweepExpiredLocksResponse, error) {
 	txn := c.badgerStore.Update()
 	defer txn.Discard()
 
-	// TODO check total number of locks
+	if request.Limit <= 0 {
+		return nil, monsterax.NewError(monsterax.InvalidArgument, "invalid limit")
+	}
+
+	lockIds := make([]*corepb.LockId, 0)
+	err := c.locksExpiryIndex.List(txn, locksExpiryIndexPK(c.shardLowerBound), func(lockId *corepb.LockId) (bool, error) {
+		lockIds = append(lockIds, lockId)
+		return len(lockIds) < int(request.Limit), nil
+	})
+	panicIfNotNil(err)
+
+	sweptLocks := 0
+	for _, lockId := range lockIds {
+		lock, err := c.getLock(txn, lockId)
+		panicIfNotNil(err)
+
+		if lock.ExpiresAt >= request.Now {
+			// Index is sorted by expiration, the rest are not expired yet
+			break
+		}
+
+		lock = c.checkLockExpiration(lock, request.Now)
+		err = c.saveLock(txn, lock, true)
+		panicIfNotNil(err)
+
+		sweptLocks++
+	}
+
+	semaphoreIds := make([]*corepb.SemaphoreId, 0)
+	err = c.semaphoresExpiryIndex.List(txn, locksExpiryIndexPK(c.shardLowerBound), func(semaphoreId *corepb.SemaphoreId) (bool, error) {
+		semaphoreIds = append(semaphoreIds, semaphoreId)
+		return len(semaphoreIds) < int(request.Limit), nil
+	})
+	panicIfNotNil(err)
+
+	sweptSemaphores := 0
+	for _, semaphoreId := range semaphoreIds {
+		semaphore, err := c.getSemaphore(txn, semaphoreId)
+		panicIfNotNil(err)
+
+		if semaphore.ExpiresAt >= request.Now {
+			// Index is sorted by expiration, the rest are not expired yet
+			break
+		}
+
+		semaphore = c.checkSemaphoreExpiration(semaphore, request.Now)
+		err = c.saveSemaphore(txn, semaphore, true)
+		panicIfNotNil(err)
+
+		sweptSemaphores++
+	}
+
+	err = txn.Commit()
+	panicIfNotNil(err)
+
+	return &corepb.SweepExpiredLocksResponse{
+		SweptLocks:      int32(sweptLocks),
+		SweptSemaphores: int32(sweptSemaphores),
+	}, nil
+}
+
+// ListLocks lists locks of a namespace ordered by lock name. Expiration is applied virtually at the moment `now`:
+// expired holders are not returned, and locks which are neither held nor waited for anymore are skipped. Locks can be
+// filtered by state and by holder process id. The page token is the name of the last lock of the previous page.
+func (c *LocksCore) ListLocks(request *corepb.ListLocksRequest) (*corepb.ListLocksResponse, error) {
+	txn := c.badgerStore.View()
+	defer txn.Discard()
+
+	locks := make([]*corepb.Lock, 0)
+	nextPageToken := ""
+
+	err := c.listLocksAfter(txn, request.NamespaceId, request.PageToken, func(lock *corepb.Lock) (bool, error) {
+		lock = c.checkLockExpiration(lock, request.Now)
+		if lock.State == corepb.LockState_UNLOCKED && len(lock.Waiters) == 0 {
+			return true, nil
+		}
+		if len(request.States) > 0 && !lo.Contains(request.States, lock.State) {
+			return true, nil
+		}
+		if request.ProcessId != "" && !isLockHeldBy(lock, request.ProcessId) {
+			return true, nil
+		}
+
+		if request.Limit > 0 && int32(len(locks)) >= request.Limit {
+			// There is at least one more lock
+			nextPageToken = locks[len(locks)-1].Id.LockName
+			return false, nil
+		}
+
+		locks = append(locks, lock)
+		return true, nil
+	})
+	panicIfNotNil(err)
+
+	return &corepb.ListLocksResponse{
+		Locks:         locks,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// ValidateFencingToken checks that a fencing token belongs to a current (unexpired) holder of the lock. Downstream
+// resources should reject writes carrying a token that is not valid anymore.
+func (c *LocksCore) ValidateFencingToken(request *corepb.ValidateFencingTokenRequest) (*corepb.ValidateFencingTokenResponse, error) {
+	txn := c.badgerStore.View()
+	defer txn.Discard()
+
+	lock, err := c.getLock(txn, request.LockId)
+	if err != nil {
+		if errors.Is(err, monstera.ErrNotFound) {
+			// No lock exists, no token can be valid
+			return &corepb.ValidateFencingTokenResponse{
+				Valid: false,
+				Lock: &corepb.Lock{
+					Id:       request.LockId,
+					State:    corepb.LockState_UNLOCKED,
+					LockedAt: 0,
+				},
+			}, nil
+		} else {
+			panic(err)
+		}
+	}
+
+	lock = c.checkLockExpiration(lock, request.Now)
+
+	valid := false
+	switch lock.State {
+	case corepb.LockState_UNLOCKED:
+		valid = false
+	case corepb.LockState_READ_LOCKED:
+		valid = lo.ContainsBy(lock.ReadLockHolders, func(h *corepb.LockHolder) bool {
+			return h.FencingToken == request.FencingToken
+		})
+	case corepb.LockState_WRITE_LOCKED:
+		valid = lock.WriteLockHolder.FencingToken == request.FencingToken
+	default:
+		panic("invalid lock state")
+	}
 
+	return &corepb.ValidateFencingTokenResponse{
+		Valid: valid,
+		Lock:  lock,
+	}, nil
+}
+
+// getOrNewLock loads the lock (with expired holders removed), or builds a new unlocked one if it does not exist yet.
+// A new lock is checked against the max number of locks per namespace, expired locks which are not cleaned up yet
+// are deleted first.
+func (c *LocksCore) getOrNewLock(txn *monstera.Txn, request *corepb.AcquireLockRequest) (*corepb.Lock, bool, error) {
 	lock, err := c.getLock(txn, request.LockId)
 	if err != nil {
 		if errors.Is(err, monstera.ErrNotFound) {
+			// Checking max number of locks
+			err = c.checkLocksLimit(txn, request.LockId, request.MaxNumberOfLocksPerNamespace, request.Now)
+			if err != nil {
+				return nil, false, err
+			}
+
 			// No lock exists, create a new one
-			lock = &corepb.Lock{
+			return &corepb.Lock{
 				Id:       request.LockId,
 				State:    corepb.LockState_UNLOCKED,
 				LockedAt: 0,
-			}
+			}, false, nil
 		} else {
 			panic(err)
 		}
-	} else {
-		// Lock exists, lets check if it expired
-		lock = c.checkLockExpiration(lock, request.Now)
 	}
 
-	lockHolder := &corepb.LockHolder{
-		ProcessId: request.ProcessId,
-		LockedAt:  request.Now,
-		ExpiresAt: request.ExpiresAt,
-	}
+	// Lock exists, lets check if it expired
+	return c.checkLockExpiration(lock, request.Now), true, nil
+}
 
+// grantLock tries to grant the lock to the process from the request. The lock is changed only if it was granted.
+func (c *LocksCore) grantLock(txn *monstera.Txn, lock *corepb.Lock, request *corepb.AcquireLockRequest) (bool, uint64) {
 	switch lock.State {
 	case corepb.LockState_UNLOCKED:
-		if request.WriteLock {
-			// Lock for writes
-			lock.State = corepb.LockState_WRITE_LOCKED
-			lock.WriteLockHolder = lockHolder
-		} else {
-			// Lock for reads only
-			lock.State = corepb.LockState_READ_LOCKED
-			lock.ReadLockHolders = []*corepb.LockHolder{lockHolder}
+		// Unlocked, but waiters queued earlier go first
+		if isNextInQueue(lock, request.ProcessId, request.WriteLock) {
+			lockHolder, err := c.newLockHolder(txn, request)
+			panicIfNotNil(err)
+
+			if request.WriteLock {
+				// Lock for writes
+				lock.State = corepb.LockState_WRITE_LOCKED
+				lock.WriteLockHolder = lockHolder
+			} else {
+				// Lock for reads only
+				lock.State = corepb.LockState_READ_LOCKED
+				lock.ReadLockHolders = []*corepb.LockHolder{lockHolder}
+			}
+			lock.LockedAt = request.Now
+			return true, lockHolder.FencingToken
 		}
-		lock.LockedAt = request.Now
 	case corepb.LockState_READ_LOCKED:
-		if request.WriteLock {
-			return &corepb.AcquireLockResponse{
-				Lock:    lock,
-				Success: false, // Already locked for reads, cannot be locked for writes.
-			}, nil
-		} else {
+		// Already locked for reads, cannot be locked for writes.
+		if !request.WriteLock {
 			// Already locked for reads.
 			// Check if the same process_id already holds the lock here.
 			existingHolder, ok := lo.Find(lock.ReadLockHolders, func(h *corepb.LockHolder) bool {
@@ -164,12 +607,18 @@ func (c *LocksCore) AcquireLock(request *corepb.AcquireLockRequest) (*corepb.Acq
 				// Update expiration time (extend lock)
 				existingHolder.ExpiresAt = request.ExpiresAt
 				existingHolder.LockedAt = request.Now
-			} else {
+				return true, existingHolder.FencingToken
+			} else if isNextInQueue(lock, request.ProcessId, request.WriteLock) {
 				// Add the new lock holder
+				lockHolder, err := c.newLockHolder(txn, request)
+				panicIfNotNil(err)
+
 				lock.ReadLockHolders = append(lock.ReadLockHolders, lockHolder)
+				return true, lockHolder.FencingToken
 			}
 		}
 	case corepb.LockState_WRITE_LOCKED:
+		// Already locked for writes, cannot be locked for reads.
 		if request.WriteLock {
 			// Already locked for writes. Check if the same process_id already holds the lock here.
 			if lock.WriteLockHolder.ProcessId == request.ProcessId {
@@ -177,68 +626,42 @@ func (c *LocksCore) AcquireLock(request *corepb.AcquireLockRequest) (*corepb.Acq
 				// Update expiration time (extend lock)
 				lock.WriteLockHolder.ExpiresAt = request.ExpiresAt
 				lock.WriteLockHolder.LockedAt = request.Now
-			} else {
-				return &corepb.AcquireLockResponse{
-					Lock:    lock,
-					Success: false, // The lock is held by another process
-				}, nil
+				return true, lock.WriteLockHolder.FencingToken
 			}
-
-		} else {
-			return &corepb.AcquireLockResponse{
-				Lock:    lock,
-				Success: false, // Already locked for writes, cannot be locked for reads.
-			}, nil
-
 		}
 	default:
 		panic("invalid lock state")
 	}
 
-	err = c.updateLock(txn, lock)
-	panicIfNotNil(err)
-
-	err = txn.Commit()
-	panicIfNotNil(err)
-
-	return &corepb.AcquireLockResponse{
-		Lock:    lock,
-		Success: true, // Locked successfully by the given process_id
-	}, nil
+	return false, 0
 }
 
-func (c *LocksCore) ReleaseLock(request *corepb.ReleaseLockRequest) (*corepb.ReleaseLockResponse, error) {
-	txn := c.badgerStore.Update()
-	defer txn.Discard()
-
-	lock, err := c.getLock(txn, request.LockId)
+// releaseLock removes the process from holders and waiters of the lock and saves it.
+func (c *LocksCore) releaseLock(txn *monstera.Txn, lockId *corepb.LockId, processId string, now int64) *corepb.Lock {
+	lock, err := c.getLock(txn, lockId)
 	if err != nil {
 		if errors.Is(err, monstera.ErrNotFound) {
 			// No lock exists, return an unlocked lock
-			return &corepb.ReleaseLockResponse{
-				Lock: &corepb.Lock{
-					Id:       request.LockId,
-					State:    corepb.LockState_UNLOCKED,
-					LockedAt: 0,
-				},
-			}, nil
+			return &corepb.Lock{
+				Id:       lockId,
+				State:    corepb.LockState_UNLOCKED,
+				LockedAt: 0,
+			}
 		} else {
 			panic(err)
 		}
 	} else {
 		// Lock exists, lets check if it expired
-		lock = c.checkLockExpiration(lock, request.Now)
+		lock = c.checkLockExpiration(lock, now)
 	}
 
 	switch lock.State {
 	case corepb.LockState_UNLOCKED:
-		// Lock has expired, delete it
-		err = c.deleteLock(txn, lock)
-		panicIfNotNil(err)
+		// Lock has expired, nothing to release
 	case corepb.LockState_READ_LOCKED:
 		// Remove the holder
 		lock.ReadLockHolders = lo.Filter(lock.ReadLockHolders, func(h *corepb.LockHolder, _ int) bool {
-			return h.ProcessId != request.ProcessId
+			return h.ProcessId != processId
 		})
 
 		// If no read lock holders left
@@ -247,37 +670,58 @@ func (c *LocksCore) ReleaseLock(request *corepb.ReleaseLockRequest) (*corepb.Rel
 			lock.LockedAt = 0
 			lock.State = corepb.LockState_UNLOCKED
 			lock.ReadLockHolders = nil
-
-			// Delete it
-			err = c.deleteLock(txn, lock)
-			panicIfNotNil(err)
 		}
 	case corepb.LockState_WRITE_LOCKED:
-		if lock.WriteLockHolder.ProcessId == request.ProcessId {
+		if lock.WriteLockHolder.ProcessId == processId {
 			// Unlock
 			lock.State = corepb.LockState_UNLOCKED
 			lock.LockedAt = 0
 			lock.WriteLockHolder = nil
-
-			// Delete it
-			err = c.deleteLock(txn, lock)
-			panicIfNotNil(err)
 		}
 	default:
 		panic("invalid lock state")
 	}
 
-	err = c.updateLock(txn, lock)
-	panicIfNotNil(err)
+	// A process that gave up waiting leaves the queue as well
+	lock.Waiters = removeWaiter(lock.Waiters, processId)
 
-	err = txn.Commit()
+	err = c.saveLock(txn, lock, true)
 	panicIfNotNil(err)
 
-	return &corepb.ReleaseLockResponse{
-		Lock: lock,
+	return lock
+}
+
+// newLockHolder creates a lock holder for the requesting process with the next fencing token.
+func (c *LocksCore) newLockHolder(txn *monstera.Txn, request *corepb.AcquireLockRequest) (*corepb.LockHolder, error) {
+	fencingToken, err := c.nextFencingToken(txn, request.LockId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &corepb.LockHolder{
+		ProcessId:    request.ProcessId,
+		LockedAt:     request.Now,
+		ExpiresAt:    request.ExpiresAt,
+		FencingToken: fencingToken,
 	}, nil
 }
 
+// nextFencingToken increments and returns the fencing token counter of a namespace. The counter is stored separately
+// from locks, so tokens keep increasing after a lock is released, expired or deleted.
+func (c *LocksCore) nextFencingToken(txn *monstera.Txn, lockId *corepb.LockId) (uint64, error) {
+	lastToken, err := c.fencingTokens.Get(txn, locksTablePK(lockId))
+	if err != nil && !errors.Is(err, monstera.ErrNotFound) {
+		return 0, err
+	}
+
+	err = c.fencingTokens.Set(txn, locksTablePK(lockId), lastToken+1)
+	if err != nil {
+		return 0, err
+	}
+
+	return lastToken + 1, nil
+}
+
 // checkLockExpiration ensures that the lock is still held at the moment `now`. Returns an updated copy of the lock.
 func (c *LocksCore) checkLockExpiration(lock *corepb.Lock, now int64) *corepb.Lock {
 	result := proto.Clone(lock).(*corepb.Lock)
@@ -305,19 +749,307 @@ func (c *LocksCore) checkLockExpiration(lock *corepb.Lock, now int64) *corepb.Lo
 		panic("invalid lock state")
 	}
 
+	// Drop waiters which gave up
+	result.Waiters = lo.Filter(result.Waiters, func(w *corepb.LockWaiter, _ int) bool {
+		return w.WaitUntil >= now
+	})
+
+	return result
+}
+
+// lockExpiresAt returns the moment after which nobody holds or waits for the lock.
+func lockExpiresAt(lock *corepb.Lock) int64 {
+	expiresAt := int64(0)
+	if lock.WriteLockHolder != nil {
+		expiresAt = lock.WriteLockHolder.ExpiresAt
+	}
+	for _, h := range lock.ReadLockHolders {
+		expiresAt = max(expiresAt, h.ExpiresAt)
+	}
+	for _, w := range lock.Waiters {
+		expiresAt = max(expiresAt, w.WaitUntil)
+	}
+	return expiresAt
+}
+
+func isLockHeldBy(lock *corepb.Lock, processId string) bool {
+	switch lock.State {
+	case corepb.LockState_READ_LOCKED:
+		return lo.ContainsBy(lock.ReadLockHolders, func(h *corepb.LockHolder) bool {
+			return h.ProcessId == processId
+		})
+	case corepb.LockState_WRITE_LOCKED:
+		return lock.WriteLockHolder.ProcessId == processId
+	default:
+		return false
+	}
+}
+
+// isNextInQueue checks that no conflicting waiter is queued ahead of the given process. Writers wait for everyone
+// queued before them, readers only for queued writers, so a constant stream of new readers cannot starve a writer.
+func isNextInQueue(lock *corepb.Lock, processId string, writeLock bool) bool {
+	for _, w := range lock.Waiters {
+		if w.ProcessId == processId {
+			return true
+		}
+		if writeLock || w.WriteLock {
+			return false
+		}
+	}
+	return true
+}
+
+// lockBlockers returns ids of processes which prevent the lock from being granted to the given process: current holders
+// and waiters queued ahead of it.
+func lockBlockers(lock *corepb.Lock, processId string, writeLock bool) []string {
+	var blockers []string
+
+	switch lock.State {
+	case corepb.LockState_READ_LOCKED:
+		if writeLock {
+			for _, h := range lock.ReadLockHolders {
+				blockers = append(blockers, h.ProcessId)
+			}
+		}
+	case corepb.LockState_WRITE_LOCKED:
+		if !writeLock || lock.WriteLockHolder.ProcessId != processId {
+			blockers = append(blockers, lock.WriteLockHolder.ProcessId)
+		}
+	}
+
+	for _, w := range lock.Waiters {
+		if w.ProcessId == processId {
+			break
+		}
+		if writeLock || w.WriteLock {
+			blockers = append(blockers, w.ProcessId)
+		}
+	}
+
+	return blockers
+}
+
+func removeWaiter(waiters []*corepb.LockWaiter, processId string) []*corepb.LockWaiter {
+	result := lo.Filter(waiters, func(w *corepb.LockWaiter, _ int) bool {
+		return w.ProcessId != processId
+	})
+	if len(result) == 0 {
+		return nil
+	}
 	return result
 }
 
+// getLockView returns the lock with expiration applied at the moment `now`, or an unlocked lock if it does not exist.
+func (c *LocksCore) getLockView(txn *monstera.Txn, lockId *corepb.LockId, now int64) *corepb.Lock {
+	lock, err := c.getLock(txn, lockId)
+	if err != nil {
+		if errors.Is(err, monstera.ErrNotFound) {
+			// No lock exists, return an unlocked lock
+			return &corepb.Lock{
+				Id:       lockId,
+				State:    corepb.LockState_UNLOCKED,
+				LockedAt: 0,
+			}
+		} else {
+			panic(err)
+		}
+	}
+
+	return c.checkLockExpiration(lock, now)
+}
+
 func (c *LocksCore) getLock(txn *monstera.Txn, lockId *corepb.LockId) (*corepb.Lock, error) {
 	return c.locksTable.Get(txn, locksTablePK(lockId), locksTableSK(lockId))
 }
 
+// listLocks iterates over all stored locks of a namespace. Namespace names are not length-prefixed in keys, so a
+// prefix scan for namespace "a" may also see locks of namespace "ab", those are skipped here.
+func (c *LocksCore) listLocks(txn *monstera.Txn, namespaceId namespaceIdIntf, fn func(lock *corepb.Lock) (bool, error)) error {
+	return c.locksTable.List(txn, locksTablePK(namespaceId), func(lock *corepb.Lock) (bool, error) {
+		if lock.Id.AccountId != namespaceId.GetAccountId() || lock.Id.NamespaceName != namespaceId.GetNamespaceName() {
+			return true, nil
+		}
+		return fn(lock)
+	})
+}
+
+// listLocksAfter lists locks of a namespace with names greater than `after`, seeking right past it.
+func (c *LocksCore) listLocksAfter(txn *monstera.Txn, namespaceId namespaceIdIntf, after string, fn func(lock *corepb.Lock) (bool, error)) error {
+	var lowerBound []byte
+	if after != "" {
+		// The smallest name greater than `after`
+		lowerBound = monstera.ConcatBytes(after, []byte{0x00})
+	}
+	// Lock names are UTF-8 strings, they never contain 0xff
+	upperBound := []byte{0xff}
+
+	return monsterax.ListRange(txn, locksTableId, locksTablePK(namespaceId), lowerBound, upperBound, func(key []byte, value []byte) (bool, error) {
+		lock := &corepb.Lock{}
+		if err := proto.Unmarshal(value, lock); err != nil {
+			return false, err
+		}
+		if lock.Id.AccountId != namespaceId.GetAccountId() || lock.Id.NamespaceName != namespaceId.GetNamespaceName() {
+			return true, nil
+		}
+		return fn(lock)
+	})
+}
+
+// updateLock stores the lock and moves it in the expiry index if its expiration has changed.
 func (c *LocksCore) updateLock(txn *monstera.Txn, lock *corepb.Lock) error {
+	expiresAt := lockExpiresAt(lock)
+	if expiresAt != lock.ExpiresAt {
+		if lock.ExpiresAt != 0 {
+			err := c.locksExpiryIndex.Delete(txn, locksExpiryIndexPK(c.shardLowerBound), locksExpiryIndexSK(lock.Id, lock.ExpiresAt))
+			if err != nil {
+				return err
+			}
+		}
+
+		lock.ExpiresAt = expiresAt
+
+		err := c.locksExpiryIndex.Set(txn, locksExpiryIndexPK(c.shardLowerBound), locksExpiryIndexSK(lock.Id, lock.ExpiresAt), lock.Id)
+		if err != nil {
+			return err
+		}
+	}
+
 	return c.locksTable.Set(txn, locksTablePK(lock.Id), locksTableSK(lock.Id), lock)
 }
 
+// saveLock stores the lock, or deletes it if nobody holds or waits for it anymore. `exists` tells if the lock is
+// already stored, so the number of locks in the namespace is kept up to date.
+func (c *LocksCore) saveLock(txn *monstera.Txn, lock *corepb.Lock, exists bool) error {
+	if lock.State == corepb.LockState_UNLOCKED && len(lock.Waiters) == 0 {
+		if exists {
+			return c.deleteLock(txn, lock)
+		}
+		return nil
+	} else if exists {
+		return c.updateLock(txn, lock)
+	} else {
+		return c.createLock(txn, lock)
+	}
+}
+
+func (c *LocksCore) createLock(txn *monstera.Txn, lock *corepb.Lock) error {
+	err := c.updateLock(txn, lock)
+	if err != nil {
+		return err
+	}
+
+	return c.changeLocksCount(txn, lock.Id, 1)
+}
+
 func (c *LocksCore) deleteLock(txn *monstera.Txn, lock *corepb.Lock) error {
-	return c.locksTable.Delete(txn, locksTablePK(lock.Id), locksTableSK(lock.Id))
+	err := c.locksTable.Delete(txn, locksTablePK(lock.Id), locksTableSK(lock.Id))
+	if err != nil {
+		return err
+	}
+
+	if lock.ExpiresAt != 0 {
+		err = c.locksExpiryIndex.Delete(txn, locksExpiryIndexPK(c.shardLowerBound), locksExpiryIndexSK(lock.Id, lock.ExpiresAt))
+		if err != nil {
+			return err
+		}
+	}
+
+	return c.changeLocksCount(txn, lock.Id, -1)
+}
+
+// checkLocksLimit checks that one more lock or semaphore can be created in the namespace. When the limit is reached,
+// expired locks and semaphores which are not cleaned up yet are deleted first. A limit of 0 means no limit.
+func (c *LocksCore) checkLocksLimit(txn *monstera.Txn, namespaceId namespaceIdIntf, limit int64, now int64) error {
+	if limit <= 0 {
+		return nil
+	}
+
+	count, err := c.getLocksCount(txn, namespaceId)
+	panicIfNotNil(err)
+
+	if count >= limit {
+		count, err = c.deleteExpiredLocks(txn, namespaceId, now)
+		panicIfNotNil(err)
+	}
+
+	if count >= limit {
+		return monsterax.NewErrorWithContext(
+			monsterax.ResourceExhausted,
+			"max number of locks per namespace reached",
+			map[string]string{"limit": fmt.Sprintf("%d", limit)})
+	}
+
+	return nil
+}
+
+// changeLocksCount adds delta to the number of stored locks and semaphores in the namespace.
+func (c *LocksCore) changeLocksCount(txn *monstera.Txn, namespaceId namespaceIdIntf, delta int64) error {
+	count, err := c.getLocksCount(txn, namespaceId)
+	if err != nil {
+		return err
+	}
+
+	if count+delta <= 0 {
+		return c.locksCounters.Delete(txn, locksTablePK(namespaceId))
+	} else {
+		return c.locksCounters.Set(txn, locksTablePK(namespaceId), uint64(count+delta))
+	}
+}
+
+// getLocksCount returns the number of stored locks (held or waited for) and semaphores in the given namespace.
+func (c *LocksCore) getLocksCount(txn *monstera.Txn, namespaceId namespaceIdIntf) (int64, error) {
+	count, err := c.locksCounters.Get(txn, locksTablePK(namespaceId))
+	if err != nil {
+		if errors.Is(err, monstera.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return int64(count), nil
+}
+
+// deleteExpiredLocks deletes all locks in the namespace which have expired at the moment `now` and have no waiters, and
+// implicitly created semaphores whose permits have all expired. Returns the number of locks and semaphores left.
+func (c *LocksCore) deleteExpiredLocks(txn *monstera.Txn, namespaceId namespaceIdIntf, now int64) (int64, error) {
+	expiredLocks := make([]*corepb.Lock, 0)
+	err := c.listLocks(txn, namespaceId, func(lock *corepb.Lock) (bool, error) {
+		lock = c.checkLockExpiration(lock, now)
+		if lock.State == corepb.LockState_UNLOCKED && len(lock.Waiters) == 0 {
+			expiredLocks = append(expiredLocks, lock)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, lock := range expiredLocks {
+		err = c.deleteLock(txn, lock)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	expiredSemaphores := make([]*corepb.Semaphore, 0)
+	err = c.listSemaphores(txn, namespaceId, func(semaphore *corepb.Semaphore) (bool, error) {
+		semaphore = c.checkSemaphoreExpiration(semaphore, now)
+		if len(semaphore.Holders) == 0 && !semaphore.CapacitySetExplicitly {
+			expiredSemaphores = append(expiredSemaphores, semaphore)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, semaphore := range expiredSemaphores {
+		err = c.deleteSemaphore(txn, semaphore)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return c.getLocksCount(txn, namespaceId)
 }
 
 type locksIdIntf interface {
@@ -337,3 +1069,17 @@ func locksTablePK(n namespaceIdIntf) []byte {
 func locksTableSK(l locksIdIntf) []byte {
 	return monstera.ConcatBytes(l.GetLockName())
 }
+
+// 1. shard lower bound (the whole shard is a single partition, sorted by expiration)
+func locksExpiryIndexPK(shardLowerBound []byte) []byte {
+	return monstera.ConcatBytes(shardLowerBound)
+}
+
+// 1. expires at
+// 2. account id
+// 3. namespace name length
+// 4. namespace name
+// 5. lock name
+func locksExpiryIndexSK(lockId *corepb.LockId, expiresAt int64) []byte {
+	return monstera.ConcatBytes(expiresAt, lockId.AccountId, uint32(len(lockId.NamespaceName)), lockId.NamespaceName, lockId.LockName)
+}
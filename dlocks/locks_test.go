@@ -1,6 +1,7 @@
 package dlocks
 
 import (
+	"fmt"
 	"math/rand/v2"
 	"testing"
 	"time"
@@ -600,6 +601,1389 @@ func TestReleaseExpiredLock(t *testing.T) {
 	require.Equal(corepb.LockState_UNLOCKED, response2.Lock.State)
 }
 
+func TestFencingTokens(t *testing.T) {
+	require := require.New(t)
+
+	locksCore := newLocksCore()
+
+	now := time.Now()
+
+	accountId := rand.Uint64()
+	lockId := &corepb.LockId{
+		AccountId:     accountId,
+		NamespaceName: "test_namespace",
+		LockName:      "test_lock",
+	}
+
+	// T+0: Acquire lock by process_1
+	response1, err := locksCore.AcquireLock(&corepb.AcquireLockRequest{
+		LockId:    lockId,
+		Now:       now.UnixNano(),
+		ProcessId: "process_1",
+		WriteLock: true,
+		ExpiresAt: now.Add(time.Hour).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.True(response1.Success)
+	require.EqualValues(1, response1.FencingToken)
+	require.EqualValues(1, response1.Lock.WriteLockHolder.FencingToken)
+
+	// T+1m: Extend lock by process_1, token stays the same
+	response2, err := locksCore.AcquireLock(&corepb.AcquireLockRequest{
+		LockId:    lockId,
+		Now:       now.Add(time.Minute).UnixNano(),
+		ProcessId: "process_1",
+		WriteLock: true,
+		ExpiresAt: now.Add(time.Hour).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.True(response2.Success)
+	require.EqualValues(1, response2.FencingToken)
+
+	// T+2m: Validate token of process_1
+	response3, err := locksCore.ValidateFencingToken(&corepb.ValidateFencingTokenRequest{
+		LockId:       lockId,
+		FencingToken: 1,
+		Now:          now.Add(2 * time.Minute).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.True(response3.Valid)
+
+	// T+61m: Lock expired and acquired by process_2
+	response4, err := locksCore.AcquireLock(&corepb.AcquireLockRequest{
+		LockId:    lockId,
+		Now:       now.Add(61 * time.Minute).UnixNano(),
+		ProcessId: "process_2",
+		WriteLock: true,
+		ExpiresAt: now.Add(2 * time.Hour).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.True(response4.Success)
+	require.EqualValues(2, response4.FencingToken)
+
+	// T+62m: Token of process_1 is not valid anymore
+	response5, err := locksCore.ValidateFencingToken(&corepb.ValidateFencingTokenRequest{
+		LockId:       lockId,
+		FencingToken: 1,
+		Now:          now.Add(62 * time.Minute).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.False(response5.Valid)
+	require.Equal("process_2", response5.Lock.WriteLockHolder.ProcessId)
+
+	// T+63m: Release lock by process_2, lock is deleted
+	_, err = locksCore.ReleaseLock(&corepb.ReleaseLockRequest{
+		LockId:    lockId,
+		Now:       now.Add(63 * time.Minute).UnixNano(),
+		ProcessId: "process_2",
+	})
+	require.NoError(err)
+
+	response6, err := locksCore.ValidateFencingToken(&corepb.ValidateFencingTokenRequest{
+		LockId:       lockId,
+		FencingToken: 2,
+		Now:          now.Add(63 * time.Minute).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.False(response6.Valid)
+
+	// T+64m: Acquire lock for reads by two processes, tokens keep increasing
+	response7, err := locksCore.AcquireLock(&corepb.AcquireLockRequest{
+		LockId:    lockId,
+		Now:       now.Add(64 * time.Minute).UnixNano(),
+		ProcessId: "process_1",
+		WriteLock: false,
+		ExpiresAt: now.Add(2 * time.Hour).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.True(response7.Success)
+	require.EqualValues(3, response7.FencingToken)
+
+	response8, err := locksCore.AcquireLock(&corepb.AcquireLockRequest{
+		LockId:    lockId,
+		Now:       now.Add(64 * time.Minute).UnixNano(),
+		ProcessId: "process_2",
+		WriteLock: false,
+		ExpiresAt: now.Add(2 * time.Hour).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.True(response8.Success)
+	require.EqualValues(4, response8.FencingToken)
+
+	response9, err := locksCore.ValidateFencingToken(&corepb.ValidateFencingTokenRequest{
+		LockId:       lockId,
+		FencingToken: 3,
+		Now:          now.Add(65 * time.Minute).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.True(response9.Valid)
+}
+
+func TestAcquireLockWaitQueue(t *testing.T) {
+	require := require.New(t)
+
+	locksCore := newLocksCore()
+
+	now := time.Now()
+
+	accountId := rand.Uint64()
+	lockId := &corepb.LockId{
+		AccountId:     accountId,
+		NamespaceName: "test_namespace",
+		LockName:      "test_lock",
+	}
+
+	// T+0: Acquire lock for reads by process_1
+	response1, err := locksCore.AcquireLock(&corepb.AcquireLockRequest{
+		LockId:    lockId,
+		Now:       now.UnixNano(),
+		ProcessId: "process_1",
+		WriteLock: false,
+		ExpiresAt: now.Add(time.Hour).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.True(response1.Success)
+
+	// T+1s: process_2 waits for a write lock
+	response2, err := locksCore.AcquireLock(&corepb.AcquireLockRequest{
+		LockId:    lockId,
+		Now:       now.Add(time.Second).UnixNano(),
+		ProcessId: "process_2",
+		WriteLock: true,
+		ExpiresAt: now.Add(time.Hour).UnixNano(),
+		WaitUntil: now.Add(time.Minute).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.False(response2.Success)
+	require.True(response2.Waiting)
+	require.Len(response2.Lock.Waiters, 1)
+	require.Equal("process_2", response2.Lock.Waiters[0].ProcessId)
+
+	// T+2s: process_3 cannot jump ahead of the queued writer
+	response3, err := locksCore.AcquireLock(&corepb.AcquireLockRequest{
+		LockId:    lockId,
+		Now:       now.Add(2 * time.Second).UnixNano(),
+		ProcessId: "process_3",
+		WriteLock: false,
+		ExpiresAt: now.Add(time.Hour).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.False(response3.Success)
+	require.False(response3.Waiting)
+
+	// T+3s: process_3 waits for a read lock behind process_2
+	response4, err := locksCore.AcquireLock(&corepb.AcquireLockRequest{
+		LockId:    lockId,
+		Now:       now.Add(3 * time.Second).UnixNano(),
+		ProcessId: "process_3",
+		WriteLock: false,
+		ExpiresAt: now.Add(time.Hour).UnixNano(),
+		WaitUntil: now.Add(time.Minute).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.False(response4.Success)
+	require.True(response4.Waiting)
+	require.Len(response4.Lock.Waiters, 2)
+
+	// T+4s: Release lock by process_1, the lock is kept for waiters
+	response5, err := locksCore.ReleaseLock(&corepb.ReleaseLockRequest{
+		LockId:    lockId,
+		Now:       now.Add(4 * time.Second).UnixNano(),
+		ProcessId: "process_1",
+	})
+
+	require.NoError(err)
+	require.Equal(corepb.LockState_UNLOCKED, response5.Lock.State)
+	require.Len(response5.Lock.Waiters, 2)
+
+	// T+5s: process_3 is still behind process_2
+	response6, err := locksCore.AcquireLock(&corepb.AcquireLockRequest{
+		LockId:    lockId,
+		Now:       now.Add(5 * time.Second).UnixNano(),
+		ProcessId: "process_3",
+		WriteLock: false,
+		ExpiresAt: now.Add(time.Hour).UnixNano(),
+		WaitUntil: now.Add(time.Minute).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.False(response6.Success)
+	require.True(response6.Waiting)
+
+	// T+6s: process_2 is at the head of the queue and gets the lock
+	response7, err := locksCore.AcquireLock(&corepb.AcquireLockRequest{
+		LockId:    lockId,
+		Now:       now.Add(6 * time.Second).UnixNano(),
+		ProcessId: "process_2",
+		WriteLock: true,
+		ExpiresAt: now.Add(time.Hour).UnixNano(),
+		WaitUntil: now.Add(time.Minute).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.True(response7.Success)
+	require.False(response7.Waiting)
+	require.Equal(corepb.LockState_WRITE_LOCKED, response7.Lock.State)
+	require.Len(response7.Lock.Waiters, 1)
+	require.Equal("process_3", response7.Lock.Waiters[0].ProcessId)
+
+	// T+7s: Release lock by process_2, then process_3 gets it
+	_, err = locksCore.ReleaseLock(&corepb.ReleaseLockRequest{
+		LockId:    lockId,
+		Now:       now.Add(7 * time.Second).UnixNano(),
+		ProcessId: "process_2",
+	})
+	require.NoError(err)
+
+	response8, err := locksCore.AcquireLock(&corepb.AcquireLockRequest{
+		LockId:    lockId,
+		Now:       now.Add(8 * time.Second).UnixNano(),
+		ProcessId: "process_3",
+		WriteLock: false,
+		ExpiresAt: now.Add(time.Hour).UnixNano(),
+		WaitUntil: now.Add(time.Minute).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.True(response8.Success)
+	require.Equal(corepb.LockState_READ_LOCKED, response8.Lock.State)
+	require.Empty(response8.Lock.Waiters)
+}
+
+func TestAcquireLockWaitDeadline(t *testing.T) {
+	require := require.New(t)
+
+	locksCore := newLocksCore()
+
+	now := time.Now()
+
+	accountId := rand.Uint64()
+	lockId := &corepb.LockId{
+		AccountId:     accountId,
+		NamespaceName: "test_namespace",
+		LockName:      "test_lock",
+	}
+
+	// T+0: Acquire lock by process_1
+	_, err := locksCore.AcquireLock(&corepb.AcquireLockRequest{
+		LockId:    lockId,
+		Now:       now.UnixNano(),
+		ProcessId: "process_1",
+		WriteLock: true,
+		ExpiresAt: now.Add(time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+
+	// T+1s: process_2 waits for a minute
+	response1, err := locksCore.AcquireLock(&corepb.AcquireLockRequest{
+		LockId:    lockId,
+		Now:       now.Add(time.Second).UnixNano(),
+		ProcessId: "process_2",
+		WriteLock: true,
+		ExpiresAt: now.Add(time.Hour).UnixNano(),
+		WaitUntil: now.Add(time.Minute).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.True(response1.Waiting)
+
+	// T+2m: process_2 gave up, the waiter is dropped
+	response2, err := locksCore.GetLock(&corepb.GetLockRequest{
+		LockId: lockId,
+		Now:    now.Add(2 * time.Minute).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.Empty(response2.Lock.Waiters)
+
+	// T+3m: process_3 can wait for the lock after process_1 releases it
+	_, err = locksCore.ReleaseLock(&corepb.ReleaseLockRequest{
+		LockId:    lockId,
+		Now:       now.Add(3 * time.Minute).UnixNano(),
+		ProcessId: "process_1",
+	})
+	require.NoError(err)
+
+	response3, err := locksCore.AcquireLock(&corepb.AcquireLockRequest{
+		LockId:    lockId,
+		Now:       now.Add(3 * time.Minute).UnixNano(),
+		ProcessId: "process_3",
+		WriteLock: true,
+		ExpiresAt: now.Add(time.Hour).UnixNano(),
+		WaitUntil: now.Add(4 * time.Minute).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.True(response3.Success)
+	require.False(response3.Waiting)
+}
+
+func TestCancelLockWait(t *testing.T) {
+	require := require.New(t)
+
+	locksCore := newLocksCore()
+
+	now := time.Now()
+
+	accountId := rand.Uint64()
+	lockId := &corepb.LockId{
+		AccountId:     accountId,
+		NamespaceName: "test_namespace",
+		LockName:      "test_lock",
+	}
+
+	// T+0: Acquire lock for reads by process_1
+	_, err := locksCore.AcquireLock(&corepb.AcquireLockRequest{
+		LockId:    lockId,
+		Now:       now.UnixNano(),
+		ProcessId: "process_1",
+		WriteLock: false,
+		ExpiresAt: now.Add(time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+
+	// T+1s: process_1 and process_2 wait for a write lock
+	for _, processId := range []string{"process_1", "process_2"} {
+		response1, err := locksCore.AcquireLock(&corepb.AcquireLockRequest{
+			LockId:    lockId,
+			Now:       now.Add(time.Second).UnixNano(),
+			ProcessId: processId,
+			WriteLock: true,
+			ExpiresAt: now.Add(time.Hour).UnixNano(),
+			WaitUntil: now.Add(time.Minute).UnixNano(),
+		})
+		require.NoError(err)
+		require.True(response1.Waiting)
+	}
+
+	// T+2s: process_1 gives up waiting, but keeps its read lock
+	response2, err := locksCore.CancelLockWait(&corepb.CancelLockWaitRequest{
+		LockId:    lockId,
+		Now:       now.Add(2 * time.Second).UnixNano(),
+		ProcessId: "process_1",
+	})
+
+	require.NoError(err)
+	require.Equal(corepb.LockState_READ_LOCKED, response2.Lock.State)
+	require.Len(response2.Lock.ReadLockHolders, 1)
+	require.Len(response2.Lock.Waiters, 1)
+	require.Equal("process_2", response2.Lock.Waiters[0].ProcessId)
+
+	// T+3s: process_2 gives up waiting as well
+	_, err = locksCore.CancelLockWait(&corepb.CancelLockWaitRequest{
+		LockId:    lockId,
+		Now:       now.Add(3 * time.Second).UnixNano(),
+		ProcessId: "process_2",
+	})
+	require.NoError(err)
+
+	response3, err := locksCore.GetLock(&corepb.GetLockRequest{
+		LockId: lockId,
+		Now:    now.Add(3 * time.Second).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.Equal(corepb.LockState_READ_LOCKED, response3.Lock.State)
+	require.Empty(response3.Lock.Waiters)
+
+	// T+4s: canceling a wait for a nonexistent lock changes nothing
+	response4, err := locksCore.CancelLockWait(&corepb.CancelLockWaitRequest{
+		LockId: &corepb.LockId{
+			AccountId:     accountId,
+			NamespaceName: "test_namespace",
+			LockName:      "another_lock",
+		},
+		Now:       now.Add(4 * time.Second).UnixNano(),
+		ProcessId: "process_1",
+	})
+
+	require.NoError(err)
+	require.Equal(corepb.LockState_UNLOCKED, response4.Lock.State)
+}
+
+func TestMaxNumberOfLocksPerNamespace(t *testing.T) {
+	require := require.New(t)
+
+	locksCore := newLocksCore()
+
+	now := time.Now()
+
+	accountId := rand.Uint64()
+	lockId := func(name string) *corepb.LockId {
+		return &corepb.LockId{
+			AccountId:     accountId,
+			NamespaceName: "test_namespace",
+			LockName:      name,
+		}
+	}
+	acquire := func(name string, at time.Time) (*corepb.AcquireLockResponse, error) {
+		return locksCore.AcquireLock(&corepb.AcquireLockRequest{
+			LockId:                       lockId(name),
+			Now:                          at.UnixNano(),
+			ProcessId:                    "process_1",
+			WriteLock:                    true,
+			ExpiresAt:                    at.Add(time.Hour).UnixNano(),
+			MaxNumberOfLocksPerNamespace: 2,
+		})
+	}
+	locksCount := func() int64 {
+		txn := locksCore.badgerStore.View()
+		defer txn.Discard()
+
+		count, err := locksCore.getLocksCount(txn, lockId(""))
+		require.NoError(err)
+		return count
+	}
+
+	// T+0: Acquire 2 locks
+	_, err := acquire("lock_1", now)
+	require.NoError(err)
+	_, err = acquire("lock_2", now)
+	require.NoError(err)
+	require.EqualValues(2, locksCount())
+
+	// Extending an existing lock does not count
+	_, err = acquire("lock_2", now.Add(time.Minute))
+	require.NoError(err)
+	require.EqualValues(2, locksCount())
+
+	// T+1m: Limit reached
+	_, err = acquire("lock_3", now.Add(time.Minute))
+	require.Error(err)
+
+	// T+2m: Release lock_1, lock_3 can be acquired now
+	_, err = locksCore.ReleaseLock(&corepb.ReleaseLockRequest{
+		LockId:    lockId("lock_1"),
+		Now:       now.Add(2 * time.Minute).UnixNano(),
+		ProcessId: "process_1",
+	})
+	require.NoError(err)
+	require.EqualValues(1, locksCount())
+
+	_, err = acquire("lock_3", now.Add(2*time.Minute))
+	require.NoError(err)
+	require.EqualValues(2, locksCount())
+
+	// T+3h: lock_2 and lock_3 expired but are still stored, they are cleaned up when the limit is reached
+	require.EqualValues(2, locksCount())
+
+	_, err = acquire("lock_4", now.Add(3*time.Hour))
+	require.NoError(err)
+	require.EqualValues(1, locksCount())
+
+	_, err = acquire("lock_5", now.Add(3*time.Hour))
+	require.NoError(err)
+	require.EqualValues(2, locksCount())
+
+	// T+3h30m: DeleteLock removes lock_5
+	_, err = locksCore.DeleteLock(&corepb.DeleteLockRequest{
+		LockId: lockId("lock_5"),
+		Now:    now.Add(3*time.Hour + 30*time.Minute).UnixNano(),
+	})
+	require.NoError(err)
+	require.EqualValues(1, locksCount())
+
+	// T+5h: GetLock does not clean up expired lock_4, but the sweeper does
+	_, err = locksCore.GetLock(&corepb.GetLockRequest{
+		LockId: lockId("lock_4"),
+		Now:    now.Add(5 * time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+	require.EqualValues(1, locksCount())
+
+	_, err = locksCore.SweepExpiredLocks(&corepb.SweepExpiredLocksRequest{
+		Now:   now.Add(5 * time.Hour).UnixNano(),
+		Limit: 100,
+	})
+	require.NoError(err)
+	require.EqualValues(0, locksCount())
+}
+
+func TestListLocks(t *testing.T) {
+	require := require.New(t)
+
+	locksCore := newLocksCore()
+
+	now := time.Now()
+
+	accountId := rand.Uint64()
+	namespaceId := &corepb.NamespaceId{
+		AccountId:     accountId,
+		NamespaceName: "test_namespace",
+	}
+	acquire := func(namespaceName string, lockName string, processId string, writeLock bool, ttl time.Duration) {
+		response, err := locksCore.AcquireLock(&corepb.AcquireLockRequest{
+			LockId: &corepb.LockId{
+				AccountId:     accountId,
+				NamespaceName: namespaceName,
+				LockName:      lockName,
+			},
+			Now:       now.UnixNano(),
+			ProcessId: processId,
+			WriteLock: writeLock,
+			ExpiresAt: now.Add(ttl).UnixNano(),
+		})
+		require.NoError(err)
+		require.True(response.Success)
+	}
+	lockNames := func(locks []*corepb.Lock) []string {
+		names := make([]string, len(locks))
+		for i, lock := range locks {
+			names[i] = lock.Id.LockName
+		}
+		return names
+	}
+
+	acquire("test_namespace", "lock_1", "process_1", true, time.Hour)
+	acquire("test_namespace", "lock_2", "process_2", false, time.Hour)
+	acquire("test_namespace", "lock_2", "process_1", false, time.Minute)
+	acquire("test_namespace", "lock_3", "process_2", true, time.Minute)
+	acquire("test_namespace", "lock_4", "process_2", true, time.Hour)
+	acquire("test_namespace_2", "lock_5", "process_1", true, time.Hour)
+
+	// T+1s: All locks of the namespace
+	response1, err := locksCore.ListLocks(&corepb.ListLocksRequest{
+		NamespaceId: namespaceId,
+		Now:         now.Add(time.Second).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.Equal([]string{"lock_1", "lock_2", "lock_3", "lock_4"}, lockNames(response1.Locks))
+	require.Empty(response1.NextPageToken)
+
+	// T+1s: Paginate
+	response2, err := locksCore.ListLocks(&corepb.ListLocksRequest{
+		NamespaceId: namespaceId,
+		Now:         now.Add(time.Second).UnixNano(),
+		Limit:       3,
+	})
+
+	require.NoError(err)
+	require.Equal([]string{"lock_1", "lock_2", "lock_3"}, lockNames(response2.Locks))
+	require.Equal("lock_3", response2.NextPageToken)
+
+	response3, err := locksCore.ListLocks(&corepb.ListLocksRequest{
+		NamespaceId: namespaceId,
+		Now:         now.Add(time.Second).UnixNano(),
+		Limit:       3,
+		PageToken:   response2.NextPageToken,
+	})
+
+	require.NoError(err)
+	require.Equal([]string{"lock_4"}, lockNames(response3.Locks))
+	require.Empty(response3.NextPageToken)
+
+	// A page token which is a prefix of lock names is not a lock name itself
+	response3, err = locksCore.ListLocks(&corepb.ListLocksRequest{
+		NamespaceId: namespaceId,
+		Now:         now.Add(time.Second).UnixNano(),
+		Limit:       1,
+		PageToken:   "lock_",
+	})
+
+	require.NoError(err)
+	require.Equal([]string{"lock_1"}, lockNames(response3.Locks))
+
+	// T+1s: Filter by state and process id
+	response4, err := locksCore.ListLocks(&corepb.ListLocksRequest{
+		NamespaceId: namespaceId,
+		Now:         now.Add(time.Second).UnixNano(),
+		States:      []corepb.LockState{corepb.LockState_WRITE_LOCKED},
+		ProcessId:   "process_2",
+	})
+
+	require.NoError(err)
+	require.Equal([]string{"lock_3", "lock_4"}, lockNames(response4.Locks))
+
+	// T+2m: Expired locks and holders are not listed
+	response5, err := locksCore.ListLocks(&corepb.ListLocksRequest{
+		NamespaceId: namespaceId,
+		Now:         now.Add(2 * time.Minute).UnixNano(),
+		ProcessId:   "process_1",
+	})
+
+	require.NoError(err)
+	require.Equal([]string{"lock_1"}, lockNames(response5.Locks))
+
+	response6, err := locksCore.ListLocks(&corepb.ListLocksRequest{
+		NamespaceId: namespaceId,
+		Now:         now.Add(2 * time.Minute).UnixNano(),
+		States:      []corepb.LockState{corepb.LockState_READ_LOCKED},
+	})
+
+	require.NoError(err)
+	require.Equal([]string{"lock_2"}, lockNames(response6.Locks))
+	require.Len(response6.Locks[0].ReadLockHolders, 1)
+}
+
+func TestSweepExpiredLocks(t *testing.T) {
+	require := require.New(t)
+
+	locksCore := newLocksCore()
+
+	now := time.Now()
+
+	accountId := rand.Uint64()
+	lockId := func(namespaceName string, lockName string) *corepb.LockId {
+		return &corepb.LockId{
+			AccountId:     accountId,
+			NamespaceName: namespaceName,
+			LockName:      lockName,
+		}
+	}
+	acquire := func(lockId *corepb.LockId, processId string, writeLock bool, ttl time.Duration) {
+		response, err := locksCore.AcquireLock(&corepb.AcquireLockRequest{
+			LockId:    lockId,
+			Now:       now.UnixNano(),
+			ProcessId: processId,
+			WriteLock: writeLock,
+			ExpiresAt: now.Add(ttl).UnixNano(),
+		})
+		require.NoError(err)
+		require.True(response.Success)
+	}
+	sweep := func(at time.Time, limit int32) int32 {
+		response, err := locksCore.SweepExpiredLocks(&corepb.SweepExpiredLocksRequest{
+			Now:   at.UnixNano(),
+			Limit: limit,
+		})
+		require.NoError(err)
+		return response.SweptLocks
+	}
+	stored := func(lockId *corepb.LockId) bool {
+		txn := locksCore.badgerStore.View()
+		defer txn.Discard()
+
+		_, err := locksCore.getLock(txn, lockId)
+		return err == nil
+	}
+
+	acquire(lockId("namespace_1", "lock_1"), "process_1", true, time.Minute)
+	acquire(lockId("namespace_1", "lock_2"), "process_1", true, 2*time.Minute)
+	acquire(lockId("namespace_2", "lock_3"), "process_1", true, 3*time.Minute)
+	acquire(lockId("namespace_2", "lock_4"), "process_1", false, time.Minute)
+	acquire(lockId("namespace_2", "lock_4"), "process_2", false, time.Hour)
+
+	// T+30s: Nothing expired yet
+	require.EqualValues(0, sweep(now.Add(30*time.Second), 100))
+
+	// A limit is required
+	_, err := locksCore.SweepExpiredLocks(&corepb.SweepExpiredLocksRequest{
+		Now:   now.Add(time.Hour).UnixNano(),
+		Limit: 0,
+	})
+	require.Error(err)
+
+	// T+150s: lock_1 and lock_2 expired, but limit is 1
+	require.EqualValues(1, sweep(now.Add(150*time.Second), 1))
+	require.False(stored(lockId("namespace_1", "lock_1")))
+	require.True(stored(lockId("namespace_1", "lock_2")))
+
+	// T+150s: lock_2 is swept, and lock_4 still has an unexpired holder, so it stays
+	require.EqualValues(1, sweep(now.Add(150*time.Second), 100))
+	require.False(stored(lockId("namespace_1", "lock_2")))
+	require.True(stored(lockId("namespace_2", "lock_3")))
+	require.True(stored(lockId("namespace_2", "lock_4")))
+
+	// T+2h: Everything is swept and counters are back to zero
+	require.EqualValues(2, sweep(now.Add(2*time.Hour), 100))
+	require.False(stored(lockId("namespace_2", "lock_3")))
+	require.False(stored(lockId("namespace_2", "lock_4")))
+	require.EqualValues(0, sweep(now.Add(2*time.Hour), 100))
+
+	txn := locksCore.badgerStore.View()
+	defer txn.Discard()
+
+	count, err := locksCore.getLocksCount(txn, lockId("namespace_1", ""))
+	require.NoError(err)
+	require.EqualValues(0, count)
+
+	count, err = locksCore.getLocksCount(txn, lockId("namespace_2", ""))
+	require.NoError(err)
+	require.EqualValues(0, count)
+}
+
+func TestSweepExpiredLocksAfterExtension(t *testing.T) {
+	require := require.New(t)
+
+	locksCore := newLocksCore()
+
+	now := time.Now()
+
+	lockId := &corepb.LockId{
+		AccountId:     rand.Uint64(),
+		NamespaceName: "test_namespace",
+		LockName:      "test_lock",
+	}
+
+	// T+0: Acquire lock for a minute
+	_, err := locksCore.AcquireLock(&corepb.AcquireLockRequest{
+		LockId:    lockId,
+		Now:       now.UnixNano(),
+		ProcessId: "process_1",
+		WriteLock: true,
+		ExpiresAt: now.Add(time.Minute).UnixNano(),
+	})
+	require.NoError(err)
+
+	// T+30s: Extend lock for an hour
+	_, err = locksCore.AcquireLock(&corepb.AcquireLockRequest{
+		LockId:    lockId,
+		Now:       now.Add(30 * time.Second).UnixNano(),
+		ProcessId: "process_1",
+		WriteLock: true,
+		ExpiresAt: now.Add(time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+
+	// T+2m: Lock is not swept
+	response1, err := locksCore.SweepExpiredLocks(&corepb.SweepExpiredLocksRequest{
+		Now:   now.Add(2 * time.Minute).UnixNano(),
+		Limit: 100,
+	})
+	require.NoError(err)
+	require.EqualValues(0, response1.SweptLocks)
+
+	response2, err := locksCore.GetLock(&corepb.GetLockRequest{
+		LockId: lockId,
+		Now:    now.Add(2 * time.Minute).UnixNano(),
+	})
+	require.NoError(err)
+	require.Equal(corepb.LockState_WRITE_LOCKED, response2.Lock.State)
+
+	// T+2h: Lock is swept
+	response3, err := locksCore.SweepExpiredLocks(&corepb.SweepExpiredLocksRequest{
+		Now:   now.Add(2 * time.Hour).UnixNano(),
+		Limit: 100,
+	})
+	require.NoError(err)
+	require.EqualValues(1, response3.SweptLocks)
+}
+
+func TestGetLockDoesNotChangeStorage(t *testing.T) {
+	require := require.New(t)
+
+	locksCore := newLocksCore()
+
+	now := time.Now()
+
+	lockId := &corepb.LockId{
+		AccountId:     rand.Uint64(),
+		NamespaceName: "test_namespace",
+		LockName:      "test_lock",
+	}
+
+	// T+0: Acquire lock for a minute
+	_, err := locksCore.AcquireLock(&corepb.AcquireLockRequest{
+		LockId:    lockId,
+		Now:       now.UnixNano(),
+		ProcessId: "process_1",
+		WriteLock: true,
+		ExpiresAt: now.Add(time.Minute).UnixNano(),
+	})
+	require.NoError(err)
+
+	// T+2m: Lock is expired
+	response1, err := locksCore.GetLock(&corepb.GetLockRequest{
+		LockId: lockId,
+		Now:    now.Add(2 * time.Minute).UnixNano(),
+	})
+	require.NoError(err)
+	require.Equal(corepb.LockState_UNLOCKED, response1.Lock.State)
+
+	response2, err := locksCore.GetLockFromFollower(&corepb.GetLockFromFollowerRequest{
+		LockId: lockId,
+		Now:    now.Add(2 * time.Minute).UnixNano(),
+	})
+	require.NoError(err)
+	require.Equal(corepb.LockState_UNLOCKED, response2.Lock.State)
+
+	// But it is still stored until the sweeper deletes it
+	txn := locksCore.badgerStore.View()
+	defer txn.Discard()
+
+	lock, err := locksCore.getLock(txn, lockId)
+	require.NoError(err)
+	require.Equal(corepb.LockState_WRITE_LOCKED, lock.State)
+}
+
+func TestUpgradeLock(t *testing.T) {
+	require := require.New(t)
+
+	locksCore := newLocksCore()
+
+	now := time.Now()
+
+	lockId := &corepb.LockId{
+		AccountId:     rand.Uint64(),
+		NamespaceName: "test_namespace",
+		LockName:      "test_lock",
+	}
+
+	// T+0: Acquire read lock by process 1
+	response1, err := locksCore.AcquireLock(&corepb.AcquireLockRequest{
+		LockId:    lockId,
+		Now:       now.UnixNano(),
+		ProcessId: "process_1",
+		WriteLock: false,
+		ExpiresAt: now.Add(time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+	require.True(response1.Success)
+
+	// T+1m: Process 2 queues for a write lock
+	response2, err := locksCore.AcquireLock(&corepb.AcquireLockRequest{
+		LockId:    lockId,
+		Now:       now.Add(time.Minute).UnixNano(),
+		ProcessId: "process_2",
+		WriteLock: true,
+		ExpiresAt: now.Add(time.Hour).UnixNano(),
+		WaitUntil: now.Add(time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+	require.False(response2.Success)
+	require.True(response2.Waiting)
+
+	// T+2m: Process 1 is the sole reader, upgrade goes ahead of the queued writer
+	response3, err := locksCore.ConvertLock(&corepb.ConvertLockRequest{
+		LockId:    lockId,
+		ProcessId: "process_1",
+		Now:       now.Add(2 * time.Minute).UnixNano(),
+		WriteLock: true,
+		ExpiresAt: now.Add(2 * time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+	require.True(response3.Success)
+	require.Equal(corepb.LockState_WRITE_LOCKED, response3.Lock.State)
+	require.Empty(response3.Lock.ReadLockHolders)
+	require.Equal("process_1", response3.Lock.WriteLockHolder.ProcessId)
+	require.EqualValues(now.Add(2*time.Hour).UnixNano(), response3.Lock.WriteLockHolder.ExpiresAt)
+	require.Greater(response3.FencingToken, response1.FencingToken)
+	require.Equal(response3.FencingToken, response3.Lock.WriteLockHolder.FencingToken)
+	require.Len(response3.Lock.Waiters, 1)
+
+	// T+3m: Upgrading again just keeps the write lock
+	response4, err := locksCore.ConvertLock(&corepb.ConvertLockRequest{
+		LockId:    lockId,
+		ProcessId: "process_1",
+		Now:       now.Add(3 * time.Minute).UnixNano(),
+		WriteLock: true,
+	})
+	require.NoError(err)
+	require.True(response4.Success)
+	require.Equal(corepb.LockState_WRITE_LOCKED, response4.Lock.State)
+	require.EqualValues(now.Add(2*time.Hour).UnixNano(), response4.Lock.WriteLockHolder.ExpiresAt)
+}
+
+func TestUpgradeLockWithAnotherReader(t *testing.T) {
+	require := require.New(t)
+
+	locksCore := newLocksCore()
+
+	now := time.Now()
+
+	lockId := &corepb.LockId{
+		AccountId:     rand.Uint64(),
+		NamespaceName: "test_namespace",
+		LockName:      "test_lock",
+	}
+
+	// T+0: Acquire read locks by process 1 and process 2
+	for _, processId := range []string{"process_1", "process_2"} {
+		response, err := locksCore.AcquireLock(&corepb.AcquireLockRequest{
+			LockId:    lockId,
+			Now:       now.UnixNano(),
+			ProcessId: processId,
+			WriteLock: false,
+			ExpiresAt: now.Add(time.Hour).UnixNano(),
+		})
+		require.NoError(err)
+		require.True(response.Success)
+	}
+
+	// T+1m: Upgrade by process 1 fails, lock is not changed
+	response1, err := locksCore.ConvertLock(&corepb.ConvertLockRequest{
+		LockId:    lockId,
+		ProcessId: "process_1",
+		Now:       now.Add(time.Minute).UnixNano(),
+		WriteLock: true,
+	})
+	require.NoError(err)
+	require.False(response1.Success)
+	require.Equal(corepb.LockState_READ_LOCKED, response1.Lock.State)
+	require.Len(response1.Lock.ReadLockHolders, 2)
+
+	// T+2m: Upgrade by process 2 fails as well
+	response2, err := locksCore.ConvertLock(&corepb.ConvertLockRequest{
+		LockId:    lockId,
+		ProcessId: "process_2",
+		Now:       now.Add(2 * time.Minute).UnixNano(),
+		WriteLock: true,
+	})
+	require.NoError(err)
+	require.False(response2.Success)
+
+	// T+3m: Both readers still hold the lock
+	response3, err := locksCore.GetLock(&corepb.GetLockRequest{
+		LockId: lockId,
+		Now:    now.Add(3 * time.Minute).UnixNano(),
+	})
+	require.NoError(err)
+	require.Equal(corepb.LockState_READ_LOCKED, response3.Lock.State)
+	require.Len(response3.Lock.ReadLockHolders, 2)
+
+	// T+4m: Process 2 releases the lock
+	_, err = locksCore.ReleaseLock(&corepb.ReleaseLockRequest{
+		LockId:    lockId,
+		ProcessId: "process_2",
+		Now:       now.Add(4 * time.Minute).UnixNano(),
+	})
+	require.NoError(err)
+
+	// T+5m: Now process 1 is the sole reader and can upgrade
+	response4, err := locksCore.ConvertLock(&corepb.ConvertLockRequest{
+		LockId:    lockId,
+		ProcessId: "process_1",
+		Now:       now.Add(5 * time.Minute).UnixNano(),
+		WriteLock: true,
+	})
+	require.NoError(err)
+	require.True(response4.Success)
+	require.Equal(corepb.LockState_WRITE_LOCKED, response4.Lock.State)
+	require.Equal("process_1", response4.Lock.WriteLockHolder.ProcessId)
+}
+
+func TestUpgradeLockWithExpiredReader(t *testing.T) {
+	require := require.New(t)
+
+	locksCore := newLocksCore()
+
+	now := time.Now()
+
+	lockId := &corepb.LockId{
+		AccountId:     rand.Uint64(),
+		NamespaceName: "test_namespace",
+		LockName:      "test_lock",
+	}
+
+	// T+0: Acquire read lock by process 1 for an hour and by process 2 for a minute
+	_, err := locksCore.AcquireLock(&corepb.AcquireLockRequest{
+		LockId:    lockId,
+		Now:       now.UnixNano(),
+		ProcessId: "process_1",
+		WriteLock: false,
+		ExpiresAt: now.Add(time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+
+	_, err = locksCore.AcquireLock(&corepb.AcquireLockRequest{
+		LockId:    lockId,
+		Now:       now.UnixNano(),
+		ProcessId: "process_2",
+		WriteLock: false,
+		ExpiresAt: now.Add(time.Minute).UnixNano(),
+	})
+	require.NoError(err)
+
+	// T+2m: Read lock of process 2 expired, upgrade succeeds
+	response1, err := locksCore.ConvertLock(&corepb.ConvertLockRequest{
+		LockId:    lockId,
+		ProcessId: "process_1",
+		Now:       now.Add(2 * time.Minute).UnixNano(),
+		WriteLock: true,
+	})
+	require.NoError(err)
+	require.True(response1.Success)
+	require.Equal(corepb.LockState_WRITE_LOCKED, response1.Lock.State)
+}
+
+func TestDowngradeLock(t *testing.T) {
+	require := require.New(t)
+
+	locksCore := newLocksCore()
+
+	now := time.Now()
+
+	lockId := &corepb.LockId{
+		AccountId:     rand.Uint64(),
+		NamespaceName: "test_namespace",
+		LockName:      "test_lock",
+	}
+
+	// T+0: Acquire write lock by process 1
+	response1, err := locksCore.AcquireLock(&corepb.AcquireLockRequest{
+		LockId:    lockId,
+		Now:       now.UnixNano(),
+		ProcessId: "process_1",
+		WriteLock: true,
+		ExpiresAt: now.Add(time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+	require.True(response1.Success)
+
+	// T+1m: Read lock by process 2 fails
+	response2, err := locksCore.AcquireLock(&corepb.AcquireLockRequest{
+		LockId:    lockId,
+		Now:       now.Add(time.Minute).UnixNano(),
+		ProcessId: "process_2",
+		WriteLock: false,
+		ExpiresAt: now.Add(time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+	require.False(response2.Success)
+
+	// T+2m: Downgrade to read lock
+	response3, err := locksCore.ConvertLock(&corepb.ConvertLockRequest{
+		LockId:    lockId,
+		ProcessId: "process_1",
+		Now:       now.Add(2 * time.Minute).UnixNano(),
+		WriteLock: false,
+	})
+	require.NoError(err)
+	require.True(response3.Success)
+	require.Equal(corepb.LockState_READ_LOCKED, response3.Lock.State)
+	require.Nil(response3.Lock.WriteLockHolder)
+	require.Len(response3.Lock.ReadLockHolders, 1)
+	require.Equal("process_1", response3.Lock.ReadLockHolders[0].ProcessId)
+	require.EqualValues(now.Add(time.Hour).UnixNano(), response3.Lock.ReadLockHolders[0].ExpiresAt)
+	require.Equal(response1.FencingToken, response3.FencingToken)
+
+	// T+3m: Read lock by process 2 succeeds now
+	response4, err := locksCore.AcquireLock(&corepb.AcquireLockRequest{
+		LockId:    lockId,
+		Now:       now.Add(3 * time.Minute).UnixNano(),
+		ProcessId: "process_2",
+		WriteLock: false,
+		ExpiresAt: now.Add(time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+	require.True(response4.Success)
+	require.Len(response4.Lock.ReadLockHolders, 2)
+}
+
+func TestConvertLockNotHeld(t *testing.T) {
+	require := require.New(t)
+
+	locksCore := newLocksCore()
+
+	now := time.Now()
+
+	lockId := &corepb.LockId{
+		AccountId:     rand.Uint64(),
+		NamespaceName: "test_namespace",
+		LockName:      "test_lock",
+	}
+
+	// T+0: Nonexistent lock
+	_, err := locksCore.ConvertLock(&corepb.ConvertLockRequest{
+		LockId:    lockId,
+		ProcessId: "process_1",
+		Now:       now.UnixNano(),
+		WriteLock: true,
+	})
+	require.Error(err)
+
+	// T+1m: Acquire write lock by process 1 for a minute
+	_, err = locksCore.AcquireLock(&corepb.AcquireLockRequest{
+		LockId:    lockId,
+		Now:       now.Add(time.Minute).UnixNano(),
+		ProcessId: "process_1",
+		WriteLock: true,
+		ExpiresAt: now.Add(2 * time.Minute).UnixNano(),
+	})
+	require.NoError(err)
+
+	// T+1m: Process 2 does not hold the lock
+	_, err = locksCore.ConvertLock(&corepb.ConvertLockRequest{
+		LockId:    lockId,
+		ProcessId: "process_2",
+		Now:       now.Add(time.Minute).UnixNano(),
+		WriteLock: false,
+	})
+	require.Error(err)
+
+	// T+3m: Lock of process 1 expired
+	_, err = locksCore.ConvertLock(&corepb.ConvertLockRequest{
+		LockId:    lockId,
+		ProcessId: "process_1",
+		Now:       now.Add(3 * time.Minute).UnixNano(),
+		WriteLock: false,
+	})
+	require.Error(err)
+}
+
+func TestAcquireLocks(t *testing.T) {
+	require := require.New(t)
+
+	locksCore := newLocksCore()
+
+	now := time.Now()
+
+	namespaceId := &corepb.NamespaceId{
+		AccountId:     rand.Uint64(),
+		NamespaceName: "test_namespace",
+	}
+
+	// T+0: Acquire 3 locks by process 1
+	response1, err := locksCore.AcquireLocks(&corepb.AcquireLocksRequest{
+		NamespaceId: namespaceId,
+		LockNames:   []string{"lock_1", "lock_2", "lock_3"},
+		ProcessId:   "process_1",
+		WriteLock:   true,
+		Now:         now.UnixNano(),
+		ExpiresAt:   now.Add(time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+	require.True(response1.Success)
+	require.Len(response1.Results, 3)
+	for i, result := range response1.Results {
+		require.Equal(fmt.Sprintf("lock_%d", i+1), result.Lock.Id.LockName)
+		require.Equal(corepb.LockState_WRITE_LOCKED, result.Lock.State)
+		require.Equal("process_1", result.Lock.WriteLockHolder.ProcessId)
+		require.NotZero(result.FencingToken)
+		require.Empty(result.BlockedBy)
+	}
+
+	// T+1m: Each lock is held by process 1
+	for _, lockName := range []string{"lock_1", "lock_2", "lock_3"} {
+		response, err := locksCore.GetLock(&corepb.GetLockRequest{
+			LockId: &corepb.LockId{
+				AccountId:     namespaceId.AccountId,
+				NamespaceName: namespaceId.NamespaceName,
+				LockName:      lockName,
+			},
+			Now: now.Add(time.Minute).UnixNano(),
+		})
+		require.NoError(err)
+		require.Equal(corepb.LockState_WRITE_LOCKED, response.Lock.State)
+		require.Equal("process_1", response.Lock.WriteLockHolder.ProcessId)
+	}
+
+	// T+2m: Release 2 of them
+	response2, err := locksCore.ReleaseLocks(&corepb.ReleaseLocksRequest{
+		NamespaceId: namespaceId,
+		LockNames:   []string{"lock_1", "lock_2"},
+		ProcessId:   "process_1",
+		Now:         now.Add(2 * time.Minute).UnixNano(),
+	})
+	require.NoError(err)
+	require.Len(response2.Locks, 2)
+	require.Equal(corepb.LockState_UNLOCKED, response2.Locks[0].State)
+	require.Equal(corepb.LockState_UNLOCKED, response2.Locks[1].State)
+
+	// T+3m: Process 2 can take the released locks
+	response3, err := locksCore.AcquireLocks(&corepb.AcquireLocksRequest{
+		NamespaceId: namespaceId,
+		LockNames:   []string{"lock_2", "lock_1"},
+		ProcessId:   "process_2",
+		WriteLock:   true,
+		Now:         now.Add(3 * time.Minute).UnixNano(),
+		ExpiresAt:   now.Add(time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+	require.True(response3.Success)
+	require.Equal("lock_2", response3.Results[0].Lock.Id.LockName)
+	require.Equal("lock_1", response3.Results[1].Lock.Id.LockName)
+}
+
+func TestAcquireLocksAllOrNone(t *testing.T) {
+	require := require.New(t)
+
+	locksCore := newLocksCore()
+
+	now := time.Now()
+
+	namespaceId := &corepb.NamespaceId{
+		AccountId:     rand.Uint64(),
+		NamespaceName: "test_namespace",
+	}
+	lockId2 := &corepb.LockId{
+		AccountId:     namespaceId.AccountId,
+		NamespaceName: namespaceId.NamespaceName,
+		LockName:      "lock_2",
+	}
+
+	// T+0: Process 2 holds lock 2 for reads
+	response1, err := locksCore.AcquireLock(&corepb.AcquireLockRequest{
+		LockId:    lockId2,
+		Now:       now.UnixNano(),
+		ProcessId: "process_2",
+		WriteLock: false,
+		ExpiresAt: now.Add(time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+	require.True(response1.Success)
+
+	// T+1m: Process 1 wants 3 locks for writes, lock 2 is blocked by process 2
+	response2, err := locksCore.AcquireLocks(&corepb.AcquireLocksRequest{
+		NamespaceId: namespaceId,
+		LockNames:   []string{"lock_1", "lock_2", "lock_3"},
+		ProcessId:   "process_1",
+		WriteLock:   true,
+		Now:         now.Add(time.Minute).UnixNano(),
+		ExpiresAt:   now.Add(time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+	require.False(response2.Success)
+	require.Len(response2.Results, 3)
+	require.Equal(corepb.LockState_UNLOCKED, response2.Results[0].Lock.State)
+	require.Empty(response2.Results[0].BlockedBy)
+	require.Zero(response2.Results[0].FencingToken)
+	require.Equal(corepb.LockState_READ_LOCKED, response2.Results[1].Lock.State)
+	require.Equal([]string{"process_2"}, response2.Results[1].BlockedBy)
+	require.Equal(corepb.LockState_UNLOCKED, response2.Results[2].Lock.State)
+
+	// Nothing is acquired, unlocked locks are not even created
+	txn := locksCore.badgerStore.View()
+	_, err = locksCore.getLock(txn, &corepb.LockId{
+		AccountId:     namespaceId.AccountId,
+		NamespaceName: namespaceId.NamespaceName,
+		LockName:      "lock_1",
+	})
+	require.ErrorIs(err, monstera.ErrNotFound)
+	count, err := locksCore.getLocksCount(txn, lockId2)
+	require.NoError(err)
+	require.EqualValues(1, count)
+	txn.Discard()
+
+	// T+2m: Process 2 releases lock 2
+	_, err = locksCore.ReleaseLock(&corepb.ReleaseLockRequest{
+		LockId:    lockId2,
+		ProcessId: "process_2",
+		Now:       now.Add(2 * time.Minute).UnixNano(),
+	})
+	require.NoError(err)
+
+	// T+3m: Now all 3 locks are acquired. Fencing tokens of the failed attempt were not used.
+	response3, err := locksCore.AcquireLocks(&corepb.AcquireLocksRequest{
+		NamespaceId: namespaceId,
+		LockNames:   []string{"lock_1", "lock_2", "lock_3"},
+		ProcessId:   "process_1",
+		WriteLock:   true,
+		Now:         now.Add(3 * time.Minute).UnixNano(),
+		ExpiresAt:   now.Add(time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+	require.True(response3.Success)
+	require.Equal(response1.FencingToken+1, response3.Results[0].FencingToken)
+	require.Equal(response1.FencingToken+2, response3.Results[1].FencingToken)
+	require.Equal(response1.FencingToken+3, response3.Results[2].FencingToken)
+}
+
+func TestAcquireLocksBlockedByWaiters(t *testing.T) {
+	require := require.New(t)
+
+	locksCore := newLocksCore()
+
+	now := time.Now()
+
+	namespaceId := &corepb.NamespaceId{
+		AccountId:     rand.Uint64(),
+		NamespaceName: "test_namespace",
+	}
+	lockId := &corepb.LockId{
+		AccountId:     namespaceId.AccountId,
+		NamespaceName: namespaceId.NamespaceName,
+		LockName:      "lock_1",
+	}
+
+	// T+0: Process 2 holds the lock for reads
+	_, err := locksCore.AcquireLock(&corepb.AcquireLockRequest{
+		LockId:    lockId,
+		Now:       now.UnixNano(),
+		ProcessId: "process_2",
+		WriteLock: false,
+		ExpiresAt: now.Add(time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+
+	// T+1m: Process 3 queues for writes
+	_, err = locksCore.AcquireLock(&corepb.AcquireLockRequest{
+		LockId:    lockId,
+		Now:       now.Add(time.Minute).UnixNano(),
+		ProcessId: "process_3",
+		WriteLock: true,
+		ExpiresAt: now.Add(time.Hour).UnixNano(),
+		WaitUntil: now.Add(time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+
+	// T+2m: Read locks by process 1 are blocked by the queued writer
+	response1, err := locksCore.AcquireLocks(&corepb.AcquireLocksRequest{
+		NamespaceId: namespaceId,
+		LockNames:   []string{"lock_1"},
+		ProcessId:   "process_1",
+		WriteLock:   false,
+		Now:         now.Add(2 * time.Minute).UnixNano(),
+		ExpiresAt:   now.Add(time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+	require.False(response1.Success)
+	require.Equal([]string{"process_3"}, response1.Results[0].BlockedBy)
+}
+
+func TestAcquireLocksMaxNumberOfLocksPerNamespace(t *testing.T) {
+	require := require.New(t)
+
+	locksCore := newLocksCore()
+
+	now := time.Now()
+
+	namespaceId := &corepb.NamespaceId{
+		AccountId:     rand.Uint64(),
+		NamespaceName: "test_namespace",
+	}
+
+	// T+0: 3 locks do not fit into the limit of 2, nothing is created
+	_, err := locksCore.AcquireLocks(&corepb.AcquireLocksRequest{
+		NamespaceId: namespaceId,
+		LockNames:   []string{"lock_1", "lock_2", "lock_3"},
+		ProcessId:   "process_1",
+		WriteLock:   true,
+		Now:         now.UnixNano(),
+		ExpiresAt:   now.Add(time.Hour).UnixNano(),
+
+		MaxNumberOfLocksPerNamespace: 2,
+	})
+	require.Error(err)
+
+	txn := locksCore.badgerStore.View()
+	count, err := locksCore.getLocksCount(txn, &corepb.LockId{
+		AccountId:     namespaceId.AccountId,
+		NamespaceName: namespaceId.NamespaceName,
+	})
+	require.NoError(err)
+	require.EqualValues(0, count)
+	txn.Discard()
+
+	// T+1m: 2 locks fit
+	response1, err := locksCore.AcquireLocks(&corepb.AcquireLocksRequest{
+		NamespaceId: namespaceId,
+		LockNames:   []string{"lock_1", "lock_2"},
+		ProcessId:   "process_1",
+		WriteLock:   true,
+		Now:         now.Add(time.Minute).UnixNano(),
+		ExpiresAt:   now.Add(time.Hour).UnixNano(),
+
+		MaxNumberOfLocksPerNamespace: 2,
+	})
+	require.NoError(err)
+	require.True(response1.Success)
+}
+
 func newLocksCore() *LocksCore {
 	return NewLocksCore(monstera.NewBadgerInMemoryStore(), []byte{0x00, 0x00}, []byte{0xff, 0xff})
 }
@@ -85,10 +85,13 @@ func (c *NamespacesCore) CreateNamespace(request *corepb.CreateNamespaceRequest)
 	}
 
 	namespace := &corepb.Namespace{
-		Id:          namespaceId,
-		Description: request.Description,
-		CreatedAt:   request.Now,
-		UpdatedAt:   request.Now,
+		Id:             namespaceId,
+		Description:    request.Description,
+		CreatedAt:      request.Now,
+		UpdatedAt:      request.Now,
+		MinLockTtl:     request.MinLockTtl,
+		DefaultLockTtl: request.DefaultLockTtl,
+		MaxLockTtl:     request.MaxLockTtl,
 	}
 
 	err = c.createNamespace(txn, namespace)
@@ -121,6 +124,25 @@ func (c *NamespacesCore) UpdateNamespace(request *corepb.UpdateNamespaceRequest)
 	namespace.Description = request.Description
 	namespace.UpdatedAt = request.Now
 
+	// Lock TTLs which are not set keep their current values
+	if request.MinLockTtl != 0 {
+		namespace.MinLockTtl = request.MinLockTtl
+	}
+	if request.DefaultLockTtl != 0 {
+		namespace.DefaultLockTtl = request.DefaultLockTtl
+	}
+	if request.MaxLockTtl != 0 {
+		namespace.MaxLockTtl = request.MaxLockTtl
+	}
+
+	minLockTtl, defaultLockTtl, maxLockTtl := namespaceLockTtls(namespace)
+	if minLockTtl > defaultLockTtl || defaultLockTtl > maxLockTtl {
+		return nil, monsterax.NewErrorWithContext(
+			monsterax.InvalidArgument,
+			"lock TTLs should satisfy min <= default <= max",
+			map[string]string{"namespace_name": request.NamespaceId.NamespaceName})
+	}
+
 	err = c.updateNamespace(txn, namespace)
 	panicIfNotNil(err)
 
@@ -124,6 +124,74 @@ func TestMaxNumberOfNamespaces(t *testing.T) {
 	require.Error(err)
 }
 
+func TestNamespaceLockTtls(t *testing.T) {
+	require := require.New(t)
+
+	namespacesCore := newNamespacesCore()
+
+	now := time.Now()
+
+	// Create namespace with lock TTL limits
+	response1, err := namespacesCore.CreateNamespace(&corepb.CreateNamespaceRequest{
+		AccountId:             rand.Uint64(),
+		Name:                  "test_namespace",
+		Now:                   now.UnixNano(),
+		MaxNumberOfNamespaces: 20,
+		MinLockTtl:            1000,
+		DefaultLockTtl:        5000,
+		MaxLockTtl:            10000,
+	})
+
+	require.NoError(err)
+
+	// Update lock TTL limits
+	_, err = namespacesCore.UpdateNamespace(&corepb.UpdateNamespaceRequest{
+		NamespaceId:    response1.Namespace.Id,
+		Now:            now.Add(time.Minute).UnixNano(),
+		MinLockTtl:     2000,
+		DefaultLockTtl: 10000,
+		MaxLockTtl:     60000,
+	})
+
+	require.NoError(err)
+
+	response2, err := namespacesCore.GetNamespace(&corepb.GetNamespaceRequest{
+		NamespaceId: response1.Namespace.Id,
+	})
+
+	require.NoError(err)
+	require.EqualValues(2000, response2.Namespace.MinLockTtl)
+	require.EqualValues(10000, response2.Namespace.DefaultLockTtl)
+	require.EqualValues(60000, response2.Namespace.MaxLockTtl)
+
+	// Leases are checked against these limits
+	require.NoError(validateLockLease("AcquireLockRequest", 10000, response2.Namespace))
+	require.Error(validateLockLease("AcquireLockRequest", 1000, response2.Namespace))
+	require.Error(validateLockLease("AcquireLockRequest", 120000, response2.Namespace))
+
+	// TTLs which are not set keep their values
+	response3, err := namespacesCore.UpdateNamespace(&corepb.UpdateNamespaceRequest{
+		NamespaceId: response1.Namespace.Id,
+		Now:         now.Add(2 * time.Minute).UnixNano(),
+		Description: "new description",
+		MaxLockTtl:  30000,
+	})
+
+	require.NoError(err)
+	require.EqualValues(2000, response3.Namespace.MinLockTtl)
+	require.EqualValues(10000, response3.Namespace.DefaultLockTtl)
+	require.EqualValues(30000, response3.Namespace.MaxLockTtl)
+
+	// Merged TTLs should still satisfy min <= default <= max
+	_, err = namespacesCore.UpdateNamespace(&corepb.UpdateNamespaceRequest{
+		NamespaceId: response1.Namespace.Id,
+		Now:         now.Add(3 * time.Minute).UnixNano(),
+		MaxLockTtl:  5000,
+	})
+
+	require.Error(err)
+}
+
 func newNamespacesCore() *NamespacesCore {
 	return NewNamespacesCore(monstera.NewBadgerInMemoryStore(), []byte{0x00, 0x00}, []byte{0xff, 0xff})
 }
@@ -10,11 +10,16 @@ func namespaceToFront(namespace *corepb.Namespace) *gatewaypb.Namespace {
 		return nil
 	}
 
+	minLockTtl, defaultLockTtl, maxLockTtl := namespaceLockTtls(namespace)
+
 	return &gatewaypb.Namespace{
-		Name:        namespace.Id.NamespaceName,
-		Description: namespace.Description,
-		CreatedAt:   namespace.CreatedAt,
-		UpdatedAt:   namespace.UpdatedAt,
+		Name:           namespace.Id.NamespaceName,
+		Description:    namespace.Description,
+		CreatedAt:      namespace.CreatedAt,
+		UpdatedAt:      namespace.UpdatedAt,
+		MinLockTtl:     minLockTtl,
+		DefaultLockTtl: defaultLockTtl,
+		MaxLockTtl:     maxLockTtl,
 	}
 }
 
@@ -37,7 +42,24 @@ func lockToFront(lock *corepb.Lock) *gatewaypb.Lock {
 		LockedAt:        lock.LockedAt,
 		WriteLockHolder: lockHolderToFront(lock.WriteLockHolder),
 		ReadLockHolders: lockHoldersToFront(lock.ReadLockHolders),
+		Waiters:         lockWaitersToFront(lock.Waiters),
+	}
+}
+
+func locksToFront(locks []*corepb.Lock) []*gatewaypb.Lock {
+	frontLocks := make([]*gatewaypb.Lock, len(locks))
+	for i, lock := range locks {
+		frontLocks[i] = lockToFront(lock)
 	}
+	return frontLocks
+}
+
+func lockStatesFromFront(states []gatewaypb.LockState) []corepb.LockState {
+	coreStates := make([]corepb.LockState, len(states))
+	for i, state := range states {
+		coreStates[i] = corepb.LockState(state)
+	}
+	return coreStates
 }
 
 func lockHolderToFront(lockHolder *corepb.LockHolder) *gatewaypb.LockHolder {
@@ -46,9 +68,10 @@ func lockHolderToFront(lockHolder *corepb.LockHolder) *gatewaypb.LockHolder {
 	}
 
 	return &gatewaypb.LockHolder{
-		ProcessId: lockHolder.ProcessId,
-		LockedAt:  lockHolder.LockedAt,
-		ExpiresAt: lockHolder.ExpiresAt,
+		ProcessId:    lockHolder.ProcessId,
+		LockedAt:     lockHolder.LockedAt,
+		ExpiresAt:    lockHolder.ExpiresAt,
+		FencingToken: lockHolder.FencingToken,
 	}
 }
 
@@ -59,3 +82,83 @@ func lockHoldersToFront(lockHolders []*corepb.LockHolder) []*gatewaypb.LockHolde
 	}
 	return frontLockHolders
 }
+
+func lockWaiterToFront(lockWaiter *corepb.LockWaiter) *gatewaypb.LockWaiter {
+	if lockWaiter == nil {
+		return nil
+	}
+
+	return &gatewaypb.LockWaiter{
+		ProcessId:  lockWaiter.ProcessId,
+		WriteLock:  lockWaiter.WriteLock,
+		EnqueuedAt: lockWaiter.EnqueuedAt,
+		WaitUntil:  lockWaiter.WaitUntil,
+	}
+}
+
+func lockWaitersToFront(lockWaiters []*corepb.LockWaiter) []*gatewaypb.LockWaiter {
+	frontLockWaiters := make([]*gatewaypb.LockWaiter, len(lockWaiters))
+	for i, lockWaiter := range lockWaiters {
+		frontLockWaiters[i] = lockWaiterToFront(lockWaiter)
+	}
+	return frontLockWaiters
+}
+
+func acquireLockResultToFront(result *corepb.AcquireLockResult) *gatewaypb.AcquireLockResult {
+	if result == nil {
+		return nil
+	}
+
+	return &gatewaypb.AcquireLockResult{
+		Lock:         lockToFront(result.Lock),
+		FencingToken: result.FencingToken,
+		BlockedBy:    result.BlockedBy,
+	}
+}
+
+func acquireLockResultsToFront(results []*corepb.AcquireLockResult) []*gatewaypb.AcquireLockResult {
+	frontResults := make([]*gatewaypb.AcquireLockResult, len(results))
+	for i, result := range results {
+		frontResults[i] = acquireLockResultToFront(result)
+	}
+	return frontResults
+}
+
+func semaphoreToFront(semaphore *corepb.Semaphore) *gatewaypb.Semaphore {
+	if semaphore == nil {
+		return nil
+	}
+
+	used := int64(0)
+	for _, holder := range semaphore.Holders {
+		used += holder.Permits
+	}
+
+	return &gatewaypb.Semaphore{
+		Name:             semaphore.Id.SemaphoreName,
+		Capacity:         semaphore.Capacity,
+		AvailablePermits: max(semaphore.Capacity-used, 0),
+		Holders:          semaphoreHoldersToFront(semaphore.Holders),
+	}
+}
+
+func semaphoreHolderToFront(semaphoreHolder *corepb.SemaphoreHolder) *gatewaypb.SemaphoreHolder {
+	if semaphoreHolder == nil {
+		return nil
+	}
+
+	return &gatewaypb.SemaphoreHolder{
+		ProcessId:  semaphoreHolder.ProcessId,
+		Permits:    semaphoreHolder.Permits,
+		AcquiredAt: semaphoreHolder.AcquiredAt,
+		ExpiresAt:  semaphoreHolder.ExpiresAt,
+	}
+}
+
+func semaphoreHoldersToFront(semaphoreHolders []*corepb.SemaphoreHolder) []*gatewaypb.SemaphoreHolder {
+	frontSemaphoreHolders := make([]*gatewaypb.SemaphoreHolder, len(semaphoreHolders))
+	for i, semaphoreHolder := range semaphoreHolders {
+		frontSemaphoreHolders[i] = semaphoreHolderToFront(semaphoreHolder)
+	}
+	return frontSemaphoreHolders
+}
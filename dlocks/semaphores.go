@@ -0,0 +1,326 @@
+package dlocks
+
+import (
+	"errors"
+
+	"github.com/evrblk/monstera"
+	"github.com/evrblk/monstera-example/dlocks/corepb"
+	monsterax "github.com/evrblk/monstera/x"
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/proto"
+)
+
+// Semaphores are stored by LocksCore next to locks and are sharded the same way (by account id and namespace name).
+
+func (c *LocksCore) GetSemaphore(request *corepb.GetSemaphoreRequest) (*corepb.GetSemaphoreResponse, error) {
+	txn := c.badgerStore.View()
+	defer txn.Discard()
+
+	semaphore, err := c.getSemaphore(txn, request.SemaphoreId)
+	if err != nil {
+		if errors.Is(err, monstera.ErrNotFound) {
+			// No semaphore exists, return an empty one
+			return &corepb.GetSemaphoreResponse{
+				Semaphore: &corepb.Semaphore{
+					Id: request.SemaphoreId,
+				},
+			}, nil
+		} else {
+			panic(err)
+		}
+	}
+
+	return &corepb.GetSemaphoreResponse{
+		Semaphore: c.checkSemaphoreExpiration(semaphore, request.Now),
+	}, nil
+}
+
+// AcquirePermits grabs the given number of permits for a process. If the process already holds permits of this
+// semaphore, its number of permits is replaced and expiration is extended, so repeated calls are safe. A semaphore is
+// created on the first acquire with the capacity from the request, the capacity of an existing semaphore is changed
+// only by SetSemaphoreCapacity.
+func (c *LocksCore) AcquirePermits(request *corepb.AcquirePermitsRequest) (*corepb.AcquirePermitsResponse, error) {
+	txn := c.badgerStore.Update()
+	defer txn.Discard()
+
+	exists := true
+	semaphore, err := c.getSemaphore(txn, request.SemaphoreId)
+	if err != nil {
+		if errors.Is(err, monstera.ErrNotFound) {
+			exists = false
+
+			if request.Capacity <= 0 {
+				return nil, monsterax.NewErrorWithContext(
+					monsterax.InvalidArgument,
+					"capacity is required to create a semaphore",
+					map[string]string{"semaphore_name": request.SemaphoreId.SemaphoreName})
+			}
+
+			// Semaphores count against the max number of locks per namespace
+			err = c.checkLocksLimit(txn, request.SemaphoreId, request.MaxNumberOfLocksPerNamespace, request.Now)
+			if err != nil {
+				return nil, err
+			}
+
+			// No semaphore exists, create a new one
+			semaphore = &corepb.Semaphore{
+				Id:        request.SemaphoreId,
+				Capacity:  request.Capacity,
+				CreatedAt: request.Now,
+			}
+		} else {
+			panic(err)
+		}
+	} else {
+		// Semaphore exists, lets check if any holders expired
+		semaphore = c.checkSemaphoreExpiration(semaphore, request.Now)
+	}
+
+	// Permits held by other processes
+	used := lo.SumBy(semaphore.Holders, func(h *corepb.SemaphoreHolder) int64 {
+		if h.ProcessId == request.ProcessId {
+			return 0
+		}
+		return h.Permits
+	})
+
+	if used+request.Permits > semaphore.Capacity {
+		return &corepb.AcquirePermitsResponse{
+			Semaphore: semaphore,
+			Success:   false, // Not enough permits available
+		}, nil
+	}
+
+	existingHolder, ok := lo.Find(semaphore.Holders, func(h *corepb.SemaphoreHolder) bool {
+		return h.ProcessId == request.ProcessId
+	})
+	if ok {
+		// Update number of permits and expiration time (extend)
+		existingHolder.Permits = request.Permits
+		existingHolder.AcquiredAt = request.Now
+		existingHolder.ExpiresAt = request.ExpiresAt
+	} else {
+		semaphore.Holders = append(semaphore.Holders, &corepb.SemaphoreHolder{
+			ProcessId:  request.ProcessId,
+			Permits:    request.Permits,
+			AcquiredAt: request.Now,
+			ExpiresAt:  request.ExpiresAt,
+		})
+	}
+	semaphore.UpdatedAt = request.Now
+
+	err = c.saveSemaphore(txn, semaphore, exists)
+	panicIfNotNil(err)
+
+	err = txn.Commit()
+	panicIfNotNil(err)
+
+	return &corepb.AcquirePermitsResponse{
+		Semaphore: semaphore,
+		Success:   true, // Acquired successfully by the given process_id
+	}, nil
+}
+
+// ReleasePermits returns the given number of permits of a process back to the semaphore (all of them if the number is
+// 0). If the process does not hold any permits nothing is changed.
+func (c *LocksCore) ReleasePermits(request *corepb.ReleasePermitsRequest) (*corepb.ReleasePermitsResponse, error) {
+	txn := c.badgerStore.Update()
+	defer txn.Discard()
+
+	semaphore, err := c.getSemaphore(txn, request.SemaphoreId)
+	if err != nil {
+		if errors.Is(err, monstera.ErrNotFound) {
+			// No semaphore exists, return an empty one
+			return &corepb.ReleasePermitsResponse{
+				Semaphore: &corepb.Semaphore{
+					Id: request.SemaphoreId,
+				},
+			}, nil
+		} else {
+			panic(err)
+		}
+	}
+
+	// Lets check if any holders expired
+	semaphore = c.checkSemaphoreExpiration(semaphore, request.Now)
+
+	existingHolder, ok := lo.Find(semaphore.Holders, func(h *corepb.SemaphoreHolder) bool {
+		return h.ProcessId == request.ProcessId
+	})
+	if ok {
+		if request.Permits > 0 && request.Permits < existingHolder.Permits {
+			existingHolder.Permits -= request.Permits
+		} else {
+			semaphore.Holders = lo.Filter(semaphore.Holders, func(h *corepb.SemaphoreHolder, _ int) bool {
+				return h.ProcessId != request.ProcessId
+			})
+		}
+		semaphore.UpdatedAt = request.Now
+	}
+
+	err = c.saveSemaphore(txn, semaphore, true)
+	panicIfNotNil(err)
+
+	err = txn.Commit()
+	panicIfNotNil(err)
+
+	return &corepb.ReleasePermitsResponse{
+		Semaphore: semaphore,
+	}, nil
+}
+
+// SetSemaphoreCapacity creates a semaphore or changes its capacity. Such semaphore is kept even when nobody holds its
+// permits. Lowering the capacity does not take permits away from current holders, but new permits cannot be acquired
+// until enough of them are released.
+func (c *LocksCore) SetSemaphoreCapacity(request *corepb.SetSemaphoreCapacityRequest) (*corepb.SetSemaphoreCapacityResponse, error) {
+	txn := c.badgerStore.Update()
+	defer txn.Discard()
+
+	exists := true
+	semaphore, err := c.getSemaphore(txn, request.SemaphoreId)
+	if err != nil {
+		if errors.Is(err, monstera.ErrNotFound) {
+			exists = false
+
+			// Semaphores count against the max number of locks per namespace
+			err = c.checkLocksLimit(txn, request.SemaphoreId, request.MaxNumberOfLocksPerNamespace, request.Now)
+			if err != nil {
+				return nil, err
+			}
+
+			semaphore = &corepb.Semaphore{
+				Id:        request.SemaphoreId,
+				CreatedAt: request.Now,
+			}
+		} else {
+			panic(err)
+		}
+	} else {
+		semaphore = c.checkSemaphoreExpiration(semaphore, request.Now)
+	}
+
+	semaphore.Capacity = request.Capacity
+	semaphore.CapacitySetExplicitly = true
+	semaphore.UpdatedAt = request.Now
+
+	err = c.saveSemaphore(txn, semaphore, exists)
+	panicIfNotNil(err)
+
+	err = txn.Commit()
+	panicIfNotNil(err)
+
+	return &corepb.SetSemaphoreCapacityResponse{
+		Semaphore: semaphore,
+	}, nil
+}
+
+// checkSemaphoreExpiration removes holders which expired at the moment `now`. Returns an updated copy of the semaphore.
+func (c *LocksCore) checkSemaphoreExpiration(semaphore *corepb.Semaphore, now int64) *corepb.Semaphore {
+	result := proto.Clone(semaphore).(*corepb.Semaphore)
+
+	result.Holders = lo.Filter(result.Holders, func(h *corepb.SemaphoreHolder, _ int) bool {
+		return h.ExpiresAt >= now
+	})
+	if len(result.Holders) == 0 {
+		result.Holders = nil
+	}
+
+	return result
+}
+
+func (c *LocksCore) getSemaphore(txn *monstera.Txn, semaphoreId *corepb.SemaphoreId) (*corepb.Semaphore, error) {
+	return c.semaphoresTable.Get(txn, locksTablePK(semaphoreId), semaphoresTableSK(semaphoreId))
+}
+
+func (c *LocksCore) listSemaphores(txn *monstera.Txn, namespaceId namespaceIdIntf, fn func(semaphore *corepb.Semaphore) (bool, error)) error {
+	return c.semaphoresTable.List(txn, locksTablePK(namespaceId), func(semaphore *corepb.Semaphore) (bool, error) {
+		if semaphore.Id.AccountId != namespaceId.GetAccountId() || semaphore.Id.NamespaceName != namespaceId.GetNamespaceName() {
+			return true, nil
+		}
+		return fn(semaphore)
+	})
+}
+
+// saveSemaphore stores the semaphore, or deletes it if nobody holds its permits and its capacity was set only by
+// the first acquire. `exists` tells if the semaphore is already stored, so the number of locks in the namespace is
+// kept up to date.
+func (c *LocksCore) saveSemaphore(txn *monstera.Txn, semaphore *corepb.Semaphore, exists bool) error {
+	if len(semaphore.Holders) == 0 && !semaphore.CapacitySetExplicitly {
+		if exists {
+			return c.deleteSemaphore(txn, semaphore)
+		}
+		return nil
+	} else if exists {
+		return c.updateSemaphore(txn, semaphore)
+	} else {
+		err := c.updateSemaphore(txn, semaphore)
+		if err != nil {
+			return err
+		}
+		return c.changeLocksCount(txn, semaphore.Id, 1)
+	}
+}
+
+// updateSemaphore stores the semaphore and moves it in the expiry index if its expiration has changed. Semaphores
+// without holders are not in the index.
+func (c *LocksCore) updateSemaphore(txn *monstera.Txn, semaphore *corepb.Semaphore) error {
+	expiresAt := semaphoreExpiresAt(semaphore)
+	if expiresAt != semaphore.ExpiresAt {
+		if semaphore.ExpiresAt != 0 {
+			err := c.semaphoresExpiryIndex.Delete(txn, locksExpiryIndexPK(c.shardLowerBound), semaphoresExpiryIndexSK(semaphore.Id, semaphore.ExpiresAt))
+			if err != nil {
+				return err
+			}
+		}
+
+		semaphore.ExpiresAt = expiresAt
+
+		if semaphore.ExpiresAt != 0 {
+			err := c.semaphoresExpiryIndex.Set(txn, locksExpiryIndexPK(c.shardLowerBound), semaphoresExpiryIndexSK(semaphore.Id, semaphore.ExpiresAt), semaphore.Id)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return c.semaphoresTable.Set(txn, locksTablePK(semaphore.Id), semaphoresTableSK(semaphore.Id), semaphore)
+}
+
+func (c *LocksCore) deleteSemaphore(txn *monstera.Txn, semaphore *corepb.Semaphore) error {
+	err := c.semaphoresTable.Delete(txn, locksTablePK(semaphore.Id), semaphoresTableSK(semaphore.Id))
+	if err != nil {
+		return err
+	}
+
+	if semaphore.ExpiresAt != 0 {
+		err = c.semaphoresExpiryIndex.Delete(txn, locksExpiryIndexPK(c.shardLowerBound), semaphoresExpiryIndexSK(semaphore.Id, semaphore.ExpiresAt))
+		if err != nil {
+			return err
+		}
+	}
+
+	return c.changeLocksCount(txn, semaphore.Id, -1)
+}
+
+// semaphoreExpiresAt returns the moment after which nobody holds permits of the semaphore, 0 if nobody holds them.
+func semaphoreExpiresAt(semaphore *corepb.Semaphore) int64 {
+	expiresAt := int64(0)
+	for _, h := range semaphore.Holders {
+		expiresAt = max(expiresAt, h.ExpiresAt)
+	}
+	return expiresAt
+}
+
+// 1. semaphore name
+func semaphoresTableSK(s *corepb.SemaphoreId) []byte {
+	return monstera.ConcatBytes(s.GetSemaphoreName())
+}
+
+// 1. expires at
+// 2. account id
+// 3. namespace name length
+// 4. namespace name
+// 5. semaphore name
+func semaphoresExpiryIndexSK(semaphoreId *corepb.SemaphoreId, expiresAt int64) []byte {
+	return monstera.ConcatBytes(expiresAt, semaphoreId.AccountId, uint32(len(semaphoreId.NamespaceName)), semaphoreId.NamespaceName, semaphoreId.SemaphoreName)
+}
@@ -0,0 +1,527 @@
+package dlocks
+
+import (
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/evrblk/monstera"
+	"github.com/evrblk/monstera-example/dlocks/corepb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcquirePermits(t *testing.T) {
+	require := require.New(t)
+
+	locksCore := newLocksCore()
+
+	now := time.Now()
+
+	accountId := rand.Uint64()
+	semaphoreId := &corepb.SemaphoreId{
+		AccountId:     accountId,
+		NamespaceName: "test_namespace",
+		SemaphoreName: "test_semaphore",
+	}
+
+	// T+0: Acquire 2 permits by process 1, semaphore is created with capacity 3
+	response1, err := locksCore.AcquirePermits(&corepb.AcquirePermitsRequest{
+		SemaphoreId: semaphoreId,
+		ProcessId:   "process_1",
+		Permits:     2,
+		Now:         now.UnixNano(),
+		ExpiresAt:   now.Add(time.Hour).UnixNano(),
+		Capacity:    3,
+	})
+
+	require.NoError(err)
+	require.True(response1.Success)
+	require.EqualValues(3, response1.Semaphore.Capacity)
+	require.Len(response1.Semaphore.Holders, 1)
+	require.Equal("process_1", response1.Semaphore.Holders[0].ProcessId)
+	require.EqualValues(2, response1.Semaphore.Holders[0].Permits)
+
+	// T+1m: Acquire 2 permits by process 2, only 1 is available
+	response2, err := locksCore.AcquirePermits(&corepb.AcquirePermitsRequest{
+		SemaphoreId: semaphoreId,
+		ProcessId:   "process_2",
+		Permits:     2,
+		Now:         now.Add(time.Minute).UnixNano(),
+		ExpiresAt:   now.Add(time.Hour).UnixNano(),
+		Capacity:    10, // ignored for existing semaphore
+	})
+
+	require.NoError(err)
+	require.False(response2.Success)
+	require.EqualValues(3, response2.Semaphore.Capacity)
+	require.Len(response2.Semaphore.Holders, 1)
+
+	// T+2m: Acquire 1 permit by process 2
+	response3, err := locksCore.AcquirePermits(&corepb.AcquirePermitsRequest{
+		SemaphoreId: semaphoreId,
+		ProcessId:   "process_2",
+		Permits:     1,
+		Now:         now.Add(2 * time.Minute).UnixNano(),
+		ExpiresAt:   now.Add(time.Hour).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.True(response3.Success)
+	require.Len(response3.Semaphore.Holders, 2)
+
+	// T+3m: Process 1 re-acquires with 1 permit, its permits are replaced
+	response4, err := locksCore.AcquirePermits(&corepb.AcquirePermitsRequest{
+		SemaphoreId: semaphoreId,
+		ProcessId:   "process_1",
+		Permits:     1,
+		Now:         now.Add(3 * time.Minute).UnixNano(),
+		ExpiresAt:   now.Add(2 * time.Hour).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.True(response4.Success)
+	require.Len(response4.Semaphore.Holders, 2)
+	require.EqualValues(1, response4.Semaphore.Holders[0].Permits)
+	require.EqualValues(now.Add(2*time.Hour).UnixNano(), response4.Semaphore.Holders[0].ExpiresAt)
+
+	// T+4m: Process 3 can now take the freed permit
+	response5, err := locksCore.AcquirePermits(&corepb.AcquirePermitsRequest{
+		SemaphoreId: semaphoreId,
+		ProcessId:   "process_3",
+		Permits:     1,
+		Now:         now.Add(4 * time.Minute).UnixNano(),
+		ExpiresAt:   now.Add(time.Hour).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.True(response5.Success)
+	require.Len(response5.Semaphore.Holders, 3)
+}
+
+func TestAcquirePermitsWithoutCapacity(t *testing.T) {
+	require := require.New(t)
+
+	locksCore := newLocksCore()
+
+	now := time.Now()
+
+	accountId := rand.Uint64()
+	semaphoreId := &corepb.SemaphoreId{
+		AccountId:     accountId,
+		NamespaceName: "test_namespace",
+		SemaphoreName: "test_semaphore",
+	}
+
+	// T+0: Acquire permits of a nonexistent semaphore without capacity
+	_, err := locksCore.AcquirePermits(&corepb.AcquirePermitsRequest{
+		SemaphoreId: semaphoreId,
+		ProcessId:   "process_1",
+		Permits:     1,
+		Now:         now.UnixNano(),
+		ExpiresAt:   now.Add(time.Hour).UnixNano(),
+	})
+
+	require.Error(err)
+
+	// T+1m: Get semaphore, nothing is created
+	response1, err := locksCore.GetSemaphore(&corepb.GetSemaphoreRequest{
+		SemaphoreId: semaphoreId,
+		Now:         now.Add(time.Minute).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.EqualValues(0, response1.Semaphore.Capacity)
+	require.Empty(response1.Semaphore.Holders)
+}
+
+func TestReleasePermits(t *testing.T) {
+	require := require.New(t)
+
+	locksCore := newLocksCore()
+
+	now := time.Now()
+
+	accountId := rand.Uint64()
+	semaphoreId := &corepb.SemaphoreId{
+		AccountId:     accountId,
+		NamespaceName: "test_namespace",
+		SemaphoreName: "test_semaphore",
+	}
+
+	// T+0: Acquire 3 permits by process 1
+	response1, err := locksCore.AcquirePermits(&corepb.AcquirePermitsRequest{
+		SemaphoreId: semaphoreId,
+		ProcessId:   "process_1",
+		Permits:     3,
+		Now:         now.UnixNano(),
+		ExpiresAt:   now.Add(time.Hour).UnixNano(),
+		Capacity:    5,
+	})
+
+	require.NoError(err)
+	require.True(response1.Success)
+
+	// T+1m: Release 1 permit
+	response2, err := locksCore.ReleasePermits(&corepb.ReleasePermitsRequest{
+		SemaphoreId: semaphoreId,
+		ProcessId:   "process_1",
+		Permits:     1,
+		Now:         now.Add(time.Minute).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.Len(response2.Semaphore.Holders, 1)
+	require.EqualValues(2, response2.Semaphore.Holders[0].Permits)
+
+	// T+2m: Release permits of a process which does not hold any
+	response3, err := locksCore.ReleasePermits(&corepb.ReleasePermitsRequest{
+		SemaphoreId: semaphoreId,
+		ProcessId:   "process_2",
+		Permits:     1,
+		Now:         now.Add(2 * time.Minute).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.Len(response3.Semaphore.Holders, 1)
+	require.EqualValues(2, response3.Semaphore.Holders[0].Permits)
+
+	// T+3m: Release all permits
+	response4, err := locksCore.ReleasePermits(&corepb.ReleasePermitsRequest{
+		SemaphoreId: semaphoreId,
+		ProcessId:   "process_1",
+		Now:         now.Add(3 * time.Minute).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.Empty(response4.Semaphore.Holders)
+
+	// Semaphore without holders is deleted
+	txn := locksCore.badgerStore.View()
+	defer txn.Discard()
+	_, err = locksCore.getSemaphore(txn, semaphoreId)
+	require.ErrorIs(err, monstera.ErrNotFound)
+}
+
+func TestReleaseNonexistentSemaphore(t *testing.T) {
+	require := require.New(t)
+
+	locksCore := newLocksCore()
+
+	now := time.Now()
+
+	accountId := rand.Uint64()
+	semaphoreId := &corepb.SemaphoreId{
+		AccountId:     accountId,
+		NamespaceName: "test_namespace",
+		SemaphoreName: "test_semaphore",
+	}
+
+	response1, err := locksCore.ReleasePermits(&corepb.ReleasePermitsRequest{
+		SemaphoreId: semaphoreId,
+		ProcessId:   "process_1",
+		Now:         now.UnixNano(),
+	})
+
+	require.NoError(err)
+	require.NotNil(response1.Semaphore)
+	require.Empty(response1.Semaphore.Holders)
+}
+
+func TestSemaphorePermitsExpiration(t *testing.T) {
+	require := require.New(t)
+
+	locksCore := newLocksCore()
+
+	now := time.Now()
+
+	accountId := rand.Uint64()
+	semaphoreId := &corepb.SemaphoreId{
+		AccountId:     accountId,
+		NamespaceName: "test_namespace",
+		SemaphoreName: "test_semaphore",
+	}
+
+	// T+0: Acquire all 2 permits by process 1 for 10 minutes
+	response1, err := locksCore.AcquirePermits(&corepb.AcquirePermitsRequest{
+		SemaphoreId: semaphoreId,
+		ProcessId:   "process_1",
+		Permits:     2,
+		Now:         now.UnixNano(),
+		ExpiresAt:   now.Add(10 * time.Minute).UnixNano(),
+		Capacity:    2,
+	})
+
+	require.NoError(err)
+	require.True(response1.Success)
+
+	// T+5m: Acquire by process 2 fails
+	response2, err := locksCore.AcquirePermits(&corepb.AcquirePermitsRequest{
+		SemaphoreId: semaphoreId,
+		ProcessId:   "process_2",
+		Permits:     1,
+		Now:         now.Add(5 * time.Minute).UnixNano(),
+		ExpiresAt:   now.Add(time.Hour).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.False(response2.Success)
+
+	// T+11m: Permits of process 1 expired
+	response3, err := locksCore.GetSemaphore(&corepb.GetSemaphoreRequest{
+		SemaphoreId: semaphoreId,
+		Now:         now.Add(11 * time.Minute).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.Empty(response3.Semaphore.Holders)
+
+	// T+12m: Acquire by process 2 succeeds, capacity is kept
+	response4, err := locksCore.AcquirePermits(&corepb.AcquirePermitsRequest{
+		SemaphoreId: semaphoreId,
+		ProcessId:   "process_2",
+		Permits:     2,
+		Now:         now.Add(12 * time.Minute).UnixNano(),
+		ExpiresAt:   now.Add(time.Hour).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.True(response4.Success)
+	require.EqualValues(2, response4.Semaphore.Capacity)
+	require.Len(response4.Semaphore.Holders, 1)
+	require.Equal("process_2", response4.Semaphore.Holders[0].ProcessId)
+}
+
+func TestSetSemaphoreCapacity(t *testing.T) {
+	require := require.New(t)
+
+	locksCore := newLocksCore()
+
+	now := time.Now()
+
+	accountId := rand.Uint64()
+	semaphoreId := &corepb.SemaphoreId{
+		AccountId:     accountId,
+		NamespaceName: "test_namespace",
+		SemaphoreName: "test_semaphore",
+	}
+
+	// T+0: Set capacity of a new semaphore
+	response1, err := locksCore.SetSemaphoreCapacity(&corepb.SetSemaphoreCapacityRequest{
+		SemaphoreId: semaphoreId,
+		Capacity:    2,
+		Now:         now.UnixNano(),
+	})
+
+	require.NoError(err)
+	require.EqualValues(2, response1.Semaphore.Capacity)
+
+	// T+1m: Acquire without capacity uses the explicit one
+	response2, err := locksCore.AcquirePermits(&corepb.AcquirePermitsRequest{
+		SemaphoreId: semaphoreId,
+		ProcessId:   "process_1",
+		Permits:     2,
+		Now:         now.Add(time.Minute).UnixNano(),
+		ExpiresAt:   now.Add(time.Hour).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.True(response2.Success)
+
+	// T+2m: Lower capacity, holders keep their permits
+	response3, err := locksCore.SetSemaphoreCapacity(&corepb.SetSemaphoreCapacityRequest{
+		SemaphoreId: semaphoreId,
+		Capacity:    1,
+		Now:         now.Add(2 * time.Minute).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.EqualValues(1, response3.Semaphore.Capacity)
+	require.Len(response3.Semaphore.Holders, 1)
+	require.EqualValues(2, response3.Semaphore.Holders[0].Permits)
+
+	// T+3m: Release all permits
+	_, err = locksCore.ReleasePermits(&corepb.ReleasePermitsRequest{
+		SemaphoreId: semaphoreId,
+		ProcessId:   "process_1",
+		Now:         now.Add(3 * time.Minute).UnixNano(),
+	})
+
+	require.NoError(err)
+
+	// T+4m: Semaphore with explicit capacity is kept
+	response4, err := locksCore.GetSemaphore(&corepb.GetSemaphoreRequest{
+		SemaphoreId: semaphoreId,
+		Now:         now.Add(4 * time.Minute).UnixNano(),
+	})
+
+	require.NoError(err)
+	require.EqualValues(1, response4.Semaphore.Capacity)
+	require.True(response4.Semaphore.CapacitySetExplicitly)
+	require.Empty(response4.Semaphore.Holders)
+}
+
+func TestSweepExpiredSemaphores(t *testing.T) {
+	require := require.New(t)
+
+	locksCore := newLocksCore()
+
+	now := time.Now()
+
+	accountId := rand.Uint64()
+	semaphoreId1 := &corepb.SemaphoreId{
+		AccountId:     accountId,
+		NamespaceName: "test_namespace",
+		SemaphoreName: "test_semaphore_1",
+	}
+	semaphoreId2 := &corepb.SemaphoreId{
+		AccountId:     accountId,
+		NamespaceName: "test_namespace",
+		SemaphoreName: "test_semaphore_2",
+	}
+
+	// T+0: Acquire permits of semaphore 1 for 10 minutes and of semaphore 2 for 1 hour
+	_, err := locksCore.AcquirePermits(&corepb.AcquirePermitsRequest{
+		SemaphoreId: semaphoreId1,
+		ProcessId:   "process_1",
+		Permits:     1,
+		Now:         now.UnixNano(),
+		ExpiresAt:   now.Add(10 * time.Minute).UnixNano(),
+		Capacity:    2,
+	})
+	require.NoError(err)
+
+	_, err = locksCore.AcquirePermits(&corepb.AcquirePermitsRequest{
+		SemaphoreId: semaphoreId2,
+		ProcessId:   "process_1",
+		Permits:     1,
+		Now:         now.UnixNano(),
+		ExpiresAt:   now.Add(time.Hour).UnixNano(),
+		Capacity:    2,
+	})
+	require.NoError(err)
+
+	// T+5m: Nothing is expired yet
+	response1, err := locksCore.SweepExpiredLocks(&corepb.SweepExpiredLocksRequest{
+		Now:   now.Add(5 * time.Minute).UnixNano(),
+		Limit: 10,
+	})
+	require.NoError(err)
+	require.EqualValues(0, response1.SweptSemaphores)
+
+	// T+11m: Semaphore 1 is swept and deleted
+	response2, err := locksCore.SweepExpiredLocks(&corepb.SweepExpiredLocksRequest{
+		Now:   now.Add(11 * time.Minute).UnixNano(),
+		Limit: 10,
+	})
+	require.NoError(err)
+	require.EqualValues(1, response2.SweptSemaphores)
+
+	txn := locksCore.badgerStore.View()
+	defer txn.Discard()
+
+	_, err = locksCore.getSemaphore(txn, semaphoreId1)
+	require.ErrorIs(err, monstera.ErrNotFound)
+
+	semaphore2, err := locksCore.getSemaphore(txn, semaphoreId2)
+	require.NoError(err)
+	require.Len(semaphore2.Holders, 1)
+
+	count, err := locksCore.getLocksCount(txn, semaphoreId2)
+	require.NoError(err)
+	require.EqualValues(1, count)
+}
+
+func TestSemaphoresMaxNumberOfLocksPerNamespace(t *testing.T) {
+	require := require.New(t)
+
+	locksCore := newLocksCore()
+
+	now := time.Now()
+
+	accountId := rand.Uint64()
+	lockId := &corepb.LockId{
+		AccountId:     accountId,
+		NamespaceName: "test_namespace",
+		LockName:      "test_lock",
+	}
+	semaphoreId := func(name string) *corepb.SemaphoreId {
+		return &corepb.SemaphoreId{
+			AccountId:     accountId,
+			NamespaceName: "test_namespace",
+			SemaphoreName: name,
+		}
+	}
+
+	// T+0: Lock and semaphore 1 take both places in the namespace
+	_, err := locksCore.AcquireLock(&corepb.AcquireLockRequest{
+		LockId:    lockId,
+		ProcessId: "process_1",
+		WriteLock: true,
+		Now:       now.UnixNano(),
+		ExpiresAt: now.Add(time.Hour).UnixNano(),
+
+		MaxNumberOfLocksPerNamespace: 2,
+	})
+	require.NoError(err)
+
+	_, err = locksCore.AcquirePermits(&corepb.AcquirePermitsRequest{
+		SemaphoreId: semaphoreId("test_semaphore_1"),
+		ProcessId:   "process_1",
+		Permits:     1,
+		Now:         now.UnixNano(),
+		ExpiresAt:   now.Add(10 * time.Minute).UnixNano(),
+		Capacity:    2,
+
+		MaxNumberOfLocksPerNamespace: 2,
+	})
+	require.NoError(err)
+
+	// T+1m: Neither acquiring nor creating semaphore 2 is allowed
+	_, err = locksCore.AcquirePermits(&corepb.AcquirePermitsRequest{
+		SemaphoreId: semaphoreId("test_semaphore_2"),
+		ProcessId:   "process_1",
+		Permits:     1,
+		Now:         now.Add(time.Minute).UnixNano(),
+		ExpiresAt:   now.Add(time.Hour).UnixNano(),
+		Capacity:    2,
+
+		MaxNumberOfLocksPerNamespace: 2,
+	})
+	require.Error(err)
+
+	_, err = locksCore.SetSemaphoreCapacity(&corepb.SetSemaphoreCapacityRequest{
+		SemaphoreId: semaphoreId("test_semaphore_2"),
+		Capacity:    2,
+		Now:         now.Add(time.Minute).UnixNano(),
+
+		MaxNumberOfLocksPerNamespace: 2,
+	})
+	require.Error(err)
+
+	// T+2m: Existing semaphore 1 can still be acquired
+	response1, err := locksCore.AcquirePermits(&corepb.AcquirePermitsRequest{
+		SemaphoreId: semaphoreId("test_semaphore_1"),
+		ProcessId:   "process_2",
+		Permits:     1,
+		Now:         now.Add(2 * time.Minute).UnixNano(),
+		ExpiresAt:   now.Add(10 * time.Minute).UnixNano(),
+
+		MaxNumberOfLocksPerNamespace: 2,
+	})
+	require.NoError(err)
+	require.True(response1.Success)
+
+	// T+11m: Permits of semaphore 1 expired, it is deleted to make room for semaphore 2
+	response2, err := locksCore.AcquirePermits(&corepb.AcquirePermitsRequest{
+		SemaphoreId: semaphoreId("test_semaphore_2"),
+		ProcessId:   "process_1",
+		Permits:     1,
+		Now:         now.Add(11 * time.Minute).UnixNano(),
+		ExpiresAt:   now.Add(time.Hour).UnixNano(),
+		Capacity:    2,
+
+		MaxNumberOfLocksPerNamespace: 2,
+	})
+	require.NoError(err)
+	require.True(response2.Success)
+}
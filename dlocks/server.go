@@ -14,6 +14,17 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+const (
+	// How often a blocked AcquireLock reads the lock to check if its waiter has reached the head of the queue
+	acquireLockPollInterval = 50 * time.Millisecond
+
+	// Timeout of removing a waiter which gave up from the queue
+	cancelLockWaitTimeout = 5 * time.Second
+
+	// Maximum time a single AcquireLock call can block
+	maxAcquireLockWaitDuration = time.Minute
+)
+
 type LocksServiceApiServer struct {
 	gatewaypb.UnimplementedLocksServiceApiServer
 
@@ -42,12 +53,17 @@ func (s *LocksServiceApiServer) CreateNamespace(ctx context.Context, request *ga
 		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
 	}
 
+	minLockTtl, defaultLockTtl, maxLockTtl := lockTtlsWithDefaults(request.MinLockTtl, request.DefaultLockTtl, request.MaxLockTtl)
+
 	res2, err := s.coreApiClient.CreateNamespace(ctx, &corepb.CreateNamespaceRequest{
 		AccountId:             accountId,
 		Name:                  request.Name,
 		Description:           request.Description,
 		Now:                   now.UnixNano(),
 		MaxNumberOfNamespaces: account.MaxNumberOfNamespaces,
+		MinLockTtl:            minLockTtl,
+		DefaultLockTtl:        defaultLockTtl,
+		MaxLockTtl:            maxLockTtl,
 	})
 	if err != nil {
 		return nil, monsterax.ErrorToGRPC(err)
@@ -96,8 +112,11 @@ func (s *LocksServiceApiServer) UpdateNamespace(ctx context.Context, request *ga
 			AccountId:     accountId,
 			NamespaceName: request.NamespaceName,
 		},
-		Description: request.Description,
-		Now:         now.UnixNano(),
+		Description:    request.Description,
+		Now:            now.UnixNano(),
+		MinLockTtl:     request.MinLockTtl,
+		DefaultLockTtl: request.DefaultLockTtl,
+		MaxLockTtl:     request.MaxLockTtl,
 	})
 	if err != nil {
 		return nil, monsterax.ErrorToGRPC(err)
@@ -159,27 +178,134 @@ func (s *LocksServiceApiServer) AcquireLock(ctx context.Context, request *gatewa
 		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
 	}
 
-	res, err := s.coreApiClient.AcquireLock(ctx, &corepb.AcquireLockRequest{
-		LockId: &corepb.LockId{
+	res1, err := s.coreApiClient.GetAccount(ctx, &corepb.GetAccountRequest{
+		AccountId: accountId,
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+	account := res1.Account
+
+	res2, err := s.coreApiClient.GetNamespace(ctx, &corepb.GetNamespaceRequest{
+		NamespaceId: &corepb.NamespaceId{
 			AccountId:     accountId,
 			NamespaceName: request.NamespaceName,
-			LockName:      request.LockName,
 		},
-		Now:       now.UnixNano(),
-		ProcessId: request.ProcessId,
-		ExpiresAt: request.ExpiresAt,
-		WriteLock: request.WriteLock,
 	})
 	if err != nil {
 		return nil, monsterax.ErrorToGRPC(err)
 	}
+	namespace := res2.Namespace
+
+	// The lease is measured with the gateway clock. A legacy absolute expires_at is converted into a lease as well,
+	// so it is subject to the same namespace limits.
+	_, ttl, _ := namespaceLockTtls(namespace)
+	if request.Ttl > 0 {
+		ttl = request.Ttl
+	} else if request.ExpiresAt != 0 {
+		ttl = time.Duration(request.ExpiresAt - now.UnixNano()).Milliseconds()
+	}
+
+	if err := validateLockLease("AcquireLockRequest", ttl, namespace); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	lockId := &corepb.LockId{
+		AccountId:     accountId,
+		NamespaceName: request.NamespaceName,
+		LockName:      request.LockName,
+	}
+
+	// Without wait_timeout a single attempt is made. Otherwise the process is queued as a waiter, and the lock is
+	// polled with reads until the process can be granted the lock or wait_timeout passes (measured with the gateway
+	// clock, like the lease). A process which did not get the lock (timeout, cancellation, error) leaves the queue.
+	waitUntil := int64(0)
+	if request.WaitTimeout > 0 {
+		waitUntil = now.Add(time.Duration(request.WaitTimeout) * time.Millisecond).UnixNano()
+	}
+
+	acquireLock := func(now time.Time) (*corepb.AcquireLockResponse, error) {
+		return s.coreApiClient.AcquireLock(ctx, &corepb.AcquireLockRequest{
+			LockId:    lockId,
+			Now:       now.UnixNano(),
+			ProcessId: request.ProcessId,
+			ExpiresAt: now.Add(time.Duration(ttl) * time.Millisecond).UnixNano(),
+			WriteLock: request.WriteLock,
+			WaitUntil: waitUntil,
+
+			MaxNumberOfLocksPerNamespace: account.MaxNumberOfLocksPerNamespace,
+		})
+	}
+
+	granted := false
+	if waitUntil != 0 {
+		defer func() {
+			if !granted {
+				s.cancelLockWait(lockId, request.ProcessId)
+			}
+		}()
+	}
+
+	res, err := acquireLock(now)
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+
+	for res.Waiting {
+		select {
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		case <-time.After(acquireLockPollInterval):
+		}
+
+		now = time.Now()
+
+		// Only a waiter which is not blocked anymore retries the update (so does the last attempt after wait_timeout),
+		// other waiters keep polling with reads
+		if now.UnixNano() < waitUntil {
+			res3, err := s.coreApiClient.GetLock(ctx, &corepb.GetLockRequest{
+				LockId: lockId,
+				Now:    now.UnixNano(),
+			})
+			if err != nil {
+				return nil, monsterax.ErrorToGRPC(err)
+			}
+			if len(lockBlockers(res3.Lock, request.ProcessId, request.WriteLock)) > 0 {
+				continue
+			}
+		}
+
+		res, err = acquireLock(now)
+		if err != nil {
+			return nil, monsterax.ErrorToGRPC(err)
+		}
+	}
+
+	granted = res.Success
 
 	return &gatewaypb.AcquireLockResponse{
-		Lock:    lockToFront(res.Lock),
-		Success: res.Success,
+		Lock:         lockToFront(res.Lock),
+		Success:      res.Success,
+		FencingToken: res.FencingToken,
 	}, nil
 }
 
+// cancelLockWait removes a process which gave up waiting from the queue of the lock. The request context might be
+// canceled already, so a separate one is used.
+func (s *LocksServiceApiServer) cancelLockWait(lockId *corepb.LockId, processId string) {
+	ctx, cancel := context.WithTimeout(context.Background(), cancelLockWaitTimeout)
+	defer cancel()
+
+	_, err := s.coreApiClient.CancelLockWait(ctx, &corepb.CancelLockWaitRequest{
+		LockId:    lockId,
+		ProcessId: processId,
+		Now:       time.Now().UnixNano(),
+	})
+	if err != nil {
+		log.Printf("Failed to cancel lock wait: %v", err)
+	}
+}
+
 func (s *LocksServiceApiServer) ReleaseLock(ctx context.Context, request *gatewaypb.ReleaseLockRequest) (*gatewaypb.ReleaseLockResponse, error) {
 	accountId := ctx.Value("account-id").(uint64)
 
@@ -208,23 +334,180 @@ func (s *LocksServiceApiServer) ReleaseLock(ctx context.Context, request *gatewa
 	}, nil
 }
 
-func (s *LocksServiceApiServer) GetLock(ctx context.Context, request *gatewaypb.GetLockRequest) (*gatewaypb.GetLockResponse, error) {
+func (s *LocksServiceApiServer) AcquireLocks(ctx context.Context, request *gatewaypb.AcquireLocksRequest) (*gatewaypb.AcquireLocksResponse, error) {
 	accountId := ctx.Value("account-id").(uint64)
 
 	now := time.Now()
 
 	// Validation
-	if err := validateGetLockRequest(request); err != nil {
+	if err := validateAcquireLocksRequest(request); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
 	}
 
-	res, err := s.coreApiClient.GetLock(ctx, &corepb.GetLockRequest{
+	res1, err := s.coreApiClient.GetAccount(ctx, &corepb.GetAccountRequest{
+		AccountId: accountId,
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+	account := res1.Account
+
+	res2, err := s.coreApiClient.GetNamespace(ctx, &corepb.GetNamespaceRequest{
+		NamespaceId: &corepb.NamespaceId{
+			AccountId:     accountId,
+			NamespaceName: request.NamespaceName,
+		},
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+	namespace := res2.Namespace
+
+	_, ttl, _ := namespaceLockTtls(namespace)
+	if request.Ttl > 0 {
+		ttl = request.Ttl
+	}
+
+	if err := validateLockLease("AcquireLocksRequest", ttl, namespace); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	res3, err := s.coreApiClient.AcquireLocks(ctx, &corepb.AcquireLocksRequest{
+		NamespaceId: &corepb.NamespaceId{
+			AccountId:     accountId,
+			NamespaceName: request.NamespaceName,
+		},
+		LockNames: request.LockNames,
+		ProcessId: request.ProcessId,
+		WriteLock: request.WriteLock,
+		Now:       now.UnixNano(),
+		ExpiresAt: now.Add(time.Duration(ttl) * time.Millisecond).UnixNano(),
+
+		MaxNumberOfLocksPerNamespace: account.MaxNumberOfLocksPerNamespace,
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+
+	return &gatewaypb.AcquireLocksResponse{
+		Success: res3.Success,
+		Results: acquireLockResultsToFront(res3.Results),
+	}, nil
+}
+
+func (s *LocksServiceApiServer) ReleaseLocks(ctx context.Context, request *gatewaypb.ReleaseLocksRequest) (*gatewaypb.ReleaseLocksResponse, error) {
+	accountId := ctx.Value("account-id").(uint64)
+
+	now := time.Now()
+
+	// Validation
+	if err := validateReleaseLocksRequest(request); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	res, err := s.coreApiClient.ReleaseLocks(ctx, &corepb.ReleaseLocksRequest{
+		NamespaceId: &corepb.NamespaceId{
+			AccountId:     accountId,
+			NamespaceName: request.NamespaceName,
+		},
+		LockNames: request.LockNames,
+		ProcessId: request.ProcessId,
+		Now:       now.UnixNano(),
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+
+	return &gatewaypb.ReleaseLocksResponse{
+		Locks: locksToFront(res.Locks),
+	}, nil
+}
+
+func (s *LocksServiceApiServer) ConvertLock(ctx context.Context, request *gatewaypb.ConvertLockRequest) (*gatewaypb.ConvertLockResponse, error) {
+	accountId := ctx.Value("account-id").(uint64)
+
+	now := time.Now()
+
+	// Validation
+	if err := validateConvertLockRequest(request); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	// Expiration time is kept unless a new lease is requested
+	expiresAt := int64(0)
+	if request.Ttl > 0 {
+		res, err := s.coreApiClient.GetNamespace(ctx, &corepb.GetNamespaceRequest{
+			NamespaceId: &corepb.NamespaceId{
+				AccountId:     accountId,
+				NamespaceName: request.NamespaceName,
+			},
+		})
+		if err != nil {
+			return nil, monsterax.ErrorToGRPC(err)
+		}
+
+		if err := validateLockLease("ConvertLockRequest", request.Ttl, res.Namespace); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+		}
+
+		expiresAt = now.Add(time.Duration(request.Ttl) * time.Millisecond).UnixNano()
+	}
+
+	res, err := s.coreApiClient.ConvertLock(ctx, &corepb.ConvertLockRequest{
 		LockId: &corepb.LockId{
 			AccountId:     accountId,
 			NamespaceName: request.NamespaceName,
 			LockName:      request.LockName,
 		},
-		Now: now.UnixNano(),
+		ProcessId: request.ProcessId,
+		Now:       now.UnixNano(),
+		WriteLock: request.WriteLock,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+
+	return &gatewaypb.ConvertLockResponse{
+		Lock:         lockToFront(res.Lock),
+		Success:      res.Success,
+		FencingToken: res.FencingToken,
+	}, nil
+}
+
+func (s *LocksServiceApiServer) GetLock(ctx context.Context, request *gatewaypb.GetLockRequest) (*gatewaypb.GetLockResponse, error) {
+	accountId := ctx.Value("account-id").(uint64)
+
+	now := time.Now()
+
+	// Validation
+	if err := validateGetLockRequest(request); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	lockId := &corepb.LockId{
+		AccountId:     accountId,
+		NamespaceName: request.NamespaceName,
+		LockName:      request.LockName,
+	}
+
+	if request.AllowFollowerRead {
+		res, err := s.coreApiClient.GetLockFromFollower(ctx, &corepb.GetLockFromFollowerRequest{
+			LockId: lockId,
+			Now:    now.UnixNano(),
+		})
+		if err != nil {
+			return nil, monsterax.ErrorToGRPC(err)
+		}
+
+		return &gatewaypb.GetLockResponse{
+			Lock: lockToFront(res.Lock),
+		}, nil
+	}
+
+	res, err := s.coreApiClient.GetLock(ctx, &corepb.GetLockRequest{
+		LockId: lockId,
+		Now:    now.UnixNano(),
 	})
 	if err != nil {
 		return nil, monsterax.ErrorToGRPC(err)
@@ -260,6 +543,228 @@ func (s *LocksServiceApiServer) DeleteLock(ctx context.Context, request *gateway
 	return &gatewaypb.DeleteLockResponse{}, nil
 }
 
+func (s *LocksServiceApiServer) ListLocks(ctx context.Context, request *gatewaypb.ListLocksRequest) (*gatewaypb.ListLocksResponse, error) {
+	accountId := ctx.Value("account-id").(uint64)
+
+	now := time.Now()
+
+	// Validation
+	if err := validateListLocksRequest(request); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = defaultLocksPageSize
+	}
+
+	res, err := s.coreApiClient.ListLocks(ctx, &corepb.ListLocksRequest{
+		NamespaceId: &corepb.NamespaceId{
+			AccountId:     accountId,
+			NamespaceName: request.NamespaceName,
+		},
+		Now:       now.UnixNano(),
+		States:    lockStatesFromFront(request.States),
+		ProcessId: request.ProcessId,
+		PageToken: request.PageToken,
+		Limit:     pageSize,
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+
+	return &gatewaypb.ListLocksResponse{
+		Locks:         locksToFront(res.Locks),
+		NextPageToken: res.NextPageToken,
+	}, nil
+}
+
+func (s *LocksServiceApiServer) ValidateFencingToken(ctx context.Context, request *gatewaypb.ValidateFencingTokenRequest) (*gatewaypb.ValidateFencingTokenResponse, error) {
+	accountId := ctx.Value("account-id").(uint64)
+
+	now := time.Now()
+
+	// Validation
+	if err := validateValidateFencingTokenRequest(request); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	res, err := s.coreApiClient.ValidateFencingToken(ctx, &corepb.ValidateFencingTokenRequest{
+		LockId: &corepb.LockId{
+			AccountId:     accountId,
+			NamespaceName: request.NamespaceName,
+			LockName:      request.LockName,
+		},
+		FencingToken: request.FencingToken,
+		Now:          now.UnixNano(),
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+
+	return &gatewaypb.ValidateFencingTokenResponse{
+		Valid: res.Valid,
+		Lock:  lockToFront(res.Lock),
+	}, nil
+}
+
+func (s *LocksServiceApiServer) AcquirePermits(ctx context.Context, request *gatewaypb.AcquirePermitsRequest) (*gatewaypb.AcquirePermitsResponse, error) {
+	accountId := ctx.Value("account-id").(uint64)
+
+	now := time.Now()
+
+	// Validation
+	if err := validateAcquirePermitsRequest(request); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	res1, err := s.coreApiClient.GetAccount(ctx, &corepb.GetAccountRequest{
+		AccountId: accountId,
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+	account := res1.Account
+
+	res2, err := s.coreApiClient.GetNamespace(ctx, &corepb.GetNamespaceRequest{
+		NamespaceId: &corepb.NamespaceId{
+			AccountId:     accountId,
+			NamespaceName: request.NamespaceName,
+		},
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+	namespace := res2.Namespace
+
+	// Semaphore permits are leased with the same namespace limits as locks
+	_, ttl, _ := namespaceLockTtls(namespace)
+	if request.Ttl > 0 {
+		ttl = request.Ttl
+	}
+
+	if err := validateLockLease("AcquirePermitsRequest", ttl, namespace); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	res3, err := s.coreApiClient.AcquirePermits(ctx, &corepb.AcquirePermitsRequest{
+		SemaphoreId: &corepb.SemaphoreId{
+			AccountId:     accountId,
+			NamespaceName: request.NamespaceName,
+			SemaphoreName: request.SemaphoreName,
+		},
+		ProcessId: request.ProcessId,
+		Permits:   request.Permits,
+		Now:       now.UnixNano(),
+		ExpiresAt: now.Add(time.Duration(ttl) * time.Millisecond).UnixNano(),
+		Capacity:  request.Capacity,
+
+		MaxNumberOfLocksPerNamespace: account.MaxNumberOfLocksPerNamespace,
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+
+	return &gatewaypb.AcquirePermitsResponse{
+		Semaphore: semaphoreToFront(res3.Semaphore),
+		Success:   res3.Success,
+	}, nil
+}
+
+func (s *LocksServiceApiServer) ReleasePermits(ctx context.Context, request *gatewaypb.ReleasePermitsRequest) (*gatewaypb.ReleasePermitsResponse, error) {
+	accountId := ctx.Value("account-id").(uint64)
+
+	now := time.Now()
+
+	// Validation
+	if err := validateReleasePermitsRequest(request); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	res, err := s.coreApiClient.ReleasePermits(ctx, &corepb.ReleasePermitsRequest{
+		SemaphoreId: &corepb.SemaphoreId{
+			AccountId:     accountId,
+			NamespaceName: request.NamespaceName,
+			SemaphoreName: request.SemaphoreName,
+		},
+		ProcessId: request.ProcessId,
+		Permits:   request.Permits,
+		Now:       now.UnixNano(),
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+
+	return &gatewaypb.ReleasePermitsResponse{
+		Semaphore: semaphoreToFront(res.Semaphore),
+	}, nil
+}
+
+func (s *LocksServiceApiServer) GetSemaphore(ctx context.Context, request *gatewaypb.GetSemaphoreRequest) (*gatewaypb.GetSemaphoreResponse, error) {
+	accountId := ctx.Value("account-id").(uint64)
+
+	now := time.Now()
+
+	// Validation
+	if err := validateGetSemaphoreRequest(request); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	res, err := s.coreApiClient.GetSemaphore(ctx, &corepb.GetSemaphoreRequest{
+		SemaphoreId: &corepb.SemaphoreId{
+			AccountId:     accountId,
+			NamespaceName: request.NamespaceName,
+			SemaphoreName: request.SemaphoreName,
+		},
+		Now: now.UnixNano(),
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+
+	return &gatewaypb.GetSemaphoreResponse{
+		Semaphore: semaphoreToFront(res.Semaphore),
+	}, nil
+}
+
+func (s *LocksServiceApiServer) SetSemaphoreCapacity(ctx context.Context, request *gatewaypb.SetSemaphoreCapacityRequest) (*gatewaypb.SetSemaphoreCapacityResponse, error) {
+	accountId := ctx.Value("account-id").(uint64)
+
+	now := time.Now()
+
+	// Validation
+	if err := validateSetSemaphoreCapacityRequest(request); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	res1, err := s.coreApiClient.GetAccount(ctx, &corepb.GetAccountRequest{
+		AccountId: accountId,
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+	account := res1.Account
+
+	res2, err := s.coreApiClient.SetSemaphoreCapacity(ctx, &corepb.SetSemaphoreCapacityRequest{
+		SemaphoreId: &corepb.SemaphoreId{
+			AccountId:     accountId,
+			NamespaceName: request.NamespaceName,
+			SemaphoreName: request.SemaphoreName,
+		},
+		Capacity: request.Capacity,
+		Now:      now.UnixNano(),
+
+		MaxNumberOfLocksPerNamespace: account.MaxNumberOfLocksPerNamespace,
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+
+	return &gatewaypb.SetSemaphoreCapacityResponse{
+		Semaphore: semaphoreToFront(res2.Semaphore),
+	}, nil
+}
+
 func NewLocksServiceApiServer(coreApiClient LocksServiceCoreApi) *LocksServiceApiServer {
 	return &LocksServiceApiServer{
 		coreApiClient: coreApiClient,
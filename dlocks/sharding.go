@@ -57,6 +57,10 @@ func (g *ShardKeyCalculator) GetLockShardKey(request *corepb.GetLockRequest) []b
 	return shardByAccountAndNamespace(request.LockId.AccountId, request.LockId.NamespaceName)
 }
 
+func (g *ShardKeyCalculator) GetLockFromFollowerShardKey(request *corepb.GetLockFromFollowerRequest) []byte {
+	return shardByAccountAndNamespace(request.LockId.AccountId, request.LockId.NamespaceName)
+}
+
 func (g *ShardKeyCalculator) GetNamespaceShardKey(request *corepb.GetNamespaceRequest) []byte {
 	return shardByAccount(request.NamespaceId.AccountId)
 }
@@ -69,6 +73,46 @@ func (g *ShardKeyCalculator) ReleaseLockShardKey(request *corepb.ReleaseLockRequ
 	return shardByAccountAndNamespace(request.LockId.AccountId, request.LockId.NamespaceName)
 }
 
+func (g *ShardKeyCalculator) CancelLockWaitShardKey(request *corepb.CancelLockWaitRequest) []byte {
+	return shardByAccountAndNamespace(request.LockId.AccountId, request.LockId.NamespaceName)
+}
+
+func (g *ShardKeyCalculator) AcquireLocksShardKey(request *corepb.AcquireLocksRequest) []byte {
+	return shardByAccountAndNamespace(request.NamespaceId.AccountId, request.NamespaceId.NamespaceName)
+}
+
+func (g *ShardKeyCalculator) ReleaseLocksShardKey(request *corepb.ReleaseLocksRequest) []byte {
+	return shardByAccountAndNamespace(request.NamespaceId.AccountId, request.NamespaceId.NamespaceName)
+}
+
+func (g *ShardKeyCalculator) ConvertLockShardKey(request *corepb.ConvertLockRequest) []byte {
+	return shardByAccountAndNamespace(request.LockId.AccountId, request.LockId.NamespaceName)
+}
+
+func (g *ShardKeyCalculator) ListLocksShardKey(request *corepb.ListLocksRequest) []byte {
+	return shardByAccountAndNamespace(request.NamespaceId.AccountId, request.NamespaceId.NamespaceName)
+}
+
+func (g *ShardKeyCalculator) ValidateFencingTokenShardKey(request *corepb.ValidateFencingTokenRequest) []byte {
+	return shardByAccountAndNamespace(request.LockId.AccountId, request.LockId.NamespaceName)
+}
+
 func (g *ShardKeyCalculator) UpdateNamespaceShardKey(request *corepb.UpdateNamespaceRequest) []byte {
 	return shardByAccount(request.NamespaceId.AccountId)
 }
+
+func (g *ShardKeyCalculator) AcquirePermitsShardKey(request *corepb.AcquirePermitsRequest) []byte {
+	return shardByAccountAndNamespace(request.SemaphoreId.AccountId, request.SemaphoreId.NamespaceName)
+}
+
+func (g *ShardKeyCalculator) GetSemaphoreShardKey(request *corepb.GetSemaphoreRequest) []byte {
+	return shardByAccountAndNamespace(request.SemaphoreId.AccountId, request.SemaphoreId.NamespaceName)
+}
+
+func (g *ShardKeyCalculator) ReleasePermitsShardKey(request *corepb.ReleasePermitsRequest) []byte {
+	return shardByAccountAndNamespace(request.SemaphoreId.AccountId, request.SemaphoreId.NamespaceName)
+}
+
+func (g *ShardKeyCalculator) SetSemaphoreCapacityShardKey(request *corepb.SetSemaphoreCapacityRequest) []byte {
+	return shardByAccountAndNamespace(request.SemaphoreId.AccountId, request.SemaphoreId.NamespaceName)
+}
@@ -24,10 +24,21 @@ type LocksServiceMonsteraShardKeyCalculator interface {
 	UpdateNamespaceShardKey(request *corepb.UpdateNamespaceRequest) []byte
 	DeleteNamespaceShardKey(request *corepb.DeleteNamespaceRequest) []byte
 
+	ValidateFencingTokenShardKey(request *corepb.ValidateFencingTokenRequest) []byte
+	ListLocksShardKey(request *corepb.ListLocksRequest) []byte
+	GetLockShardKey(request *corepb.GetLockRequest) []byte
+	GetLockFromFollowerShardKey(request *corepb.GetLockFromFollowerRequest) []byte
+	GetSemaphoreShardKey(request *corepb.GetSemaphoreRequest) []byte
 	AcquireLockShardKey(request *corepb.AcquireLockRequest) []byte
 	ReleaseLockShardKey(request *corepb.ReleaseLockRequest) []byte
+	CancelLockWaitShardKey(request *corepb.CancelLockWaitRequest) []byte
 	DeleteLockShardKey(request *corepb.DeleteLockRequest) []byte
-	GetLockShardKey(request *corepb.GetLockRequest) []byte
+	ConvertLockShardKey(request *corepb.ConvertLockRequest) []byte
+	AcquireLocksShardKey(request *corepb.AcquireLocksRequest) []byte
+	ReleaseLocksShardKey(request *corepb.ReleaseLocksRequest) []byte
+	AcquirePermitsShardKey(request *corepb.AcquirePermitsRequest) []byte
+	ReleasePermitsShardKey(request *corepb.ReleasePermitsRequest) []byte
+	SetSemaphoreCapacityShardKey(request *corepb.SetSemaphoreCapacityRequest) []byte
 }
 
 type LocksServiceCoreApiMonsteraStub struct {
@@ -317,6 +328,146 @@ func (s *LocksServiceCoreApiMonsteraStub) DeleteNamespace(ctx context.Context, r
 	}
 }
 
+func (s *LocksServiceCoreApiMonsteraStub) ValidateFencingToken(ctx context.Context, request *corepb.ValidateFencingTokenRequest) (*corepb.ValidateFencingTokenResponse, error) {
+	readRequest := &corepb.ReadRequest{Request: &corepb.ReadRequest_ValidateFencingTokenRequest{ValidateFencingTokenRequest: request}}
+	requestBytes, err := proto.Marshal(readRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.ValidateFencingTokenShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Read(ctx, "Locks", shardKey, false, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	readResponse := &corepb.ReadResponse{}
+	err = proto.Unmarshal(responseBytes, readResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := readResponse.Response.(*corepb.ReadResponse_ValidateFencingTokenResponse)
+	if ok {
+		return response.ValidateFencingTokenResponse, nilifyIfEmpty(readResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": readResponse.String()})
+	}
+}
+
+func (s *LocksServiceCoreApiMonsteraStub) ListLocks(ctx context.Context, request *corepb.ListLocksRequest) (*corepb.ListLocksResponse, error) {
+	readRequest := &corepb.ReadRequest{Request: &corepb.ReadRequest_ListLocksRequest{ListLocksRequest: request}}
+	requestBytes, err := proto.Marshal(readRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.ListLocksShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Read(ctx, "Locks", shardKey, false, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	readResponse := &corepb.ReadResponse{}
+	err = proto.Unmarshal(responseBytes, readResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := readResponse.Response.(*corepb.ReadResponse_ListLocksResponse)
+	if ok {
+		return response.ListLocksResponse, nilifyIfEmpty(readResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": readResponse.String()})
+	}
+}
+
+func (s *LocksServiceCoreApiMonsteraStub) GetLock(ctx context.Context, request *corepb.GetLockRequest) (*corepb.GetLockResponse, error) {
+	readRequest := &corepb.ReadRequest{Request: &corepb.ReadRequest_GetLockRequest{GetLockRequest: request}}
+	requestBytes, err := proto.Marshal(readRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.GetLockShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Read(ctx, "Locks", shardKey, false, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	readResponse := &corepb.ReadResponse{}
+	err = proto.Unmarshal(responseBytes, readResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := readResponse.Response.(*corepb.ReadResponse_GetLockResponse)
+	if ok {
+		return response.GetLockResponse, nilifyIfEmpty(readResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": readResponse.String()})
+	}
+}
+
+func (s *LocksServiceCoreApiMonsteraStub) GetLockFromFollower(ctx context.Context, request *corepb.GetLockFromFollowerRequest) (*corepb.GetLockFromFollowerResponse, error) {
+	readRequest := &corepb.ReadRequest{Request: &corepb.ReadRequest_GetLockFromFollowerRequest{GetLockFromFollowerRequest: request}}
+	requestBytes, err := proto.Marshal(readRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.GetLockFromFollowerShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Read(ctx, "Locks", shardKey, true, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	readResponse := &corepb.ReadResponse{}
+	err = proto.Unmarshal(responseBytes, readResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := readResponse.Response.(*corepb.ReadResponse_GetLockFromFollowerResponse)
+	if ok {
+		return response.GetLockFromFollowerResponse, nilifyIfEmpty(readResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": readResponse.String()})
+	}
+}
+
+func (s *LocksServiceCoreApiMonsteraStub) GetSemaphore(ctx context.Context, request *corepb.GetSemaphoreRequest) (*corepb.GetSemaphoreResponse, error) {
+	readRequest := &corepb.ReadRequest{Request: &corepb.ReadRequest_GetSemaphoreRequest{GetSemaphoreRequest: request}}
+	requestBytes, err := proto.Marshal(readRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.GetSemaphoreShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Read(ctx, "Locks", shardKey, false, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	readResponse := &corepb.ReadResponse{}
+	err = proto.Unmarshal(responseBytes, readResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := readResponse.Response.(*corepb.ReadResponse_GetSemaphoreResponse)
+	if ok {
+		return response.GetSemaphoreResponse, nilifyIfEmpty(readResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": readResponse.String()})
+	}
+}
+
 func (s *LocksServiceCoreApiMonsteraStub) AcquireLock(ctx context.Context, request *corepb.AcquireLockRequest) (*corepb.AcquireLockResponse, error) {
 	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_AcquireLockRequest{AcquireLockRequest: request}}
 	requestBytes, err := proto.Marshal(updateRequest)
@@ -373,6 +524,34 @@ func (s *LocksServiceCoreApiMonsteraStub) ReleaseLock(ctx context.Context, reque
 	}
 }
 
+func (s *LocksServiceCoreApiMonsteraStub) CancelLockWait(ctx context.Context, request *corepb.CancelLockWaitRequest) (*corepb.CancelLockWaitResponse, error) {
+	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_CancelLockWaitRequest{CancelLockWaitRequest: request}}
+	requestBytes, err := proto.Marshal(updateRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.CancelLockWaitShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Update(ctx, "Locks", shardKey, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	updateResponse := &corepb.UpdateResponse{}
+	err = proto.Unmarshal(responseBytes, updateResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := updateResponse.Response.(*corepb.UpdateResponse_CancelLockWaitResponse)
+	if ok {
+		return response.CancelLockWaitResponse, nilifyIfEmpty(updateResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": updateResponse.String()})
+	}
+}
+
 func (s *LocksServiceCoreApiMonsteraStub) DeleteLock(ctx context.Context, request *corepb.DeleteLockRequest) (*corepb.DeleteLockResponse, error) {
 	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_DeleteLockRequest{DeleteLockRequest: request}}
 	requestBytes, err := proto.Marshal(updateRequest)
@@ -401,14 +580,42 @@ func (s *LocksServiceCoreApiMonsteraStub) DeleteLock(ctx context.Context, reques
 	}
 }
 
-func (s *LocksServiceCoreApiMonsteraStub) GetLock(ctx context.Context, request *corepb.GetLockRequest) (*corepb.GetLockResponse, error) {
-	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_GetLockRequest{GetLockRequest: request}}
+func (s *LocksServiceCoreApiMonsteraStub) ConvertLock(ctx context.Context, request *corepb.ConvertLockRequest) (*corepb.ConvertLockResponse, error) {
+	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_ConvertLockRequest{ConvertLockRequest: request}}
 	requestBytes, err := proto.Marshal(updateRequest)
 	if err != nil {
 		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
 	}
 
-	shardKey := s.shardKeyCalculator.GetLockShardKey(request)
+	shardKey := s.shardKeyCalculator.ConvertLockShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Update(ctx, "Locks", shardKey, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	updateResponse := &corepb.UpdateResponse{}
+	err = proto.Unmarshal(responseBytes, updateResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := updateResponse.Response.(*corepb.UpdateResponse_ConvertLockResponse)
+	if ok {
+		return response.ConvertLockResponse, nilifyIfEmpty(updateResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": updateResponse.String()})
+	}
+}
+
+func (s *LocksServiceCoreApiMonsteraStub) AcquireLocks(ctx context.Context, request *corepb.AcquireLocksRequest) (*corepb.AcquireLocksResponse, error) {
+	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_AcquireLocksRequest{AcquireLocksRequest: request}}
+	requestBytes, err := proto.Marshal(updateRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.AcquireLocksShardKey(request)
 
 	responseBytes, err := s.monsteraClient.Update(ctx, "Locks", shardKey, requestBytes)
 	if err != nil {
@@ -421,9 +628,147 @@ func (s *LocksServiceCoreApiMonsteraStub) GetLock(ctx context.Context, request *
 		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
 	}
 
-	response, ok := updateResponse.Response.(*corepb.UpdateResponse_GetLockResponse)
+	response, ok := updateResponse.Response.(*corepb.UpdateResponse_AcquireLocksResponse)
 	if ok {
-		return response.GetLockResponse, nilifyIfEmpty(updateResponse.Error)
+		return response.AcquireLocksResponse, nilifyIfEmpty(updateResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": updateResponse.String()})
+	}
+}
+
+func (s *LocksServiceCoreApiMonsteraStub) ReleaseLocks(ctx context.Context, request *corepb.ReleaseLocksRequest) (*corepb.ReleaseLocksResponse, error) {
+	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_ReleaseLocksRequest{ReleaseLocksRequest: request}}
+	requestBytes, err := proto.Marshal(updateRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.ReleaseLocksShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Update(ctx, "Locks", shardKey, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	updateResponse := &corepb.UpdateResponse{}
+	err = proto.Unmarshal(responseBytes, updateResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := updateResponse.Response.(*corepb.UpdateResponse_ReleaseLocksResponse)
+	if ok {
+		return response.ReleaseLocksResponse, nilifyIfEmpty(updateResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": updateResponse.String()})
+	}
+}
+
+func (s *LocksServiceCoreApiMonsteraStub) SweepExpiredLocks(ctx context.Context, request *corepb.SweepExpiredLocksRequest, shardId string) (*corepb.SweepExpiredLocksResponse, error) {
+	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_SweepExpiredLocksRequest{SweepExpiredLocksRequest: request}}
+	requestBytes, err := proto.Marshal(updateRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	responseBytes, err := s.monsteraClient.UpdateShard(ctx, "Locks", shardId, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	updateResponse := &corepb.UpdateResponse{}
+	err = proto.Unmarshal(responseBytes, updateResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := updateResponse.Response.(*corepb.UpdateResponse_SweepExpiredLocksResponse)
+	if ok {
+		return response.SweepExpiredLocksResponse, nilifyIfEmpty(updateResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": updateResponse.String()})
+	}
+}
+
+func (s *LocksServiceCoreApiMonsteraStub) AcquirePermits(ctx context.Context, request *corepb.AcquirePermitsRequest) (*corepb.AcquirePermitsResponse, error) {
+	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_AcquirePermitsRequest{AcquirePermitsRequest: request}}
+	requestBytes, err := proto.Marshal(updateRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.AcquirePermitsShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Update(ctx, "Locks", shardKey, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	updateResponse := &corepb.UpdateResponse{}
+	err = proto.Unmarshal(responseBytes, updateResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := updateResponse.Response.(*corepb.UpdateResponse_AcquirePermitsResponse)
+	if ok {
+		return response.AcquirePermitsResponse, nilifyIfEmpty(updateResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": updateResponse.String()})
+	}
+}
+
+func (s *LocksServiceCoreApiMonsteraStub) ReleasePermits(ctx context.Context, request *corepb.ReleasePermitsRequest) (*corepb.ReleasePermitsResponse, error) {
+	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_ReleasePermitsRequest{ReleasePermitsRequest: request}}
+	requestBytes, err := proto.Marshal(updateRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.ReleasePermitsShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Update(ctx, "Locks", shardKey, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	updateResponse := &corepb.UpdateResponse{}
+	err = proto.Unmarshal(responseBytes, updateResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := updateResponse.Response.(*corepb.UpdateResponse_ReleasePermitsResponse)
+	if ok {
+		return response.ReleasePermitsResponse, nilifyIfEmpty(updateResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": updateResponse.String()})
+	}
+}
+
+func (s *LocksServiceCoreApiMonsteraStub) SetSemaphoreCapacity(ctx context.Context, request *corepb.SetSemaphoreCapacityRequest) (*corepb.SetSemaphoreCapacityResponse, error) {
+	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_SetSemaphoreCapacityRequest{SetSemaphoreCapacityRequest: request}}
+	requestBytes, err := proto.Marshal(updateRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.SetSemaphoreCapacityShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Update(ctx, "Locks", shardKey, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	updateResponse := &corepb.UpdateResponse{}
+	err = proto.Unmarshal(responseBytes, updateResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := updateResponse.Response.(*corepb.UpdateResponse_SetSemaphoreCapacityResponse)
+	if ok {
+		return response.SetSemaphoreCapacityResponse, nilifyIfEmpty(updateResponse.Error)
 	} else {
 		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": updateResponse.String()})
 	}
@@ -521,6 +866,41 @@ func (s *LocksServiceCoreApiStandaloneStub) DeleteNamespace(ctx context.Context,
 	return s.namespacesCore.DeleteNamespace(request)
 }
 
+func (s *LocksServiceCoreApiStandaloneStub) ValidateFencingToken(ctx context.Context, request *corepb.ValidateFencingTokenRequest) (*corepb.ValidateFencingTokenResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.locksCore.ValidateFencingToken(request)
+}
+
+func (s *LocksServiceCoreApiStandaloneStub) ListLocks(ctx context.Context, request *corepb.ListLocksRequest) (*corepb.ListLocksResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.locksCore.ListLocks(request)
+}
+
+func (s *LocksServiceCoreApiStandaloneStub) GetLock(ctx context.Context, request *corepb.GetLockRequest) (*corepb.GetLockResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.locksCore.GetLock(request)
+}
+
+func (s *LocksServiceCoreApiStandaloneStub) GetLockFromFollower(ctx context.Context, request *corepb.GetLockFromFollowerRequest) (*corepb.GetLockFromFollowerResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.locksCore.GetLockFromFollower(request)
+}
+
+func (s *LocksServiceCoreApiStandaloneStub) GetSemaphore(ctx context.Context, request *corepb.GetSemaphoreRequest) (*corepb.GetSemaphoreResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.locksCore.GetSemaphore(request)
+}
+
 func (s *LocksServiceCoreApiStandaloneStub) AcquireLock(ctx context.Context, request *corepb.AcquireLockRequest) (*corepb.AcquireLockResponse, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -535,6 +915,13 @@ func (s *LocksServiceCoreApiStandaloneStub) ReleaseLock(ctx context.Context, req
 	return s.locksCore.ReleaseLock(request)
 }
 
+func (s *LocksServiceCoreApiStandaloneStub) CancelLockWait(ctx context.Context, request *corepb.CancelLockWaitRequest) (*corepb.CancelLockWaitResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.locksCore.CancelLockWait(request)
+}
+
 func (s *LocksServiceCoreApiStandaloneStub) DeleteLock(ctx context.Context, request *corepb.DeleteLockRequest) (*corepb.DeleteLockResponse, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -542,11 +929,53 @@ func (s *LocksServiceCoreApiStandaloneStub) DeleteLock(ctx context.Context, requ
 	return s.locksCore.DeleteLock(request)
 }
 
-func (s *LocksServiceCoreApiStandaloneStub) GetLock(ctx context.Context, request *corepb.GetLockRequest) (*corepb.GetLockResponse, error) {
+func (s *LocksServiceCoreApiStandaloneStub) ConvertLock(ctx context.Context, request *corepb.ConvertLockRequest) (*corepb.ConvertLockResponse, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	return s.locksCore.GetLock(request)
+	return s.locksCore.ConvertLock(request)
+}
+
+func (s *LocksServiceCoreApiStandaloneStub) AcquireLocks(ctx context.Context, request *corepb.AcquireLocksRequest) (*corepb.AcquireLocksResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.locksCore.AcquireLocks(request)
+}
+
+func (s *LocksServiceCoreApiStandaloneStub) ReleaseLocks(ctx context.Context, request *corepb.ReleaseLocksRequest) (*corepb.ReleaseLocksResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.locksCore.ReleaseLocks(request)
+}
+
+func (s *LocksServiceCoreApiStandaloneStub) SweepExpiredLocks(ctx context.Context, request *corepb.SweepExpiredLocksRequest, shardId string) (*corepb.SweepExpiredLocksResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.locksCore.SweepExpiredLocks(request)
+}
+
+func (s *LocksServiceCoreApiStandaloneStub) AcquirePermits(ctx context.Context, request *corepb.AcquirePermitsRequest) (*corepb.AcquirePermitsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.locksCore.AcquirePermits(request)
+}
+
+func (s *LocksServiceCoreApiStandaloneStub) ReleasePermits(ctx context.Context, request *corepb.ReleasePermitsRequest) (*corepb.ReleasePermitsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.locksCore.ReleasePermits(request)
+}
+
+func (s *LocksServiceCoreApiStandaloneStub) SetSemaphoreCapacity(ctx context.Context, request *corepb.SetSemaphoreCapacityRequest) (*corepb.SetSemaphoreCapacityResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.locksCore.SetSemaphoreCapacity(request)
 }
 
 func NewLocksServiceCoreApiStandaloneStub(accountsCore AccountsCoreApi, namespacesCore NamespacesCoreApi, locksCore LocksCoreApi) *LocksServiceCoreApiStandaloneStub {
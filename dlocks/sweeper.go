@@ -0,0 +1,83 @@
+package dlocks
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/evrblk/monstera-example/dlocks/corepb"
+)
+
+const sweepBatchSize = 100
+
+// ExpiredLocksSweeper periodically deletes expired locks on all shards of Locks core. Expired locks are also cleaned
+// up lazily when they are accessed, the sweeper only makes sure that abandoned locks do not accumulate in storage.
+type ExpiredLocksSweeper struct {
+	coreApiClient LocksServiceCoreApi
+	shardIds      []string
+	interval      time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func (s *ExpiredLocksSweeper) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				for _, shardId := range s.shardIds {
+					s.sweepShard(ctx, shardId)
+				}
+			}
+		}
+	}()
+}
+
+func (s *ExpiredLocksSweeper) Stop() {
+	log.Println("Stopping ExpiredLocksSweeper...")
+
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+}
+
+func (s *ExpiredLocksSweeper) sweepShard(ctx context.Context, shardId string) {
+	for {
+		now := time.Now()
+
+		resp, err := s.coreApiClient.SweepExpiredLocks(ctx, &corepb.SweepExpiredLocksRequest{
+			Now:   now.UnixNano(),
+			Limit: sweepBatchSize,
+		}, shardId)
+		if err != nil {
+			log.Printf("failed to sweep expired locks on shard %s: %v", shardId, err)
+			return
+		}
+
+		if resp.SweptLocks == 0 && resp.SweptSemaphores == 0 {
+			return
+		}
+	}
+}
+
+func NewExpiredLocksSweeper(coreApiClient LocksServiceCoreApi, shardIds []string, interval time.Duration) *ExpiredLocksSweeper {
+	return &ExpiredLocksSweeper{
+		coreApiClient: coreApiClient,
+		shardIds:      shardIds,
+		interval:      interval,
+	}
+}
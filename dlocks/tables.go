@@ -5,7 +5,12 @@ var (
 	accountsTableId       = []byte{0x00, 0x00}
 	accountsEmailsIndexId = []byte{0x00, 0x01}
 
-	locksTableId = []byte{0x01, 0x00}
+	locksTableId            = []byte{0x01, 0x00}
+	fencingTokensTableId    = []byte{0x01, 0x01}
+	locksCountersTableId    = []byte{0x01, 0x02}
+	locksExpiryIndexId      = []byte{0x01, 0x03}
+	semaphoresTableId       = []byte{0x01, 0x04}
+	semaphoresExpiryIndexId = []byte{0x01, 0x05}
 
 	namespacesTableId = []byte{0x02, 0x00}
 )
@@ -3,6 +3,7 @@ package dlocks
 import (
 	"fmt"
 
+	"github.com/evrblk/monstera-example/dlocks/corepb"
 	"github.com/evrblk/monstera-example/dlocks/gatewaypb"
 )
 
@@ -11,6 +12,18 @@ const (
 	maxLockNameLength      = 128
 	maxProcessIdLength     = 128
 	maxDescriptionLength   = 1024
+	defaultLocksPageSize   = 100
+	maxLocksPageSize       = 1000
+	maxLocksPerRequest     = 100
+	maxSemaphoreNameLength = 128
+	maxSemaphoreCapacity   = 1000000
+
+	// Lock TTLs are in milliseconds. Namespaces which do not configure TTLs get the defaults.
+	minLockTtl                 = 100
+	maxLockTtl                 = 24 * 60 * 60 * 1000
+	defaultNamespaceMinLockTtl = 1000
+	defaultNamespaceLockTtl    = 60 * 1000
+	defaultNamespaceMaxLockTtl = 60 * 60 * 1000
 )
 
 func validateCreateNamespaceRequest(request *gatewaypb.CreateNamespaceRequest) error {
@@ -25,6 +38,10 @@ func validateCreateNamespaceRequest(request *gatewaypb.CreateNamespaceRequest) e
 		return fmt.Errorf("invalid CreateNamespaceRequest.Description")
 	}
 
+	if err := validateLockTtls("CreateNamespaceRequest", request.MinLockTtl, request.DefaultLockTtl, request.MaxLockTtl); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -51,6 +68,17 @@ func validateUpdateNamespaceRequest(request *gatewaypb.UpdateNamespaceRequest) e
 		return fmt.Errorf("invalid UpdateNamespaceRequest.Description")
 	}
 
+	// Zero TTLs keep current values of the namespace, so min <= default <= max is checked by the core after merging
+	if request.MinLockTtl < 0 || request.DefaultLockTtl < 0 || request.MaxLockTtl < 0 {
+		return fmt.Errorf("invalid UpdateNamespaceRequest: lock TTLs should not be negative")
+	}
+	if request.MinLockTtl != 0 && request.MinLockTtl < minLockTtl {
+		return fmt.Errorf("invalid UpdateNamespaceRequest.MinLockTtl")
+	}
+	if request.MaxLockTtl > maxLockTtl {
+		return fmt.Errorf("invalid UpdateNamespaceRequest.MaxLockTtl")
+	}
+
 	return nil
 }
 
@@ -105,6 +133,145 @@ func validateGetLockRequest(request *gatewaypb.GetLockRequest) error {
 	return nil
 }
 
+func validateListLocksRequest(request *gatewaypb.ListLocksRequest) error {
+	if request.NamespaceName == "" {
+		return fmt.Errorf("invalid ListLocksRequest.NamespaceName")
+	}
+	if len(request.NamespaceName) > maxNamespaceNameLength {
+		return fmt.Errorf("invalid ListLocksRequest.NamespaceName")
+	}
+
+	if len(request.ProcessId) > maxProcessIdLength {
+		return fmt.Errorf("invalid ListLocksRequest.ProcessId")
+	}
+
+	if len(request.PageToken) > maxLockNameLength {
+		return fmt.Errorf("invalid ListLocksRequest.PageToken")
+	}
+
+	if request.PageSize < 0 || request.PageSize > maxLocksPageSize {
+		return fmt.Errorf("invalid ListLocksRequest.PageSize")
+	}
+
+	return nil
+}
+
+func validateValidateFencingTokenRequest(request *gatewaypb.ValidateFencingTokenRequest) error {
+	if request.NamespaceName == "" {
+		return fmt.Errorf("invalid ValidateFencingTokenRequest.NamespaceName")
+	}
+	if len(request.NamespaceName) > maxNamespaceNameLength {
+		return fmt.Errorf("invalid ValidateFencingTokenRequest.NamespaceName")
+	}
+
+	if request.LockName == "" {
+		return fmt.Errorf("invalid ValidateFencingTokenRequest.LockName")
+	}
+	if len(request.LockName) > maxLockNameLength {
+		return fmt.Errorf("invalid ValidateFencingTokenRequest.LockName")
+	}
+
+	if request.FencingToken == 0 {
+		return fmt.Errorf("invalid ValidateFencingTokenRequest.FencingToken")
+	}
+
+	return nil
+}
+
+func validateAcquireLocksRequest(request *gatewaypb.AcquireLocksRequest) error {
+	if request.NamespaceName == "" {
+		return fmt.Errorf("invalid AcquireLocksRequest.NamespaceName")
+	}
+	if len(request.NamespaceName) > maxNamespaceNameLength {
+		return fmt.Errorf("invalid AcquireLocksRequest.NamespaceName")
+	}
+
+	if err := validateLockNames("AcquireLocksRequest", request.LockNames); err != nil {
+		return err
+	}
+
+	if request.ProcessId == "" {
+		return fmt.Errorf("invalid AcquireLocksRequest.ProcessId")
+	}
+	if len(request.ProcessId) > maxProcessIdLength {
+		return fmt.Errorf("invalid AcquireLocksRequest.ProcessId")
+	}
+
+	if request.Ttl < 0 {
+		return fmt.Errorf("invalid AcquireLocksRequest.Ttl")
+	}
+
+	return nil
+}
+
+func validateReleaseLocksRequest(request *gatewaypb.ReleaseLocksRequest) error {
+	if request.NamespaceName == "" {
+		return fmt.Errorf("invalid ReleaseLocksRequest.NamespaceName")
+	}
+	if len(request.NamespaceName) > maxNamespaceNameLength {
+		return fmt.Errorf("invalid ReleaseLocksRequest.NamespaceName")
+	}
+
+	if err := validateLockNames("ReleaseLocksRequest", request.LockNames); err != nil {
+		return err
+	}
+
+	if request.ProcessId == "" {
+		return fmt.Errorf("invalid ReleaseLocksRequest.ProcessId")
+	}
+	if len(request.ProcessId) > maxProcessIdLength {
+		return fmt.Errorf("invalid ReleaseLocksRequest.ProcessId")
+	}
+
+	return nil
+}
+
+// validateLockNames checks a list of lock names of a multi-lock request. Names must be unique.
+func validateLockNames(requestName string, lockNames []string) error {
+	if len(lockNames) == 0 || len(lockNames) > maxLocksPerRequest {
+		return fmt.Errorf("invalid %s.LockNames", requestName)
+	}
+
+	seen := make(map[string]bool, len(lockNames))
+	for _, lockName := range lockNames {
+		if lockName == "" || len(lockName) > maxLockNameLength || seen[lockName] {
+			return fmt.Errorf("invalid %s.LockNames", requestName)
+		}
+		seen[lockName] = true
+	}
+
+	return nil
+}
+
+func validateConvertLockRequest(request *gatewaypb.ConvertLockRequest) error {
+	if request.NamespaceName == "" {
+		return fmt.Errorf("invalid ConvertLockRequest.NamespaceName")
+	}
+	if len(request.NamespaceName) > maxNamespaceNameLength {
+		return fmt.Errorf("invalid ConvertLockRequest.NamespaceName")
+	}
+
+	if request.LockName == "" {
+		return fmt.Errorf("invalid ConvertLockRequest.LockName")
+	}
+	if len(request.LockName) > maxLockNameLength {
+		return fmt.Errorf("invalid ConvertLockRequest.LockName")
+	}
+
+	if request.ProcessId == "" {
+		return fmt.Errorf("invalid ConvertLockRequest.ProcessId")
+	}
+	if len(request.ProcessId) > maxProcessIdLength {
+		return fmt.Errorf("invalid ConvertLockRequest.ProcessId")
+	}
+
+	if request.Ttl < 0 {
+		return fmt.Errorf("invalid ConvertLockRequest.Ttl")
+	}
+
+	return nil
+}
+
 func validateReleaseLockRequest(request *gatewaypb.ReleaseLockRequest) error {
 	if request.NamespaceName == "" {
 		return fmt.Errorf("invalid ReleaseLockRequest.NamespaceName")
@@ -152,5 +319,172 @@ func validateAcquireLockRequest(request *gatewaypb.AcquireLockRequest) error {
 		return fmt.Errorf("invalid AcquireLockRequest.ProcessId")
 	}
 
+	if request.WaitTimeout < 0 || request.WaitTimeout > maxAcquireLockWaitDuration.Milliseconds() {
+		return fmt.Errorf("invalid AcquireLockRequest.WaitTimeout")
+	}
+
+	if request.Ttl < 0 {
+		return fmt.Errorf("invalid AcquireLockRequest.Ttl")
+	}
+	if request.Ttl > 0 && request.ExpiresAt != 0 {
+		return fmt.Errorf("invalid AcquireLockRequest.Ttl: only one of ttl and expires_at can be set")
+	}
+
+	return nil
+}
+
+// validateLockLease checks that a lease duration (in milliseconds) fits into TTL limits of the namespace.
+func validateLockLease(requestName string, ttl int64, namespace *corepb.Namespace) error {
+	minTtl, _, maxTtl := namespaceLockTtls(namespace)
+
+	if ttl < minTtl || ttl > maxTtl {
+		return fmt.Errorf("invalid %s.Ttl: lease should be between %dms and %dms", requestName, minTtl, maxTtl)
+	}
+
+	return nil
+}
+
+// validateLockTtls checks TTL limits of a namespace. Zero values are replaced with defaults first.
+func validateLockTtls(requestName string, minTtl int64, defaultTtl int64, maxTtl int64) error {
+	if minTtl < 0 || defaultTtl < 0 || maxTtl < 0 {
+		return fmt.Errorf("invalid %s: lock TTLs should not be negative", requestName)
+	}
+
+	minTtl, defaultTtl, maxTtl = lockTtlsWithDefaults(minTtl, defaultTtl, maxTtl)
+
+	if minTtl < minLockTtl {
+		return fmt.Errorf("invalid %s.MinLockTtl", requestName)
+	}
+	if maxTtl > maxLockTtl {
+		return fmt.Errorf("invalid %s.MaxLockTtl", requestName)
+	}
+	if minTtl > defaultTtl || defaultTtl > maxTtl {
+		return fmt.Errorf("invalid %s: lock TTLs should satisfy min <= default <= max", requestName)
+	}
+
+	return nil
+}
+
+func lockTtlsWithDefaults(minTtl int64, defaultTtl int64, maxTtl int64) (int64, int64, int64) {
+	if minTtl == 0 {
+		minTtl = defaultNamespaceMinLockTtl
+	}
+	if defaultTtl == 0 {
+		defaultTtl = defaultNamespaceLockTtl
+	}
+	if maxTtl == 0 {
+		maxTtl = defaultNamespaceMaxLockTtl
+	}
+	return minTtl, defaultTtl, maxTtl
+}
+
+// namespaceLockTtls returns TTL limits of a namespace, namespaces created before TTLs were configurable get defaults.
+func namespaceLockTtls(namespace *corepb.Namespace) (int64, int64, int64) {
+	return lockTtlsWithDefaults(namespace.MinLockTtl, namespace.DefaultLockTtl, namespace.MaxLockTtl)
+}
+
+func validateAcquirePermitsRequest(request *gatewaypb.AcquirePermitsRequest) error {
+	if request.NamespaceName == "" {
+		return fmt.Errorf("invalid AcquirePermitsRequest.NamespaceName")
+	}
+	if len(request.NamespaceName) > maxNamespaceNameLength {
+		return fmt.Errorf("invalid AcquirePermitsRequest.NamespaceName")
+	}
+
+	if request.SemaphoreName == "" {
+		return fmt.Errorf("invalid AcquirePermitsRequest.SemaphoreName")
+	}
+	if len(request.SemaphoreName) > maxSemaphoreNameLength {
+		return fmt.Errorf("invalid AcquirePermitsRequest.SemaphoreName")
+	}
+
+	if request.ProcessId == "" {
+		return fmt.Errorf("invalid AcquirePermitsRequest.ProcessId")
+	}
+	if len(request.ProcessId) > maxProcessIdLength {
+		return fmt.Errorf("invalid AcquirePermitsRequest.ProcessId")
+	}
+
+	if request.Permits <= 0 || request.Permits > maxSemaphoreCapacity {
+		return fmt.Errorf("invalid AcquirePermitsRequest.Permits")
+	}
+
+	if request.Ttl < 0 {
+		return fmt.Errorf("invalid AcquirePermitsRequest.Ttl")
+	}
+
+	if request.Capacity < 0 || request.Capacity > maxSemaphoreCapacity {
+		return fmt.Errorf("invalid AcquirePermitsRequest.Capacity")
+	}
+
+	return nil
+}
+
+func validateReleasePermitsRequest(request *gatewaypb.ReleasePermitsRequest) error {
+	if request.NamespaceName == "" {
+		return fmt.Errorf("invalid ReleasePermitsRequest.NamespaceName")
+	}
+	if len(request.NamespaceName) > maxNamespaceNameLength {
+		return fmt.Errorf("invalid ReleasePermitsRequest.NamespaceName")
+	}
+
+	if request.SemaphoreName == "" {
+		return fmt.Errorf("invalid ReleasePermitsRequest.SemaphoreName")
+	}
+	if len(request.SemaphoreName) > maxSemaphoreNameLength {
+		return fmt.Errorf("invalid ReleasePermitsRequest.SemaphoreName")
+	}
+
+	if request.ProcessId == "" {
+		return fmt.Errorf("invalid ReleasePermitsRequest.ProcessId")
+	}
+	if len(request.ProcessId) > maxProcessIdLength {
+		return fmt.Errorf("invalid ReleasePermitsRequest.ProcessId")
+	}
+
+	if request.Permits < 0 {
+		return fmt.Errorf("invalid ReleasePermitsRequest.Permits")
+	}
+
+	return nil
+}
+
+func validateGetSemaphoreRequest(request *gatewaypb.GetSemaphoreRequest) error {
+	if request.NamespaceName == "" {
+		return fmt.Errorf("invalid GetSemaphoreRequest.NamespaceName")
+	}
+	if len(request.NamespaceName) > maxNamespaceNameLength {
+		return fmt.Errorf("invalid GetSemaphoreRequest.NamespaceName")
+	}
+
+	if request.SemaphoreName == "" {
+		return fmt.Errorf("invalid GetSemaphoreRequest.SemaphoreName")
+	}
+	if len(request.SemaphoreName) > maxSemaphoreNameLength {
+		return fmt.Errorf("invalid GetSemaphoreRequest.SemaphoreName")
+	}
+
+	return nil
+}
+
+func validateSetSemaphoreCapacityRequest(request *gatewaypb.SetSemaphoreCapacityRequest) error {
+	if request.NamespaceName == "" {
+		return fmt.Errorf("invalid SetSemaphoreCapacityRequest.NamespaceName")
+	}
+	if len(request.NamespaceName) > maxNamespaceNameLength {
+		return fmt.Errorf("invalid SetSemaphoreCapacityRequest.NamespaceName")
+	}
+
+	if request.SemaphoreName == "" {
+		return fmt.Errorf("invalid SetSemaphoreCapacityRequest.SemaphoreName")
+	}
+	if len(request.SemaphoreName) > maxSemaphoreNameLength {
+		return fmt.Errorf("invalid SetSemaphoreCapacityRequest.SemaphoreName")
+	}
+
+	if request.Capacity <= 0 || request.Capacity > maxSemaphoreCapacity {
+		return fmt.Errorf("invalid SetSemaphoreCapacityRequest.Capacity")
+	}
+
 	return nil
 }
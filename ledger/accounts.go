@@ -1,36 +1,95 @@
 package ledger
 
 import (
+	"encoding/binary"
 	"io"
+	"slices"
+	"strconv"
+	"time"
 
 	"errors"
 
 	"github.com/evrblk/monstera"
 	"github.com/evrblk/monstera-example/ledger/corepb"
 	monsterax "github.com/evrblk/monstera/x"
+	"google.golang.org/protobuf/proto"
 )
 
 type AccountsCore struct {
-	badgerStore *monstera.BadgerStore
+	badgerStore     *monstera.BadgerStore
+	shardLowerBound []byte
+	shardUpperBound []byte
 
 	accountsTable     *monsterax.SimpleKeyTable[*corepb.Account, corepb.Account]
 	transactionsTable *monsterax.CompositeKeyTable[*corepb.Transaction, corepb.Transaction]
+	disputesTable     *monsterax.CompositeKeyTable[*corepb.Dispute, corepb.Dispute]
+	alertRulesTable   *monsterax.CompositeKeyTable[*corepb.AlertRule, corepb.AlertRule]
+	alertsTable       *monsterax.CompositeKeyTable[*corepb.TriggeredAlert, corepb.TriggeredAlert]
+	outboxTable       *monsterax.CompositeKeyTable[*corepb.OutboxRecord, corepb.OutboxRecord]
+	outboxSequence    *monsterax.UniqueUint64Index
+	spendingTable     *monsterax.CompositeKeyTable[*corepb.SpendingAggregate, corepb.SpendingAggregate]
+	rollupsTable      *monsterax.CompositeKeyTable[*corepb.TransactionRollup, corepb.TransactionRollup]
+	shardStatsTable   *monsterax.SimpleKeyTable[*corepb.ShardStats, corepb.ShardStats]
+	escrowsTable      *monsterax.CompositeKeyTable[*corepb.Escrow, corepb.Escrow]
+
+	// value-dated topups which are settled but not yet available, sorted by value date within the shard
+	pendingAvailabilityIndex *monsterax.SortedIndex
+	// the same topups sorted by value date within each account, so that updates of an account scan only its topups
+	accountPendingAvailabilityIndex *monsterax.SortedIndex
+	// held escrows with auto-release deadline, sorted by deadline within the shard
+	escrowDeadlineIndex *monsterax.SortedIndex
+
+	// raft stats of the replica running this core, nil if the core is not replicated (standalone, tests)
+	raftStats func() map[string]string
 }
 
 var _ AccountsCoreApi = &AccountsCore{}
 
 func NewAccountsCore(badgerStore *monstera.BadgerStore, shardLowerBound []byte, shardUpperBound []byte) *AccountsCore {
 	return &AccountsCore{
-		badgerStore:       badgerStore,
-		accountsTable:     monsterax.NewSimpleKeyTable[*corepb.Account, corepb.Account](accountsTableId, shardLowerBound, shardUpperBound),
-		transactionsTable: monsterax.NewCompositeKeyTable[*corepb.Transaction, corepb.Transaction](transactionsTableId, shardLowerBound, shardUpperBound),
+		badgerStore:                     badgerStore,
+		shardLowerBound:                 shardLowerBound,
+		shardUpperBound:                 shardUpperBound,
+		accountsTable:                   monsterax.NewSimpleKeyTable[*corepb.Account, corepb.Account](accountsTableId, shardLowerBound, shardUpperBound),
+		transactionsTable:               monsterax.NewCompositeKeyTable[*corepb.Transaction, corepb.Transaction](transactionsTableId, shardLowerBound, shardUpperBound),
+		disputesTable:                   monsterax.NewCompositeKeyTable[*corepb.Dispute, corepb.Dispute](disputesTableId, shardLowerBound, shardUpperBound),
+		alertRulesTable:                 monsterax.NewCompositeKeyTable[*corepb.AlertRule, corepb.AlertRule](alertRulesTableId, shardLowerBound, shardUpperBound),
+		alertsTable:                     monsterax.NewCompositeKeyTable[*corepb.TriggeredAlert, corepb.TriggeredAlert](alertsTableId, shardLowerBound, shardUpperBound),
+		outboxTable:                     monsterax.NewCompositeKeyTable[*corepb.OutboxRecord, corepb.OutboxRecord](outboxTableId, shardLowerBound, shardUpperBound),
+		outboxSequence:                  monsterax.NewUniqueUint64Index(outboxSequenceId, shardLowerBound, shardUpperBound),
+		spendingTable:                   monsterax.NewCompositeKeyTable[*corepb.SpendingAggregate, corepb.SpendingAggregate](spendingTableId, shardLowerBound, shardUpperBound),
+		rollupsTable:                    monsterax.NewCompositeKeyTable[*corepb.TransactionRollup, corepb.TransactionRollup](rollupsTableId, shardLowerBound, shardUpperBound),
+		shardStatsTable:                 monsterax.NewSimpleKeyTable[*corepb.ShardStats, corepb.ShardStats](shardStatsTableId, shardLowerBound, shardUpperBound),
+		escrowsTable:                    monsterax.NewCompositeKeyTable[*corepb.Escrow, corepb.Escrow](escrowsTableId, shardLowerBound, shardUpperBound),
+		pendingAvailabilityIndex:        monsterax.NewSortedIndex(pendingAvailabilityIndexId, shardLowerBound, shardUpperBound),
+		accountPendingAvailabilityIndex: monsterax.NewSortedIndex(accountPendingAvailabilityIndexId, shardLowerBound, shardUpperBound),
+		escrowDeadlineIndex:             monsterax.NewSortedIndex(escrowDeadlineIndexId, shardLowerBound, shardUpperBound),
 	}
 }
 
+// WithRaftStats sets a source of raft stats of the replica running this core, which are used to tell how fresh
+// follower reads are.
+func (c *AccountsCore) WithRaftStats(raftStats func() map[string]string) *AccountsCore {
+	c.raftStats = raftStats
+	return c
+}
+
 func (c *AccountsCore) ranges() []monstera.KeyRange {
 	return []monstera.KeyRange{
 		c.accountsTable.GetTableKeyRange(),
 		c.transactionsTable.GetTableKeyRange(),
+		c.disputesTable.GetTableKeyRange(),
+		c.alertRulesTable.GetTableKeyRange(),
+		c.alertsTable.GetTableKeyRange(),
+		c.outboxTable.GetTableKeyRange(),
+		c.outboxSequence.GetTableKeyRange(),
+		c.spendingTable.GetTableKeyRange(),
+		c.rollupsTable.GetTableKeyRange(),
+		c.shardStatsTable.GetTableKeyRange(),
+		c.escrowsTable.GetTableKeyRange(),
+		c.pendingAvailabilityIndex.GetTableKeyRange(),
+		c.accountPendingAvailabilityIndex.GetTableKeyRange(),
+		c.escrowDeadlineIndex.GetTableKeyRange(),
 	}
 }
 
@@ -58,6 +117,193 @@ func (c *AccountsCore) ListTransactions(request *corepb.ListTransactionsRequest)
 	}, nil
 }
 
+// ListTransactionsFromFollower is served by any replica. Along with transactions it returns account update time and
+// the time up to which the replica applied the shard log, so that the caller can decide if the replica is fresh enough.
+func (c *AccountsCore) ListTransactionsFromFollower(request *corepb.ListTransactionsFromFollowerRequest) (*corepb.ListTransactionsFromFollowerResponse, error) {
+	txn := c.badgerStore.View()
+	defer txn.Discard()
+
+	transactions, err := c.listTransactions(txn, request.AccountId)
+	panicIfNotNil(err)
+
+	var accountUpdatedAt int64
+	account, err := c.getAccount(txn, request.AccountId)
+	if err != nil {
+		if !errors.Is(err, monstera.ErrNotFound) {
+			panic(err)
+		}
+	} else {
+		accountUpdatedAt = account.UpdatedAt
+	}
+
+	return &corepb.ListTransactionsFromFollowerResponse{
+		Transactions:     transactions,
+		AccountUpdatedAt: accountUpdatedAt,
+		AppliedAt:        c.appliedAt(request.Now),
+	}, nil
+}
+
+func (c *AccountsCore) GetSpendingSummary(request *corepb.GetSpendingSummaryRequest) (*corepb.GetSpendingSummaryResponse, error) {
+	txn := c.badgerStore.View()
+	defer txn.Discard()
+
+	aggregates, err := c.listSpendingAggregates(txn, request.AccountId, request.FromMonth, request.ToMonth)
+	panicIfNotNil(err)
+
+	return &corepb.GetSpendingSummaryResponse{
+		Aggregates: aggregates,
+	}, nil
+}
+
+func (c *AccountsCore) ListArchivableTransactions(request *corepb.ListArchivableTransactionsRequest) (*corepb.ListArchivableTransactionsResponse, error) {
+	txn := c.badgerStore.View()
+	defer txn.Discard()
+
+	if request.Limit <= 0 {
+		return nil, monsterax.NewError(monsterax.InvalidArgument, "invalid limit")
+	}
+
+	// the same cutoff is used by ArchiveTransactions, it must not be in the future
+	if request.Before > request.Now {
+		return nil, monsterax.NewError(monsterax.InvalidArgument, "cutoff is in the future")
+	}
+
+	transactions, err := c.listArchivableTransactions(txn, request.Before, request.After, int(request.Limit))
+	panicIfNotNil(err)
+
+	return &corepb.ListArchivableTransactionsResponse{
+		Transactions: transactions,
+	}, nil
+}
+
+func (c *AccountsCore) ListTransactionRollups(request *corepb.ListTransactionRollupsRequest) (*corepb.ListTransactionRollupsResponse, error) {
+	txn := c.badgerStore.View()
+	defer txn.Discard()
+
+	rollups, err := c.listTransactionRollups(txn, request.AccountId)
+	panicIfNotNil(err)
+
+	return &corepb.ListTransactionRollupsResponse{
+		Rollups: rollups,
+	}, nil
+}
+
+// ArchiveTransactions deletes settled and cancelled transactions last updated before the cutoff and adds them to
+// monthly rollups of their accounts. Balances are not changed. Archived transactions are the same ones which were
+// listed by ListArchivableTransactions with the same cutoff, since final transactions never change and new ones
+// are updated after the cutoff. One outbox record is written per account with the archived transactions of the batch.
+func (c *AccountsCore) ArchiveTransactions(request *corepb.ArchiveTransactionsRequest) (*corepb.ArchiveTransactionsResponse, error) {
+	txn := c.badgerStore.Update()
+	defer txn.Discard()
+
+	if request.Limit <= 0 {
+		return nil, monsterax.NewError(monsterax.InvalidArgument, "invalid limit")
+	}
+
+	// value-dated topups available before the cutoff are released below, so archived transactions are not referenced
+	// by pending availability index
+	if request.Before > request.Now {
+		return nil, monsterax.NewError(monsterax.InvalidArgument, "cutoff is in the future")
+	}
+
+	transactions, err := c.listArchivableTransactions(txn, request.Before, nil, int(request.Limit))
+	panicIfNotNil(err)
+
+	first := 0
+	for i, transaction := range transactions {
+		// transactions are sorted by account id
+		if i == 0 || transactions[i-1].Id.AccountId != transaction.Id.AccountId {
+			first = i
+
+			_, err = c.releaseAccount(txn, transaction.Id.AccountId, request.Now)
+			panicIfNotNil(err)
+		}
+
+		err = c.rollupTransaction(txn, transaction, request.Now)
+		panicIfNotNil(err)
+
+		err = c.deleteTransaction(txn, transaction.Id)
+		panicIfNotNil(err)
+
+		// last archived transaction of the account
+		if i == len(transactions)-1 || transactions[i+1].Id.AccountId != transaction.Id.AccountId {
+			account, err := c.getAccount(txn, transaction.Id.AccountId)
+			panicIfNotNil(err)
+
+			err = c.appendOutbox(txn, &corepb.OutboxRecord{
+				Type:                 corepb.OutboxEventType_OUTBOX_EVENT_TYPE_TRANSACTIONS_ARCHIVED,
+				CreatedAt:            request.Now,
+				Account:              account,
+				ArchivedTransactions: transactions[first : i+1],
+			})
+			panicIfNotNil(err)
+		}
+	}
+
+	err = txn.Commit()
+	panicIfNotNil(err)
+
+	return &corepb.ArchiveTransactionsResponse{
+		ArchivedTransactions: int32(len(transactions)),
+	}, nil
+}
+
+func (c *AccountsCore) GetShardStats(request *corepb.GetShardStatsRequest) (*corepb.GetShardStatsResponse, error) {
+	txn := c.badgerStore.View()
+	defer txn.Discard()
+
+	stats, err := c.getShardStats(txn)
+	panicIfNotNil(err)
+
+	return &corepb.GetShardStatsResponse{
+		Stats: stats,
+	}, nil
+}
+
+// RebuildShardStats recomputes running aggregates of the shard from all accounts and transactions. It is only needed
+// once for shards with data created before aggregates were introduced.
+func (c *AccountsCore) RebuildShardStats(request *corepb.RebuildShardStatsRequest) (*corepb.RebuildShardStatsResponse, error) {
+	txn := c.badgerStore.Update()
+	defer txn.Discard()
+
+	stats := &corepb.ShardStats{
+		UpdatedAt: request.Now,
+	}
+
+	err := c.accountsTable.ListInRange(txn, c.shardLowerBound, c.shardUpperBound, func(account *corepb.Account) (bool, error) {
+		stats.AccountsCount++
+		stats.TotalAvailableBalance += account.AvailableBalance
+		stats.TotalSettledBalance += account.SettledBalance
+		stats.TotalPendingAvailabilityBalance += account.PendingAvailabilityBalance
+		return true, nil
+	})
+	panicIfNotNil(err)
+
+	err = monsterax.ListRange(txn, transactionsTableId, []byte{}, c.shardLowerBound, c.shardUpperBound, func(key []byte, value []byte) (bool, error) {
+		transaction := &corepb.Transaction{}
+		err := proto.Unmarshal(value, transaction)
+		if err != nil {
+			return false, err
+		}
+
+		if transaction.Status == corepb.TransactionStatus_TRANSACTION_STATUS_PENDING {
+			stats.PendingTransactionsCount++
+		}
+		return true, nil
+	})
+	panicIfNotNil(err)
+
+	err = c.shardStatsTable.Set(txn, c.shardLowerBound, stats)
+	panicIfNotNil(err)
+
+	err = txn.Commit()
+	panicIfNotNil(err)
+
+	return &corepb.RebuildShardStatsResponse{
+		Stats: stats,
+	}, nil
+}
+
 func (c *AccountsCore) GetTransaction(request *corepb.GetTransactionRequest) (*corepb.GetTransactionResponse, error) {
 	txn := c.badgerStore.View()
 	defer txn.Discard()
@@ -95,22 +341,31 @@ func (c *AccountsCore) CreateTransaction(request *corepb.CreateTransactionReques
 		}
 	}
 
+	accountBefore := proto.Clone(account).(*corepb.Account)
+
+	err = c.touchAccount(txn, account, request.Now)
+	panicIfNotNil(err)
+
 	transaction := &corepb.Transaction{
 		Id:          request.TransactionId,
 		Amount:      request.Amount,
 		Description: request.Description,
+		Category:    request.Category,
 		CreatedAt:   request.Now,
 		UpdatedAt:   request.Now,
 	}
 
 	// if money is added to the account (topup)
 	if transaction.Amount >= 0 {
+		// value date only makes sense for topups
+		transaction.AvailableAt = request.AvailableAt
+
 		if request.Settled {
 			transaction.Status = corepb.TransactionStatus_TRANSACTION_STATUS_SETTLED
 
 			// settled topups increase the balance
-			account.AvailableBalance += transaction.Amount
-			account.SettledBalance += transaction.Amount
+			err = c.settleTopup(txn, account, transaction, request.Now)
+			panicIfNotNil(err)
 		} else {
 			transaction.Status = corepb.TransactionStatus_TRANSACTION_STATUS_PENDING
 			// pending topups do not increase the balance
@@ -137,12 +392,26 @@ func (c *AccountsCore) CreateTransaction(request *corepb.CreateTransactionReques
 
 	account.UpdatedAt = request.Now
 
+	err = c.balanceChanged(txn, accountBefore, account, transaction.Id, request.Now)
+	panicIfNotNil(err)
+
 	err = c.updateAccount(txn, account)
 	panicIfNotNil(err)
 
 	err = c.createTransaction(txn, transaction)
 	panicIfNotNil(err)
 
+	err = c.transactionChanged(txn, corepb.TransactionStatus_TRANSACTION_STATUS_INVALID, transaction)
+	panicIfNotNil(err)
+
+	err = c.appendOutbox(txn, &corepb.OutboxRecord{
+		Type:        corepb.OutboxEventType_OUTBOX_EVENT_TYPE_TRANSACTION_CREATED,
+		CreatedAt:   request.Now,
+		Account:     account,
+		Transaction: transaction,
+	})
+	panicIfNotNil(err)
+
 	err = txn.Commit()
 	panicIfNotNil(err)
 
@@ -167,6 +436,11 @@ func (c *AccountsCore) CancelTransaction(request *corepb.CancelTransactionReques
 		}
 	}
 
+	accountBefore := proto.Clone(account).(*corepb.Account)
+
+	err = c.touchAccount(txn, account, request.Now)
+	panicIfNotNil(err)
+
 	transaction, err := c.getTransaction(txn, request.TransactionId)
 	if err != nil {
 		if errors.Is(err, monstera.ErrNotFound) {
@@ -187,6 +461,7 @@ func (c *AccountsCore) CancelTransaction(request *corepb.CancelTransactionReques
 			map[string]string{"transaction_id": EncodeTransactionId(request.TransactionId)})
 	}
 
+	statusBefore := transaction.Status
 	transaction.Status = corepb.TransactionStatus_TRANSACTION_STATUS_CANCELLED
 	transaction.UpdatedAt = request.Now
 
@@ -201,9 +476,23 @@ func (c *AccountsCore) CancelTransaction(request *corepb.CancelTransactionReques
 	err = c.updateTransaction(txn, transaction)
 	panicIfNotNil(err)
 
+	err = c.transactionChanged(txn, statusBefore, transaction)
+	panicIfNotNil(err)
+
+	err = c.balanceChanged(txn, accountBefore, account, transaction.Id, request.Now)
+	panicIfNotNil(err)
+
 	err = c.updateAccount(txn, account)
 	panicIfNotNil(err)
 
+	err = c.appendOutbox(txn, &corepb.OutboxRecord{
+		Type:        corepb.OutboxEventType_OUTBOX_EVENT_TYPE_TRANSACTION_CANCELLED,
+		CreatedAt:   request.Now,
+		Account:     account,
+		Transaction: transaction,
+	})
+	panicIfNotNil(err)
+
 	err = txn.Commit()
 	panicIfNotNil(err)
 
@@ -228,6 +517,11 @@ func (c *AccountsCore) SettleTransaction(request *corepb.SettleTransactionReques
 		}
 	}
 
+	accountBefore := proto.Clone(account).(*corepb.Account)
+
+	err = c.touchAccount(txn, account, request.Now)
+	panicIfNotNil(err)
+
 	transaction, err := c.getTransaction(txn, request.TransactionId)
 	if err != nil {
 		if errors.Is(err, monstera.ErrNotFound) {
@@ -248,14 +542,15 @@ func (c *AccountsCore) SettleTransaction(request *corepb.SettleTransactionReques
 			map[string]string{"transaction_id": EncodeTransactionId(request.TransactionId)})
 	}
 
+	statusBefore := transaction.Status
 	transaction.Status = corepb.TransactionStatus_TRANSACTION_STATUS_SETTLED
 	transaction.UpdatedAt = request.Now
 
 	// pending topups do not change the balance, need to update both balances
 	// pending purchases only change the available balance, need to update settled balance
 	if transaction.Amount >= 0 {
-		account.AvailableBalance += transaction.Amount
-		account.SettledBalance += transaction.Amount
+		err = c.settleTopup(txn, account, transaction, request.Now)
+		panicIfNotNil(err)
 	} else {
 		account.SettledBalance += transaction.Amount
 	}
@@ -265,9 +560,23 @@ func (c *AccountsCore) SettleTransaction(request *corepb.SettleTransactionReques
 	err = c.updateTransaction(txn, transaction)
 	panicIfNotNil(err)
 
+	err = c.transactionChanged(txn, statusBefore, transaction)
+	panicIfNotNil(err)
+
+	err = c.balanceChanged(txn, accountBefore, account, transaction.Id, request.Now)
+	panicIfNotNil(err)
+
 	err = c.updateAccount(txn, account)
 	panicIfNotNil(err)
 
+	err = c.appendOutbox(txn, &corepb.OutboxRecord{
+		Type:        corepb.OutboxEventType_OUTBOX_EVENT_TYPE_TRANSACTION_SETTLED,
+		CreatedAt:   request.Now,
+		Account:     account,
+		Transaction: transaction,
+	})
+	panicIfNotNil(err)
+
 	err = txn.Commit()
 	panicIfNotNil(err)
 
@@ -292,11 +601,43 @@ func (c *AccountsCore) GetAccount(request *corepb.GetAccountRequest) (*corepb.Ge
 		}
 	}
 
+	// value-dated topups which became available are released in the returned copy only, storage is not changed
+	_, err = c.releaseAvailableFunds(txn, account, request.Now)
+	panicIfNotNil(err)
+
 	return &corepb.GetAccountResponse{
 		Account: account,
 	}, nil
 }
 
+// GetAccountFromFollower is served by any replica. Along with the account it returns the time up to which the replica
+// applied the shard log, so that the caller can decide if the replica is fresh enough.
+func (c *AccountsCore) GetAccountFromFollower(request *corepb.GetAccountFromFollowerRequest) (*corepb.GetAccountFromFollowerResponse, error) {
+	txn := c.badgerStore.View()
+	defer txn.Discard()
+
+	account, err := c.getAccount(txn, request.AccountId)
+	if err != nil {
+		if errors.Is(err, monstera.ErrNotFound) {
+			return nil, monsterax.NewErrorWithContext(
+				monsterax.NotFound,
+				"account not found",
+				map[string]string{"account_id": EncodeAccountId(request.AccountId)})
+		} else {
+			panic(err)
+		}
+	}
+
+	// value-dated topups which became available are released in the returned copy only, storage is not changed
+	_, err = c.releaseAvailableFunds(txn, account, request.Now)
+	panicIfNotNil(err)
+
+	return &corepb.GetAccountFromFollowerResponse{
+		Account:   account,
+		AppliedAt: c.appliedAt(request.Now),
+	}, nil
+}
+
 func (c *AccountsCore) CreateAccount(request *corepb.CreateAccountRequest) (*corepb.CreateAccountResponse, error) {
 	txn := c.badgerStore.Update()
 	defer txn.Discard()
@@ -307,11 +648,24 @@ func (c *AccountsCore) CreateAccount(request *corepb.CreateAccountRequest) (*cor
 		SettledBalance:   0,
 		CreatedAt:        request.Now,
 		UpdatedAt:        request.Now,
+		CustomerId:       request.CustomerId,
 	}
 
 	err := c.createAccount(txn, account)
 	panicIfNotNil(err)
 
+	err = c.updateShardStats(txn, request.Now, func(stats *corepb.ShardStats) {
+		stats.AccountsCount++
+	})
+	panicIfNotNil(err)
+
+	err = c.appendOutbox(txn, &corepb.OutboxRecord{
+		Type:      corepb.OutboxEventType_OUTBOX_EVENT_TYPE_ACCOUNT_CREATED,
+		CreatedAt: request.Now,
+		Account:   account,
+	})
+	panicIfNotNil(err)
+
 	err = txn.Commit()
 	panicIfNotNil(err)
 
@@ -320,63 +674,1633 @@ func (c *AccountsCore) CreateAccount(request *corepb.CreateAccountRequest) (*cor
 	}, nil
 }
 
-func (c *AccountsCore) getAccount(txn *monstera.Txn, accountId uint64) (*corepb.Account, error) {
-	return c.accountsTable.Get(txn, accountsTablePK(accountId))
-}
+func (c *AccountsCore) SweepPendingAvailability(request *corepb.SweepPendingAvailabilityRequest) (*corepb.SweepPendingAvailabilityResponse, error) {
+	txn := c.badgerStore.Update()
+	defer txn.Discard()
 
-func (c *AccountsCore) updateAccount(txn *monstera.Txn, account *corepb.Account) error {
-	return c.accountsTable.Set(txn, accountsTablePK(account.Id), account)
-}
+	if request.Limit <= 0 {
+		return nil, monsterax.NewError(monsterax.InvalidArgument, "invalid limit")
+	}
 
-func (c *AccountsCore) deleteAccount(txn *monstera.Txn, accountId uint64) error {
-	return c.accountsTable.Delete(txn, accountsTablePK(accountId))
-}
+	// find accounts with value-dated topups which became available, the index is sorted by value date so only due
+	// topups are scanned
+	accountIds := make([]uint64, 0)
+	err := c.listAvailablePendingTopups(txn, request.Now, func(transactionId *corepb.TransactionId) bool {
+		if !slices.Contains(accountIds, transactionId.AccountId) {
+			accountIds = append(accountIds, transactionId.AccountId)
+		}
+		return len(accountIds) < int(request.Limit)
+	})
+	panicIfNotNil(err)
 
-func (c *AccountsCore) createAccount(txn *monstera.Txn, account *corepb.Account) error {
-	return c.accountsTable.Set(txn, accountsTablePK(account.Id), account)
-}
+	releasedTransactions := 0
+	for _, accountId := range accountIds {
+		released, err := c.releaseAccount(txn, accountId, request.Now)
+		panicIfNotNil(err)
 
-func (c *AccountsCore) getTransaction(txn *monstera.Txn, transactionId *corepb.TransactionId) (*corepb.Transaction, error) {
-	return c.transactionsTable.Get(txn, transactionsTablePK(transactionId.AccountId), transactionsTableSK(transactionId))
-}
+		releasedTransactions += released
+	}
 
-func (c *AccountsCore) createTransaction(txn *monstera.Txn, transaction *corepb.Transaction) error {
-	return c.transactionsTable.Set(txn, transactionsTablePK(transaction.Id.AccountId), transactionsTableSK(transaction.Id), transaction)
+	err = txn.Commit()
+	panicIfNotNil(err)
+
+	return &corepb.SweepPendingAvailabilityResponse{
+		ReleasedTransactions: int32(releasedTransactions),
+	}, nil
 }
 
-func (c *AccountsCore) updateTransaction(txn *monstera.Txn, transaction *corepb.Transaction) error {
-	return c.transactionsTable.Set(txn, transactionsTablePK(transaction.Id.AccountId), transactionsTableSK(transaction.Id), transaction)
+func (c *AccountsCore) ListAlertRules(request *corepb.ListAlertRulesRequest) (*corepb.ListAlertRulesResponse, error) {
+	txn := c.badgerStore.View()
+	defer txn.Discard()
+
+	alertRules, err := c.listAlertRules(txn, request.AccountId)
+	panicIfNotNil(err)
+
+	return &corepb.ListAlertRulesResponse{
+		AlertRules: alertRules,
+	}, nil
 }
 
-func (c *AccountsCore) listTransactions(txn *monstera.Txn, accountId uint64) ([]*corepb.Transaction, error) {
-	result := make([]*corepb.Transaction, 0)
+func (c *AccountsCore) CreateAlertRule(request *corepb.CreateAlertRuleRequest) (*corepb.CreateAlertRuleResponse, error) {
+	txn := c.badgerStore.Update()
+	defer txn.Discard()
 
-	err := c.transactionsTable.List(txn, transactionsTablePK(accountId), func(transaction *corepb.Transaction) (bool, error) {
-		result = append(result, transaction)
-		return true, nil
-	})
+	_, err := c.getAccount(txn, request.AlertRuleId.AccountId)
 	if err != nil {
-		return nil, err
+		if errors.Is(err, monstera.ErrNotFound) {
+			return nil, monsterax.NewErrorWithContext(
+				monsterax.NotFound,
+				"account not found",
+				map[string]string{"account_id": EncodeAccountId(request.AlertRuleId.AccountId)})
+		} else {
+			panic(err)
+		}
 	}
 
-	return result, nil
-}
+	if request.Direction != corepb.AlertDirection_ALERT_DIRECTION_BELOW && request.Direction != corepb.AlertDirection_ALERT_DIRECTION_ABOVE {
+		return nil, monsterax.NewError(monsterax.InvalidArgument, "invalid alert direction")
+	}
 
-// 1. shard key (by account id)
-// 2. account id
-func accountsTablePK(accountId uint64) []byte {
-	return monstera.ConcatBytes(shardByAccount(accountId), accountId)
-}
+	alertRule := &corepb.AlertRule{
+		Id:        request.AlertRuleId,
+		Threshold: request.Threshold,
+		Direction: request.Direction,
+		CreatedAt: request.Now,
+	}
 
-// 1. shard key (by account id)
-// 2. account id
-func transactionsTablePK(accountId uint64) []byte {
-	return monstera.ConcatBytes(shardByAccount(accountId), accountId)
+	err = c.createAlertRule(txn, alertRule)
+	panicIfNotNil(err)
+
+	err = c.appendOutbox(txn, &corepb.OutboxRecord{
+		Type:      corepb.OutboxEventType_OUTBOX_EVENT_TYPE_ALERT_RULE_CREATED,
+		CreatedAt: request.Now,
+		AlertRule: alertRule,
+	})
+	panicIfNotNil(err)
+
+	err = txn.Commit()
+	panicIfNotNil(err)
+
+	return &corepb.CreateAlertRuleResponse{
+		AlertRule: alertRule,
+	}, nil
 }
 
-// 1. transaction id
-func transactionsTableSK(t *corepb.TransactionId) []byte {
-	return monstera.ConcatBytes(t.GetTransactionId())
+func (c *AccountsCore) DeleteAlertRule(request *corepb.DeleteAlertRuleRequest) (*corepb.DeleteAlertRuleResponse, error) {
+	txn := c.badgerStore.Update()
+	defer txn.Discard()
+
+	_, err := c.getAlertRule(txn, request.AlertRuleId)
+	if err != nil {
+		if errors.Is(err, monstera.ErrNotFound) {
+			return nil, monsterax.NewErrorWithContext(
+				monsterax.NotFound,
+				"alert rule not found",
+				map[string]string{"alert_rule_id": EncodeAlertRuleId(request.AlertRuleId)})
+		} else {
+			panic(err)
+		}
+	}
+
+	err = c.deleteAlertRule(txn, request.AlertRuleId)
+	panicIfNotNil(err)
+
+	err = c.appendOutbox(txn, &corepb.OutboxRecord{
+		Type:        corepb.OutboxEventType_OUTBOX_EVENT_TYPE_ALERT_RULE_DELETED,
+		CreatedAt:   request.Now,
+		AlertRuleId: request.AlertRuleId,
+	})
+	panicIfNotNil(err)
+
+	err = txn.Commit()
+	panicIfNotNil(err)
+
+	return &corepb.DeleteAlertRuleResponse{}, nil
+}
+
+func (c *AccountsCore) ListTriggeredAlerts(request *corepb.ListTriggeredAlertsRequest) (*corepb.ListTriggeredAlertsResponse, error) {
+	txn := c.badgerStore.View()
+	defer txn.Discard()
+
+	alerts, err := c.listTriggeredAlerts(txn, request.AccountId, request.UnacknowledgedOnly)
+	panicIfNotNil(err)
+
+	return &corepb.ListTriggeredAlertsResponse{
+		TriggeredAlerts: alerts,
+	}, nil
+}
+
+func (c *AccountsCore) AckAlert(request *corepb.AckAlertRequest) (*corepb.AckAlertResponse, error) {
+	txn := c.badgerStore.Update()
+	defer txn.Discard()
+
+	alert, err := c.getTriggeredAlert(txn, request.TriggeredAlertId)
+	if err != nil {
+		if errors.Is(err, monstera.ErrNotFound) {
+			return nil, monsterax.NewErrorWithContext(
+				monsterax.NotFound,
+				"triggered alert not found",
+				map[string]string{"triggered_alert_id": EncodeTriggeredAlertId(request.TriggeredAlertId)})
+		} else {
+			panic(err)
+		}
+	}
+
+	// repeated acks are no-op
+	if !alert.Acknowledged {
+		alert.Acknowledged = true
+		alert.AcknowledgedAt = request.Now
+
+		err = c.updateTriggeredAlert(txn, alert)
+		panicIfNotNil(err)
+
+		err = c.appendOutbox(txn, &corepb.OutboxRecord{
+			Type:           corepb.OutboxEventType_OUTBOX_EVENT_TYPE_ALERT_ACKNOWLEDGED,
+			CreatedAt:      request.Now,
+			TriggeredAlert: alert,
+		})
+		panicIfNotNil(err)
+
+		err = txn.Commit()
+		panicIfNotNil(err)
+	}
+
+	return &corepb.AckAlertResponse{
+		TriggeredAlert: alert,
+	}, nil
+}
+
+func (c *AccountsCore) ReadOutbox(request *corepb.ReadOutboxRequest) (*corepb.ReadOutboxResponse, error) {
+	txn := c.badgerStore.View()
+	defer txn.Discard()
+
+	if request.Limit <= 0 {
+		return nil, monsterax.NewError(monsterax.InvalidArgument, "invalid limit")
+	}
+
+	// acknowledged records are deleted, so the outbox always starts with the oldest unacknowledged record
+	records := make([]*corepb.OutboxRecord, 0)
+	err := c.outboxTable.List(txn, outboxTablePK(c.shardLowerBound), func(record *corepb.OutboxRecord) (bool, error) {
+		records = append(records, record)
+		return len(records) < int(request.Limit), nil
+	})
+	panicIfNotNil(err)
+
+	return &corepb.ReadOutboxResponse{
+		Records: records,
+	}, nil
+}
+
+func (c *AccountsCore) AckOutbox(request *corepb.AckOutboxRequest) (*corepb.AckOutboxResponse, error) {
+	txn := c.badgerStore.Update()
+	defer txn.Discard()
+
+	ids := make([]uint64, 0)
+	err := c.outboxTable.List(txn, outboxTablePK(c.shardLowerBound), func(record *corepb.OutboxRecord) (bool, error) {
+		if record.Id > request.UpToId {
+			return false, nil
+		}
+		ids = append(ids, record.Id)
+		return true, nil
+	})
+	panicIfNotNil(err)
+
+	for _, id := range ids {
+		err = c.outboxTable.Delete(txn, outboxTablePK(c.shardLowerBound), outboxTableSK(id))
+		panicIfNotNil(err)
+	}
+
+	err = txn.Commit()
+	panicIfNotNil(err)
+
+	return &corepb.AckOutboxResponse{}, nil
+}
+
+func (c *AccountsCore) GetDispute(request *corepb.GetDisputeRequest) (*corepb.GetDisputeResponse, error) {
+	txn := c.badgerStore.View()
+	defer txn.Discard()
+
+	dispute, err := c.getDispute(txn, request.DisputeId)
+	if err != nil {
+		if errors.Is(err, monstera.ErrNotFound) {
+			return nil, monsterax.NewErrorWithContext(
+				monsterax.NotFound,
+				"dispute not found",
+				map[string]string{"dispute_id": EncodeDisputeId(request.DisputeId)})
+		} else {
+			panic(err)
+		}
+	}
+
+	return &corepb.GetDisputeResponse{
+		Dispute: dispute,
+	}, nil
+}
+
+func (c *AccountsCore) ListDisputes(request *corepb.ListDisputesRequest) (*corepb.ListDisputesResponse, error) {
+	txn := c.badgerStore.View()
+	defer txn.Discard()
+
+	disputes, err := c.listDisputes(txn, request.AccountId)
+	panicIfNotNil(err)
+
+	return &corepb.ListDisputesResponse{
+		Disputes: disputes,
+	}, nil
+}
+
+func (c *AccountsCore) OpenDispute(request *corepb.OpenDisputeRequest) (*corepb.OpenDisputeResponse, error) {
+	txn := c.badgerStore.Update()
+	defer txn.Discard()
+
+	// disputed transaction must belong to the same account (and the same shard)
+	if request.TransactionId.AccountId != request.DisputeId.AccountId {
+		return nil, monsterax.NewErrorWithContext(
+			monsterax.InvalidArgument,
+			"transaction does not belong to the account",
+			map[string]string{"transaction_id": EncodeTransactionId(request.TransactionId)})
+	}
+
+	account, err := c.getAccount(txn, request.DisputeId.AccountId)
+	if err != nil {
+		if errors.Is(err, monstera.ErrNotFound) {
+			return nil, monsterax.NewErrorWithContext(
+				monsterax.NotFound,
+				"account not found",
+				map[string]string{"account_id": EncodeAccountId(request.DisputeId.AccountId)})
+		} else {
+			panic(err)
+		}
+	}
+
+	accountBefore := proto.Clone(account).(*corepb.Account)
+
+	err = c.touchAccount(txn, account, request.Now)
+	panicIfNotNil(err)
+
+	transaction, err := c.getTransaction(txn, request.TransactionId)
+	if err != nil {
+		if errors.Is(err, monstera.ErrNotFound) {
+			return nil, monsterax.NewErrorWithContext(
+				monsterax.NotFound,
+				"transaction not found",
+				map[string]string{"transaction_id": EncodeTransactionId(request.TransactionId)})
+		} else {
+			panic(err)
+		}
+	}
+
+	// only settled purchases can be disputed
+	if transaction.Status != corepb.TransactionStatus_TRANSACTION_STATUS_SETTLED || transaction.Amount >= 0 {
+		return nil, monsterax.NewErrorWithContext(
+			monsterax.InvalidArgument,
+			"transaction is not a settled purchase",
+			map[string]string{"transaction_id": EncodeTransactionId(request.TransactionId)})
+	}
+
+	// a transaction can be disputed only once
+	if transaction.DisputeId != nil {
+		return nil, monsterax.NewErrorWithContext(
+			monsterax.AlreadyExists,
+			"transaction is already disputed",
+			map[string]string{"dispute_id": EncodeDisputeId(transaction.DisputeId)})
+	}
+
+	// full amount of the purchase is disputed by default, partial disputes are allowed
+	amount := request.Amount
+	if amount == 0 {
+		amount = -transaction.Amount
+	}
+	if amount < 0 || amount > -transaction.Amount {
+		return nil, monsterax.NewErrorWithContext(
+			monsterax.InvalidArgument,
+			"invalid dispute amount",
+			map[string]string{"transaction_id": EncodeTransactionId(request.TransactionId)})
+	}
+
+	// provisional credit is a settled topup, it is available to the account holder right away
+	provisionalTransaction := &corepb.Transaction{
+		Id: &corepb.TransactionId{
+			AccountId:     request.DisputeId.AccountId,
+			TransactionId: request.ProvisionalTransactionId,
+		},
+		Amount:      amount,
+		Description: "Provisional credit: " + transaction.Description,
+		Category:    transaction.Category,
+		Status:      corepb.TransactionStatus_TRANSACTION_STATUS_SETTLED,
+		CreatedAt:   request.Now,
+		UpdatedAt:   request.Now,
+		DisputeId:   request.DisputeId,
+	}
+
+	account.AvailableBalance += provisionalTransaction.Amount
+	account.SettledBalance += provisionalTransaction.Amount
+	account.UpdatedAt = request.Now
+
+	dispute := &corepb.Dispute{
+		Id:                       request.DisputeId,
+		TransactionId:            request.TransactionId,
+		Amount:                   amount,
+		Description:              request.Description,
+		Status:                   corepb.DisputeStatus_DISPUTE_STATUS_OPENED,
+		ProvisionalTransactionId: provisionalTransaction.Id,
+		CreatedAt:                request.Now,
+		UpdatedAt:                request.Now,
+	}
+
+	transaction.DisputeId = request.DisputeId
+	transaction.UpdatedAt = request.Now
+
+	err = c.updateTransaction(txn, transaction)
+	panicIfNotNil(err)
+
+	err = c.createTransaction(txn, provisionalTransaction)
+	panicIfNotNil(err)
+
+	err = c.transactionChanged(txn, corepb.TransactionStatus_TRANSACTION_STATUS_INVALID, provisionalTransaction)
+	panicIfNotNil(err)
+
+	err = c.balanceChanged(txn, accountBefore, account, provisionalTransaction.Id, request.Now)
+	panicIfNotNil(err)
+
+	err = c.updateAccount(txn, account)
+	panicIfNotNil(err)
+
+	err = c.createDispute(txn, dispute)
+	panicIfNotNil(err)
+
+	err = c.appendOutbox(txn, &corepb.OutboxRecord{
+		Type:        corepb.OutboxEventType_OUTBOX_EVENT_TYPE_DISPUTE_OPENED,
+		CreatedAt:   request.Now,
+		Account:     account,
+		Transaction: provisionalTransaction,
+		Dispute:     dispute,
+	})
+	panicIfNotNil(err)
+
+	err = txn.Commit()
+	panicIfNotNil(err)
+
+	return &corepb.OpenDisputeResponse{
+		Dispute: dispute,
+	}, nil
+}
+
+func (c *AccountsCore) ResolveDispute(request *corepb.ResolveDisputeRequest) (*corepb.ResolveDisputeResponse, error) {
+	txn := c.badgerStore.Update()
+	defer txn.Discard()
+
+	account, err := c.getAccount(txn, request.DisputeId.AccountId)
+	if err != nil {
+		if errors.Is(err, monstera.ErrNotFound) {
+			return nil, monsterax.NewErrorWithContext(
+				monsterax.NotFound,
+				"account not found",
+				map[string]string{"account_id": EncodeAccountId(request.DisputeId.AccountId)})
+		} else {
+			panic(err)
+		}
+	}
+
+	accountBefore := proto.Clone(account).(*corepb.Account)
+
+	err = c.touchAccount(txn, account, request.Now)
+	panicIfNotNil(err)
+
+	dispute, err := c.getDispute(txn, request.DisputeId)
+	if err != nil {
+		if errors.Is(err, monstera.ErrNotFound) {
+			return nil, monsterax.NewErrorWithContext(
+				monsterax.NotFound,
+				"dispute not found",
+				map[string]string{"dispute_id": EncodeDisputeId(request.DisputeId)})
+		} else {
+			panic(err)
+		}
+	}
+
+	// only opened disputes can be resolved
+	if dispute.Status != corepb.DisputeStatus_DISPUTE_STATUS_OPENED {
+		return nil, monsterax.NewErrorWithContext(
+			monsterax.InvalidArgument,
+			"dispute is not opened",
+			map[string]string{"dispute_id": EncodeDisputeId(request.DisputeId)})
+	}
+
+	dispute.UpdatedAt = request.Now
+
+	if request.Won {
+		// provisional credit becomes final, balance does not change
+		dispute.Status = corepb.DisputeStatus_DISPUTE_STATUS_WON
+	} else {
+		dispute.Status = corepb.DisputeStatus_DISPUTE_STATUS_LOST
+
+		disputedTransaction, err := c.getTransaction(txn, dispute.TransactionId)
+		if err != nil {
+			if errors.Is(err, monstera.ErrNotFound) {
+				return nil, monsterax.NewErrorWithContext(
+					monsterax.NotFound,
+					"disputed transaction not found",
+					map[string]string{"transaction_id": EncodeTransactionId(dispute.TransactionId)})
+			} else {
+				panic(err)
+			}
+		}
+
+		// provisional credit is reversed with a settled purchase, it is not checked against available balance
+		// and can make the balance negative
+		finalTransaction := &corepb.Transaction{
+			Id: &corepb.TransactionId{
+				AccountId:     request.DisputeId.AccountId,
+				TransactionId: request.FinalTransactionId,
+			},
+			Amount:      -dispute.Amount,
+			Description: "Provisional credit reversal",
+			Category:    disputedTransaction.Category,
+			Status:      corepb.TransactionStatus_TRANSACTION_STATUS_SETTLED,
+			CreatedAt:   request.Now,
+			UpdatedAt:   request.Now,
+			DisputeId:   request.DisputeId,
+		}
+
+		account.AvailableBalance += finalTransaction.Amount
+		account.SettledBalance += finalTransaction.Amount
+		account.UpdatedAt = request.Now
+
+		dispute.FinalTransactionId = finalTransaction.Id
+
+		err = c.createTransaction(txn, finalTransaction)
+		panicIfNotNil(err)
+
+		err = c.transactionChanged(txn, corepb.TransactionStatus_TRANSACTION_STATUS_INVALID, finalTransaction)
+		panicIfNotNil(err)
+	}
+
+	err = c.balanceChanged(txn, accountBefore, account, dispute.FinalTransactionId, request.Now)
+	panicIfNotNil(err)
+
+	err = c.updateAccount(txn, account)
+	panicIfNotNil(err)
+
+	err = c.updateDispute(txn, dispute)
+	panicIfNotNil(err)
+
+	var finalTransaction *corepb.Transaction
+	if dispute.FinalTransactionId != nil {
+		finalTransaction, err = c.getTransaction(txn, dispute.FinalTransactionId)
+		panicIfNotNil(err)
+	}
+
+	err = c.appendOutbox(txn, &corepb.OutboxRecord{
+		Type:        corepb.OutboxEventType_OUTBOX_EVENT_TYPE_DISPUTE_RESOLVED,
+		CreatedAt:   request.Now,
+		Account:     account,
+		Transaction: finalTransaction,
+		Dispute:     dispute,
+	})
+	panicIfNotNil(err)
+
+	err = txn.Commit()
+	panicIfNotNil(err)
+
+	return &corepb.ResolveDisputeResponse{
+		Dispute: dispute,
+	}, nil
+}
+
+func (c *AccountsCore) GetEscrow(request *corepb.GetEscrowRequest) (*corepb.GetEscrowResponse, error) {
+	txn := c.badgerStore.View()
+	defer txn.Discard()
+
+	escrow, err := c.getEscrow(txn, request.EscrowId)
+	if err != nil {
+		if errors.Is(err, monstera.ErrNotFound) {
+			return nil, monsterax.NewErrorWithContext(
+				monsterax.NotFound,
+				"escrow not found",
+				map[string]string{"escrow_id": EncodeEscrowId(request.EscrowId)})
+		} else {
+			panic(err)
+		}
+	}
+
+	return &corepb.GetEscrowResponse{
+		Escrow: escrow,
+	}, nil
+}
+
+func (c *AccountsCore) ListEscrows(request *corepb.ListEscrowsRequest) (*corepb.ListEscrowsResponse, error) {
+	txn := c.badgerStore.View()
+	defer txn.Discard()
+
+	escrows, err := c.listEscrows(txn, request.AccountId)
+	panicIfNotNil(err)
+
+	return &corepb.ListEscrowsResponse{
+		Escrows: escrows,
+	}, nil
+}
+
+func (c *AccountsCore) HoldEscrow(request *corepb.HoldEscrowRequest) (*corepb.HoldEscrowResponse, error) {
+	txn := c.badgerStore.Update()
+	defer txn.Discard()
+
+	account, err := c.getAccount(txn, request.EscrowId.AccountId)
+	if err != nil {
+		if errors.Is(err, monstera.ErrNotFound) {
+			return nil, monsterax.NewErrorWithContext(
+				monsterax.NotFound,
+				"account not found",
+				map[string]string{"account_id": EncodeAccountId(request.EscrowId.AccountId)})
+		} else {
+			panic(err)
+		}
+	}
+
+	accountBefore := proto.Clone(account).(*corepb.Account)
+
+	err = c.touchAccount(txn, account, request.Now)
+	panicIfNotNil(err)
+
+	if request.Amount <= 0 {
+		return nil, monsterax.NewErrorWithContext(
+			monsterax.InvalidArgument,
+			"invalid escrow amount",
+			map[string]string{"escrow_id": EncodeEscrowId(request.EscrowId)})
+	}
+
+	if request.AutoReleaseAt != 0 && request.AutoReleaseAt <= request.Now {
+		return nil, monsterax.NewErrorWithContext(
+			monsterax.InvalidArgument,
+			"auto release deadline is in the past",
+			map[string]string{"escrow_id": EncodeEscrowId(request.EscrowId)})
+	}
+
+	// held funds must be available, escrows can not make the balance negative
+	if account.AvailableBalance < request.Amount {
+		return nil, monsterax.NewErrorWithContext(
+			monsterax.InvalidArgument,
+			"insufficient funds",
+			map[string]string{"account_id": EncodeAccountId(request.EscrowId.AccountId)})
+	}
+
+	escrow := &corepb.Escrow{
+		Id:            request.EscrowId,
+		Name:          request.Name,
+		Amount:        request.Amount,
+		Status:        corepb.EscrowStatus_ESCROW_STATUS_HELD,
+		AutoReleaseAt: request.AutoReleaseAt,
+		ReleaseTransactionId: &corepb.TransactionId{
+			AccountId:     request.EscrowId.AccountId,
+			TransactionId: request.ReleaseTransactionId,
+		},
+		CreatedAt: request.Now,
+		UpdatedAt: request.Now,
+	}
+
+	// held funds leave available balance, but stay in settled balance until the escrow is released
+	account.AvailableBalance -= escrow.Amount
+	account.EscrowBalance += escrow.Amount
+	account.UpdatedAt = request.Now
+
+	if escrow.AutoReleaseAt != 0 {
+		err = c.escrowDeadlineIndex.Add(txn, escrowDeadlineIndexKey(c.shardLowerBound, escrow.Id, escrow.AutoReleaseAt))
+		panicIfNotNil(err)
+	}
+
+	err = c.balanceChanged(txn, accountBefore, account, nil, request.Now)
+	panicIfNotNil(err)
+
+	err = c.updateAccount(txn, account)
+	panicIfNotNil(err)
+
+	err = c.createEscrow(txn, escrow)
+	panicIfNotNil(err)
+
+	err = c.appendOutbox(txn, &corepb.OutboxRecord{
+		Type:      corepb.OutboxEventType_OUTBOX_EVENT_TYPE_ESCROW_HELD,
+		CreatedAt: request.Now,
+		Account:   account,
+		Escrow:    escrow,
+	})
+	panicIfNotNil(err)
+
+	err = txn.Commit()
+	panicIfNotNil(err)
+
+	return &corepb.HoldEscrowResponse{
+		Escrow: escrow,
+	}, nil
+}
+
+func (c *AccountsCore) ReleaseEscrow(request *corepb.ReleaseEscrowRequest) (*corepb.ReleaseEscrowResponse, error) {
+	txn := c.badgerStore.Update()
+	defer txn.Discard()
+
+	account, err := c.getAccount(txn, request.EscrowId.AccountId)
+	if err != nil {
+		if errors.Is(err, monstera.ErrNotFound) {
+			return nil, monsterax.NewErrorWithContext(
+				monsterax.NotFound,
+				"account not found",
+				map[string]string{"account_id": EncodeAccountId(request.EscrowId.AccountId)})
+		} else {
+			panic(err)
+		}
+	}
+
+	accountBefore := proto.Clone(account).(*corepb.Account)
+
+	err = c.touchAccount(txn, account, request.Now)
+	panicIfNotNil(err)
+
+	escrow, err := c.getEscrow(txn, request.EscrowId)
+	if err != nil {
+		if errors.Is(err, monstera.ErrNotFound) {
+			return nil, monsterax.NewErrorWithContext(
+				monsterax.NotFound,
+				"escrow not found",
+				map[string]string{"escrow_id": EncodeEscrowId(request.EscrowId)})
+		} else {
+			panic(err)
+		}
+	}
+
+	// only held escrows can be released
+	if escrow.Status != corepb.EscrowStatus_ESCROW_STATUS_HELD {
+		return nil, monsterax.NewErrorWithContext(
+			monsterax.InvalidArgument,
+			"escrow is not held",
+			map[string]string{"escrow_id": EncodeEscrowId(request.EscrowId)})
+	}
+
+	// after the deadline the escrow belongs to the auto-release, even if the sweep has not reached it yet
+	if escrow.AutoReleaseAt != 0 && escrow.AutoReleaseAt <= request.Now {
+		return nil, monsterax.NewErrorWithContext(
+			monsterax.InvalidArgument,
+			"escrow auto release deadline has passed",
+			map[string]string{"escrow_id": EncodeEscrowId(request.EscrowId)})
+	}
+
+	if request.ReleasedAmount < 0 || request.ReleasedAmount > escrow.Amount {
+		return nil, monsterax.NewErrorWithContext(
+			monsterax.InvalidArgument,
+			"invalid released amount",
+			map[string]string{"escrow_id": EncodeEscrowId(request.EscrowId)})
+	}
+
+	releaseTransaction, err := c.closeEscrow(txn, account, escrow, request.ReleasedAmount, request.Now)
+	panicIfNotNil(err)
+
+	err = c.balanceChanged(txn, accountBefore, account, escrow.ReleaseTransactionId, request.Now)
+	panicIfNotNil(err)
+
+	err = c.updateAccount(txn, account)
+	panicIfNotNil(err)
+
+	err = c.appendOutbox(txn, &corepb.OutboxRecord{
+		Type:        corepb.OutboxEventType_OUTBOX_EVENT_TYPE_ESCROW_RELEASED,
+		CreatedAt:   request.Now,
+		Account:     account,
+		Transaction: releaseTransaction,
+		Escrow:      escrow,
+	})
+	panicIfNotNil(err)
+
+	err = txn.Commit()
+	panicIfNotNil(err)
+
+	return &corepb.ReleaseEscrowResponse{
+		Escrow: escrow,
+	}, nil
+}
+
+// SweepEscrows releases in full held escrows whose auto-release deadline has passed at the moment `now`.
+func (c *AccountsCore) SweepEscrows(request *corepb.SweepEscrowsRequest) (*corepb.SweepEscrowsResponse, error) {
+	txn := c.badgerStore.Update()
+	defer txn.Discard()
+
+	if request.Limit <= 0 {
+		return nil, monsterax.NewError(monsterax.InvalidArgument, "invalid limit")
+	}
+
+	// the index is sorted by deadline, so only expired escrows are scanned
+	escrowIds := make([]*corepb.EscrowId, 0)
+	upperBound := monstera.ConcatBytes(c.shardLowerBound, request.Now+1)
+	err := c.escrowDeadlineIndex.ListInRange(txn, c.shardLowerBound, upperBound, func(key []byte) (bool, error) {
+		_, accountId, escrowId := parseEscrowDeadlineIndexKey(key)
+		escrowIds = append(escrowIds, &corepb.EscrowId{
+			AccountId: accountId,
+			EscrowId:  escrowId,
+		})
+		return len(escrowIds) < int(request.Limit), nil
+	})
+	panicIfNotNil(err)
+
+	for _, escrowId := range escrowIds {
+		account, err := c.getAccount(txn, escrowId.AccountId)
+		panicIfNotNil(err)
+
+		accountBefore := proto.Clone(account).(*corepb.Account)
+
+		err = c.touchAccount(txn, account, request.Now)
+		panicIfNotNil(err)
+
+		escrow, err := c.getEscrow(txn, escrowId)
+		panicIfNotNil(err)
+
+		escrow.AutoReleased = true
+
+		releaseTransaction, err := c.closeEscrow(txn, account, escrow, escrow.Amount, request.Now)
+		panicIfNotNil(err)
+
+		err = c.balanceChanged(txn, accountBefore, account, escrow.ReleaseTransactionId, request.Now)
+		panicIfNotNil(err)
+
+		err = c.updateAccount(txn, account)
+		panicIfNotNil(err)
+
+		err = c.appendOutbox(txn, &corepb.OutboxRecord{
+			Type:        corepb.OutboxEventType_OUTBOX_EVENT_TYPE_ESCROW_RELEASED,
+			CreatedAt:   request.Now,
+			Account:     account,
+			Transaction: releaseTransaction,
+			Escrow:      escrow,
+		})
+		panicIfNotNil(err)
+	}
+
+	err = txn.Commit()
+	panicIfNotNil(err)
+
+	return &corepb.SweepEscrowsResponse{
+		ReleasedEscrows: int32(len(escrowIds)),
+	}, nil
+}
+
+// balanceChanged is called by every update which changes account balance, before the account is stored.
+// It updates running aggregates of the shard and triggers alerts for all alert rules whose threshold was crossed
+// by available balance.
+func (c *AccountsCore) balanceChanged(txn *monstera.Txn, before *corepb.Account, after *corepb.Account, transactionId *corepb.TransactionId, now int64) error {
+	err := c.updateShardStats(txn, now, func(stats *corepb.ShardStats) {
+		stats.TotalAvailableBalance += after.AvailableBalance - before.AvailableBalance
+		stats.TotalSettledBalance += after.SettledBalance - before.SettledBalance
+		stats.TotalPendingAvailabilityBalance += after.PendingAvailabilityBalance - before.PendingAvailabilityBalance
+	})
+	if err != nil {
+		return err
+	}
+
+	if before.AvailableBalance == after.AvailableBalance {
+		return nil
+	}
+
+	alertRules, err := c.listAlertRules(txn, after.Id)
+	if err != nil {
+		return err
+	}
+
+	for _, alertRule := range alertRules {
+		var crossed bool
+		switch alertRule.Direction {
+		case corepb.AlertDirection_ALERT_DIRECTION_BELOW:
+			crossed = before.AvailableBalance >= alertRule.Threshold && after.AvailableBalance < alertRule.Threshold
+		case corepb.AlertDirection_ALERT_DIRECTION_ABOVE:
+			crossed = before.AvailableBalance <= alertRule.Threshold && after.AvailableBalance > alertRule.Threshold
+		default:
+			panic("invalid alert direction")
+		}
+
+		if !crossed {
+			continue
+		}
+
+		// alert ids are sequential per account to keep them deterministic
+		after.LastTriggeredAlertId++
+
+		alert := &corepb.TriggeredAlert{
+			Id: &corepb.TriggeredAlertId{
+				AccountId:        after.Id,
+				TriggeredAlertId: after.LastTriggeredAlertId,
+			},
+			AlertRuleId:      alertRule.Id,
+			Threshold:        alertRule.Threshold,
+			Direction:        alertRule.Direction,
+			AvailableBalance: after.AvailableBalance,
+			TransactionId:    transactionId,
+			TriggeredAt:      now,
+		}
+
+		err = c.createTriggeredAlert(txn, alert)
+		if err != nil {
+			return err
+		}
+
+		err = c.appendOutbox(txn, &corepb.OutboxRecord{
+			Type:           corepb.OutboxEventType_OUTBOX_EVENT_TYPE_ALERT_TRIGGERED,
+			CreatedAt:      now,
+			TriggeredAlert: alert,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *AccountsCore) getShardStats(txn *monstera.Txn) (*corepb.ShardStats, error) {
+	stats, err := c.shardStatsTable.Get(txn, c.shardLowerBound)
+	if err != nil {
+		if errors.Is(err, monstera.ErrNotFound) {
+			return &corepb.ShardStats{}, nil
+		}
+		return nil, err
+	}
+	return stats, nil
+}
+
+// appliedAt returns the time up to which the replica is known to have applied the shard log. A follower which applied
+// every committed entry is as fresh as its last contact with the leader, a follower which is still catching up (or
+// has never heard from the leader) returns 0. A leader and a core which is not replicated are always fresh.
+func (c *AccountsCore) appliedAt(now int64) int64 {
+	if c.raftStats == nil {
+		return now
+	}
+
+	stats := c.raftStats()
+	appliedIndex, err1 := strconv.ParseUint(stats["applied_index"], 10, 64)
+	commitIndex, err2 := strconv.ParseUint(stats["commit_index"], 10, 64)
+	if err1 != nil || err2 != nil || appliedIndex < commitIndex {
+		return 0
+	}
+
+	// "0" on the leader, "never" if the follower has not heard from the leader yet
+	lastContact, err := time.ParseDuration(stats["last_contact"])
+	if err != nil {
+		return 0
+	}
+
+	return now - lastContact.Nanoseconds()
+}
+
+// updateShardStats applies a change to running aggregates of the shard. There is a single record per shard, updates
+// are applied sequentially anyway.
+func (c *AccountsCore) updateShardStats(txn *monstera.Txn, now int64, fn func(stats *corepb.ShardStats)) error {
+	stats, err := c.getShardStats(txn)
+	if err != nil {
+		return err
+	}
+
+	fn(stats)
+	stats.UpdatedAt = now
+
+	return c.shardStatsTable.Set(txn, c.shardLowerBound, stats)
+}
+
+// appendOutbox stores a record in the shard outbox within the same transaction as the change it describes.
+// Record ids are sequential per shard, so consumers can acknowledge everything up to a given id.
+func (c *AccountsCore) appendOutbox(txn *monstera.Txn, record *corepb.OutboxRecord) error {
+	lastId, err := c.outboxSequence.Get(txn, c.shardLowerBound)
+	if err != nil && !errors.Is(err, monstera.ErrNotFound) {
+		return err
+	}
+
+	record.Id = lastId + 1
+
+	err = c.outboxSequence.Set(txn, c.shardLowerBound, record.Id)
+	if err != nil {
+		return err
+	}
+
+	return c.outboxTable.Set(txn, outboxTablePK(c.shardLowerBound), outboxTableSK(record.Id), record)
+}
+
+// transactionChanged is called by every update which creates a transaction or changes its status. It counts
+// pending transactions of the shard, and moves purchases between pending and settled amounts of spending aggregates
+// (cancelled purchases and purchases with insufficient funds are not counted).
+func (c *AccountsCore) transactionChanged(txn *monstera.Txn, statusBefore corepb.TransactionStatus, transaction *corepb.Transaction) error {
+	if statusBefore == transaction.Status {
+		return nil
+	}
+
+	err := c.updateShardStats(txn, transaction.UpdatedAt, func(stats *corepb.ShardStats) {
+		if statusBefore == corepb.TransactionStatus_TRANSACTION_STATUS_PENDING {
+			stats.PendingTransactionsCount--
+		}
+		if transaction.Status == corepb.TransactionStatus_TRANSACTION_STATUS_PENDING {
+			stats.PendingTransactionsCount++
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	// only purchases are spending
+	if transaction.Amount >= 0 {
+		return nil
+	}
+
+	month := calendarMonth(transaction.CreatedAt)
+
+	aggregate, err := c.getSpendingAggregate(txn, transaction.Id.AccountId, month, transaction.Category)
+	if err != nil {
+		if errors.Is(err, monstera.ErrNotFound) {
+			aggregate = &corepb.SpendingAggregate{
+				AccountId: transaction.Id.AccountId,
+				Month:     month,
+				Category:  transaction.Category,
+			}
+		} else {
+			return err
+		}
+	}
+
+	// amount is negative for purchases, aggregates are positive
+	switch statusBefore {
+	case corepb.TransactionStatus_TRANSACTION_STATUS_PENDING:
+		aggregate.PendingAmount += transaction.Amount
+		aggregate.PendingCount--
+	case corepb.TransactionStatus_TRANSACTION_STATUS_SETTLED:
+		aggregate.SettledAmount += transaction.Amount
+		aggregate.SettledCount--
+	}
+
+	switch transaction.Status {
+	case corepb.TransactionStatus_TRANSACTION_STATUS_PENDING:
+		aggregate.PendingAmount -= transaction.Amount
+		aggregate.PendingCount++
+	case corepb.TransactionStatus_TRANSACTION_STATUS_SETTLED:
+		aggregate.SettledAmount -= transaction.Amount
+		aggregate.SettledCount++
+	}
+
+	if aggregate.PendingCount == 0 && aggregate.SettledCount == 0 {
+		return c.deleteSpendingAggregate(txn, aggregate)
+	} else {
+		return c.updateSpendingAggregate(txn, aggregate)
+	}
+}
+
+// releaseAccount releases value-dated topups of an idle account which became available at the moment `now`, and
+// stores the account. It returns the number of released transactions.
+func (c *AccountsCore) releaseAccount(txn *monstera.Txn, accountId uint64, now int64) (int, error) {
+	account, err := c.getAccount(txn, accountId)
+	if err != nil {
+		return 0, err
+	}
+
+	accountBefore := proto.Clone(account).(*corepb.Account)
+
+	released, err := c.releaseFunds(txn, account, now)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(released) == 0 {
+		return 0, nil
+	}
+
+	err = c.balanceChanged(txn, accountBefore, account, nil, now)
+	if err != nil {
+		return 0, err
+	}
+
+	err = c.updateAccount(txn, account)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(released), nil
+}
+
+// settleTopup adds a settled topup to the account balance. Value-dated topups are added to the settled balance
+// right away, but stay in pending availability until their value date.
+func (c *AccountsCore) settleTopup(txn *monstera.Txn, account *corepb.Account, transaction *corepb.Transaction, now int64) error {
+	account.SettledBalance += transaction.Amount
+
+	if transaction.AvailableAt > now && transaction.Amount > 0 {
+		account.PendingAvailabilityBalance += transaction.Amount
+
+		err := c.pendingAvailabilityIndex.Add(txn, pendingAvailabilityIndexKey(c.shardLowerBound, transaction.Id, transaction.AvailableAt))
+		if err != nil {
+			return err
+		}
+		return c.accountPendingAvailabilityIndex.Add(txn, accountPendingAvailabilityIndexKey(transaction.Id, transaction.AvailableAt))
+	} else {
+		account.AvailableBalance += transaction.Amount
+		return nil
+	}
+}
+
+// touchAccount lazily releases value-dated topups which became available at the moment `now`. It is called by every
+// update which loads the account.
+func (c *AccountsCore) touchAccount(txn *monstera.Txn, account *corepb.Account, now int64) error {
+	_, err := c.releaseFunds(txn, account, now)
+	return err
+}
+
+// releaseFunds releases value-dated topups of the account which became available at the moment `now`, deletes them
+// from the index and writes them to the outbox, both for lazy releases and sweeps. Only the account is changed, it is
+// stored by the caller. Released transactions are returned.
+func (c *AccountsCore) releaseFunds(txn *monstera.Txn, account *corepb.Account, now int64) ([]*corepb.Transaction, error) {
+	released, err := c.releaseAvailableFunds(txn, account, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(released) == 0 {
+		return released, nil
+	}
+
+	err = c.deletePendingAvailability(txn, released)
+	if err != nil {
+		return nil, err
+	}
+
+	account.UpdatedAt = now
+
+	for _, transaction := range released {
+		err = c.appendOutbox(txn, &corepb.OutboxRecord{
+			Type:        corepb.OutboxEventType_OUTBOX_EVENT_TYPE_FUNDS_RELEASED,
+			CreatedAt:   now,
+			Account:     account,
+			Transaction: transaction,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return released, nil
+}
+
+// releaseAvailableFunds moves value-dated topups which became available at the moment `now` from pending availability
+// into available balance of the given account. Only the account is changed, released transactions are returned so
+// that the caller can delete them from the index.
+func (c *AccountsCore) releaseAvailableFunds(txn *monstera.Txn, account *corepb.Account, now int64) ([]*corepb.Transaction, error) {
+	result := make([]*corepb.Transaction, 0)
+
+	if account.PendingAvailabilityBalance == 0 {
+		return result, nil
+	}
+
+	// only topups of this account which are already due are scanned
+	transactionIds := make([]*corepb.TransactionId, 0)
+	upperBound := monstera.ConcatBytes(transactionsTablePK(account.Id), now)
+	err := c.accountPendingAvailabilityIndex.ListInRange(txn, transactionsTablePK(account.Id), upperBound, func(key []byte) (bool, error) {
+		transactionIds = append(transactionIds, &corepb.TransactionId{
+			AccountId:     account.Id,
+			TransactionId: parseAccountPendingAvailabilityIndexKey(key),
+		})
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, transactionId := range transactionIds {
+		transaction, err := c.getTransaction(txn, transactionId)
+		if err != nil {
+			return nil, err
+		}
+
+		account.PendingAvailabilityBalance -= transaction.Amount
+		account.AvailableBalance += transaction.Amount
+
+		result = append(result, transaction)
+	}
+
+	return result, nil
+}
+
+// listAvailablePendingTopups calls fn for value-dated topups of the shard which became available at the moment `now`,
+// in order of their value dates, until fn returns false. Due topups are released by sweeps, so the scan is short.
+func (c *AccountsCore) listAvailablePendingTopups(txn *monstera.Txn, now int64, fn func(transactionId *corepb.TransactionId) bool) error {
+	upperBound := monstera.ConcatBytes(c.shardLowerBound, now+1)
+
+	return c.pendingAvailabilityIndex.ListInRange(txn, c.shardLowerBound, upperBound, func(key []byte) (bool, error) {
+		_, accountId, transactionId := parsePendingAvailabilityIndexKey(key)
+		return fn(&corepb.TransactionId{
+			AccountId:     accountId,
+			TransactionId: transactionId,
+		}), nil
+	})
+}
+
+func (c *AccountsCore) deletePendingAvailability(txn *monstera.Txn, transactions []*corepb.Transaction) error {
+	for _, transaction := range transactions {
+		err := c.pendingAvailabilityIndex.Delete(txn, pendingAvailabilityIndexKey(c.shardLowerBound, transaction.Id, transaction.AvailableAt))
+		if err != nil {
+			return err
+		}
+
+		err = c.accountPendingAvailabilityIndex.Delete(txn, accountPendingAvailabilityIndexKey(transaction.Id, transaction.AvailableAt))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// closeEscrow releases `releasedAmount` of a held escrow and refunds the rest. Released funds are debited from
+// settled balance with a settled purchase (returned, nil if nothing is released), refunded funds return to
+// available balance. The escrow is stored, the account is only changed.
+func (c *AccountsCore) closeEscrow(txn *monstera.Txn, account *corepb.Account, escrow *corepb.Escrow, releasedAmount int64, now int64) (*corepb.Transaction, error) {
+	escrow.ReleasedAmount = releasedAmount
+	escrow.RefundedAmount = escrow.Amount - releasedAmount
+	escrow.UpdatedAt = now
+
+	switch {
+	case escrow.RefundedAmount == 0:
+		escrow.Status = corepb.EscrowStatus_ESCROW_STATUS_RELEASED
+	case escrow.ReleasedAmount == 0:
+		escrow.Status = corepb.EscrowStatus_ESCROW_STATUS_REFUNDED
+	default:
+		escrow.Status = corepb.EscrowStatus_ESCROW_STATUS_SPLIT
+	}
+
+	account.EscrowBalance -= escrow.Amount
+	account.AvailableBalance += escrow.RefundedAmount
+	account.SettledBalance -= escrow.ReleasedAmount
+	account.UpdatedAt = now
+
+	if escrow.AutoReleaseAt != 0 {
+		err := c.escrowDeadlineIndex.Delete(txn, escrowDeadlineIndexKey(c.shardLowerBound, escrow.Id, escrow.AutoReleaseAt))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := c.updateEscrow(txn, escrow)
+	if err != nil {
+		return nil, err
+	}
+
+	if escrow.ReleasedAmount == 0 {
+		return nil, nil
+	}
+
+	releaseTransaction := &corepb.Transaction{
+		Id:          escrow.ReleaseTransactionId,
+		Amount:      -escrow.ReleasedAmount,
+		Description: "Escrow release: " + escrow.Name,
+		Status:      corepb.TransactionStatus_TRANSACTION_STATUS_SETTLED,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	err = c.createTransaction(txn, releaseTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.transactionChanged(txn, corepb.TransactionStatus_TRANSACTION_STATUS_INVALID, releaseTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	return releaseTransaction, nil
+}
+
+func (c *AccountsCore) getAccount(txn *monstera.Txn, accountId uint64) (*corepb.Account, error) {
+	return c.accountsTable.Get(txn, accountsTablePK(accountId))
+}
+
+func (c *AccountsCore) updateAccount(txn *monstera.Txn, account *corepb.Account) error {
+	return c.accountsTable.Set(txn, accountsTablePK(account.Id), account)
+}
+
+func (c *AccountsCore) deleteAccount(txn *monstera.Txn, accountId uint64) error {
+	return c.accountsTable.Delete(txn, accountsTablePK(accountId))
+}
+
+func (c *AccountsCore) createAccount(txn *monstera.Txn, account *corepb.Account) error {
+	return c.accountsTable.Set(txn, accountsTablePK(account.Id), account)
+}
+
+func (c *AccountsCore) getTransaction(txn *monstera.Txn, transactionId *corepb.TransactionId) (*corepb.Transaction, error) {
+	return c.transactionsTable.Get(txn, transactionsTablePK(transactionId.AccountId), transactionsTableSK(transactionId))
+}
+
+func (c *AccountsCore) createTransaction(txn *monstera.Txn, transaction *corepb.Transaction) error {
+	return c.transactionsTable.Set(txn, transactionsTablePK(transaction.Id.AccountId), transactionsTableSK(transaction.Id), transaction)
+}
+
+func (c *AccountsCore) updateTransaction(txn *monstera.Txn, transaction *corepb.Transaction) error {
+	return c.transactionsTable.Set(txn, transactionsTablePK(transaction.Id.AccountId), transactionsTableSK(transaction.Id), transaction)
+}
+
+func (c *AccountsCore) listTransactions(txn *monstera.Txn, accountId uint64) ([]*corepb.Transaction, error) {
+	result := make([]*corepb.Transaction, 0)
+
+	err := c.transactionsTable.List(txn, transactionsTablePK(accountId), func(transaction *corepb.Transaction) (bool, error) {
+		result = append(result, transaction)
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (c *AccountsCore) deleteTransaction(txn *monstera.Txn, transactionId *corepb.TransactionId) error {
+	return c.transactionsTable.Delete(txn, transactionsTablePK(transactionId.AccountId), transactionsTableSK(transactionId))
+}
+
+// listArchivableTransactions lists settled and cancelled transactions of all accounts of the shard which were last
+// updated (and became available, for value-dated topups) before the cutoff, sorted by account id and transaction id.
+// Transactions of disputes which are still opened are kept, since resolving a dispute reads them.
+func (c *AccountsCore) listArchivableTransactions(txn *monstera.Txn, before int64, after *corepb.TransactionId, limit int) ([]*corepb.Transaction, error) {
+	result := make([]*corepb.Transaction, 0)
+
+	lowerBound := c.shardLowerBound
+	if after != nil {
+		lowerBound = monstera.ConcatBytes(transactionsTablePK(after.AccountId), transactionsTableSK(after))
+	}
+
+	err := monsterax.ListRange(txn, transactionsTableId, []byte{}, lowerBound, c.shardUpperBound, func(key []byte, value []byte) (bool, error) {
+		transaction := &corepb.Transaction{}
+		err := proto.Unmarshal(value, transaction)
+		if err != nil {
+			return false, err
+		}
+
+		if after != nil && proto.Equal(transaction.Id, after) {
+			return true, nil
+		}
+
+		final := transaction.Status == corepb.TransactionStatus_TRANSACTION_STATUS_SETTLED ||
+			transaction.Status == corepb.TransactionStatus_TRANSACTION_STATUS_CANCELLED
+		if final && transaction.UpdatedAt < before && transaction.AvailableAt < before {
+			if transaction.DisputeId != nil {
+				dispute, err := c.getDispute(txn, transaction.DisputeId)
+				if err != nil {
+					return false, err
+				}
+				if dispute.Status == corepb.DisputeStatus_DISPUTE_STATUS_OPENED {
+					return true, nil
+				}
+			}
+
+			result = append(result, transaction)
+		}
+
+		return len(result) < limit, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// rollupTransaction adds an archived transaction to the rollup of its account for the month of its creation
+func (c *AccountsCore) rollupTransaction(txn *monstera.Txn, transaction *corepb.Transaction, now int64) error {
+	month := calendarMonth(transaction.CreatedAt)
+
+	rollup, err := c.rollupsTable.Get(txn, rollupsTablePK(transaction.Id.AccountId), rollupsTableSK(month))
+	if err != nil {
+		if errors.Is(err, monstera.ErrNotFound) {
+			rollup = &corepb.TransactionRollup{
+				AccountId: transaction.Id.AccountId,
+				Month:     month,
+			}
+		} else {
+			return err
+		}
+	}
+
+	switch {
+	case transaction.Status == corepb.TransactionStatus_TRANSACTION_STATUS_CANCELLED:
+		rollup.CancelledCount++
+	case transaction.Amount >= 0:
+		rollup.TopupAmount += transaction.Amount
+		rollup.TopupCount++
+	default:
+		rollup.PurchaseAmount += transaction.Amount
+		rollup.PurchaseCount++
+	}
+
+	rollup.UpdatedAt = now
+
+	return c.rollupsTable.Set(txn, rollupsTablePK(rollup.AccountId), rollupsTableSK(rollup.Month), rollup)
+}
+
+func (c *AccountsCore) listTransactionRollups(txn *monstera.Txn, accountId uint64) ([]*corepb.TransactionRollup, error) {
+	result := make([]*corepb.TransactionRollup, 0)
+
+	err := c.rollupsTable.List(txn, rollupsTablePK(accountId), func(rollup *corepb.TransactionRollup) (bool, error) {
+		result = append(result, rollup)
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (c *AccountsCore) getSpendingAggregate(txn *monstera.Txn, accountId uint64, month string, category string) (*corepb.SpendingAggregate, error) {
+	return c.spendingTable.Get(txn, spendingTablePK(accountId), spendingTableSK(month, category))
+}
+
+func (c *AccountsCore) updateSpendingAggregate(txn *monstera.Txn, aggregate *corepb.SpendingAggregate) error {
+	return c.spendingTable.Set(txn, spendingTablePK(aggregate.AccountId), spendingTableSK(aggregate.Month, aggregate.Category), aggregate)
+}
+
+func (c *AccountsCore) deleteSpendingAggregate(txn *monstera.Txn, aggregate *corepb.SpendingAggregate) error {
+	return c.spendingTable.Delete(txn, spendingTablePK(aggregate.AccountId), spendingTableSK(aggregate.Month, aggregate.Category))
+}
+
+// listSpendingAggregates lists aggregates of an account between fromMonth and toMonth (inclusive), sorted by month
+// and category. Empty bounds are not limiting.
+func (c *AccountsCore) listSpendingAggregates(txn *monstera.Txn, accountId uint64, fromMonth string, toMonth string) ([]*corepb.SpendingAggregate, error) {
+	result := make([]*corepb.SpendingAggregate, 0)
+
+	err := c.spendingTable.List(txn, spendingTablePK(accountId), func(aggregate *corepb.SpendingAggregate) (bool, error) {
+		if toMonth != "" && aggregate.Month > toMonth {
+			return false, nil
+		}
+		if fromMonth == "" || aggregate.Month >= fromMonth {
+			result = append(result, aggregate)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (c *AccountsCore) getDispute(txn *monstera.Txn, disputeId *corepb.DisputeId) (*corepb.Dispute, error) {
+	return c.disputesTable.Get(txn, disputesTablePK(disputeId.AccountId), disputesTableSK(disputeId))
+}
+
+func (c *AccountsCore) createDispute(txn *monstera.Txn, dispute *corepb.Dispute) error {
+	return c.disputesTable.Set(txn, disputesTablePK(dispute.Id.AccountId), disputesTableSK(dispute.Id), dispute)
+}
+
+func (c *AccountsCore) updateDispute(txn *monstera.Txn, dispute *corepb.Dispute) error {
+	return c.disputesTable.Set(txn, disputesTablePK(dispute.Id.AccountId), disputesTableSK(dispute.Id), dispute)
+}
+
+func (c *AccountsCore) listDisputes(txn *monstera.Txn, accountId uint64) ([]*corepb.Dispute, error) {
+	result := make([]*corepb.Dispute, 0)
+
+	err := c.disputesTable.List(txn, disputesTablePK(accountId), func(dispute *corepb.Dispute) (bool, error) {
+		result = append(result, dispute)
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (c *AccountsCore) getEscrow(txn *monstera.Txn, escrowId *corepb.EscrowId) (*corepb.Escrow, error) {
+	return c.escrowsTable.Get(txn, escrowsTablePK(escrowId.AccountId), escrowsTableSK(escrowId))
+}
+
+func (c *AccountsCore) createEscrow(txn *monstera.Txn, escrow *corepb.Escrow) error {
+	return c.escrowsTable.Set(txn, escrowsTablePK(escrow.Id.AccountId), escrowsTableSK(escrow.Id), escrow)
+}
+
+func (c *AccountsCore) updateEscrow(txn *monstera.Txn, escrow *corepb.Escrow) error {
+	return c.escrowsTable.Set(txn, escrowsTablePK(escrow.Id.AccountId), escrowsTableSK(escrow.Id), escrow)
+}
+
+func (c *AccountsCore) listEscrows(txn *monstera.Txn, accountId uint64) ([]*corepb.Escrow, error) {
+	result := make([]*corepb.Escrow, 0)
+
+	err := c.escrowsTable.List(txn, escrowsTablePK(accountId), func(escrow *corepb.Escrow) (bool, error) {
+		result = append(result, escrow)
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (c *AccountsCore) getAlertRule(txn *monstera.Txn, alertRuleId *corepb.AlertRuleId) (*corepb.AlertRule, error) {
+	return c.alertRulesTable.Get(txn, alertRulesTablePK(alertRuleId.AccountId), alertRulesTableSK(alertRuleId))
+}
+
+func (c *AccountsCore) createAlertRule(txn *monstera.Txn, alertRule *corepb.AlertRule) error {
+	return c.alertRulesTable.Set(txn, alertRulesTablePK(alertRule.Id.AccountId), alertRulesTableSK(alertRule.Id), alertRule)
+}
+
+func (c *AccountsCore) deleteAlertRule(txn *monstera.Txn, alertRuleId *corepb.AlertRuleId) error {
+	return c.alertRulesTable.Delete(txn, alertRulesTablePK(alertRuleId.AccountId), alertRulesTableSK(alertRuleId))
+}
+
+func (c *AccountsCore) listAlertRules(txn *monstera.Txn, accountId uint64) ([]*corepb.AlertRule, error) {
+	result := make([]*corepb.AlertRule, 0)
+
+	err := c.alertRulesTable.List(txn, alertRulesTablePK(accountId), func(alertRule *corepb.AlertRule) (bool, error) {
+		result = append(result, alertRule)
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (c *AccountsCore) getTriggeredAlert(txn *monstera.Txn, alertId *corepb.TriggeredAlertId) (*corepb.TriggeredAlert, error) {
+	return c.alertsTable.Get(txn, alertsTablePK(alertId.AccountId), alertsTableSK(alertId))
+}
+
+func (c *AccountsCore) createTriggeredAlert(txn *monstera.Txn, alert *corepb.TriggeredAlert) error {
+	return c.alertsTable.Set(txn, alertsTablePK(alert.Id.AccountId), alertsTableSK(alert.Id), alert)
+}
+
+func (c *AccountsCore) updateTriggeredAlert(txn *monstera.Txn, alert *corepb.TriggeredAlert) error {
+	return c.alertsTable.Set(txn, alertsTablePK(alert.Id.AccountId), alertsTableSK(alert.Id), alert)
+}
+
+func (c *AccountsCore) listTriggeredAlerts(txn *monstera.Txn, accountId uint64, unacknowledgedOnly bool) ([]*corepb.TriggeredAlert, error) {
+	result := make([]*corepb.TriggeredAlert, 0)
+
+	err := c.alertsTable.List(txn, alertsTablePK(accountId), func(alert *corepb.TriggeredAlert) (bool, error) {
+		if !unacknowledgedOnly || !alert.Acknowledged {
+			result = append(result, alert)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// 1. shard key (by account id)
+// 2. account id
+func accountsTablePK(accountId uint64) []byte {
+	return monstera.ConcatBytes(shardByAccount(accountId), accountId)
+}
+
+// 1. shard key (by account id)
+// 2. account id
+func transactionsTablePK(accountId uint64) []byte {
+	return monstera.ConcatBytes(shardByAccount(accountId), accountId)
+}
+
+// 1. transaction id
+func transactionsTableSK(t *corepb.TransactionId) []byte {
+	return monstera.ConcatBytes(t.GetTransactionId())
+}
+
+// 1. shard key (by account id)
+// 2. account id
+func disputesTablePK(accountId uint64) []byte {
+	return monstera.ConcatBytes(shardByAccount(accountId), accountId)
+}
+
+// 1. dispute id
+func disputesTableSK(d *corepb.DisputeId) []byte {
+	return monstera.ConcatBytes(d.GetDisputeId())
+}
+
+// 1. shard key (by account id)
+// 2. account id
+func escrowsTablePK(accountId uint64) []byte {
+	return monstera.ConcatBytes(shardByAccount(accountId), accountId)
+}
+
+// 1. escrow id
+func escrowsTableSK(e *corepb.EscrowId) []byte {
+	return monstera.ConcatBytes(e.GetEscrowId())
+}
+
+// 1. shard key (by account id)
+// 2. account id
+func alertRulesTablePK(accountId uint64) []byte {
+	return monstera.ConcatBytes(shardByAccount(accountId), accountId)
+}
+
+// 1. alert rule id
+func alertRulesTableSK(a *corepb.AlertRuleId) []byte {
+	return monstera.ConcatBytes(a.GetAlertRuleId())
+}
+
+// 1. shard key (by account id)
+// 2. account id
+func alertsTablePK(accountId uint64) []byte {
+	return monstera.ConcatBytes(shardByAccount(accountId), accountId)
+}
+
+// 1. triggered alert id (sequential, alerts are sorted by time)
+func alertsTableSK(a *corepb.TriggeredAlertId) []byte {
+	return monstera.ConcatBytes(a.GetTriggeredAlertId())
+}
+
+// 1. shard lower bound (one range per shard, so that sweeps scan topups in order of value dates)
+// 2. available at
+// 3. account id
+// 4. transaction id
+func pendingAvailabilityIndexKey(shardLowerBound []byte, transactionId *corepb.TransactionId, availableAt int64) []byte {
+	return monstera.ConcatBytes(shardLowerBound, availableAt, transactionId.AccountId, transactionId.TransactionId)
+}
+
+// parsePendingAvailabilityIndexKey returns available at, account id, and transaction id from the last 24 bytes of
+// a full index key
+func parsePendingAvailabilityIndexKey(key []byte) (int64, uint64, uint64) {
+	n := len(key)
+	return int64(binary.BigEndian.Uint64(key[n-24 : n-16])), binary.BigEndian.Uint64(key[n-16 : n-8]), binary.BigEndian.Uint64(key[n-8:])
+}
+
+// 1. shard key (by account id)
+// 2. account id
+// 3. available at
+// 4. transaction id
+func accountPendingAvailabilityIndexKey(transactionId *corepb.TransactionId, availableAt int64) []byte {
+	return monstera.ConcatBytes(transactionsTablePK(transactionId.AccountId), availableAt, transactionId.TransactionId)
+}
+
+// parseAccountPendingAvailabilityIndexKey returns transaction id from the last 8 bytes of a full index key
+func parseAccountPendingAvailabilityIndexKey(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[len(key)-8:])
+}
+
+// 1. shard lower bound (one range per shard, so that sweeps scan escrows in order of deadlines)
+// 2. auto release at
+// 3. account id
+// 4. escrow id
+func escrowDeadlineIndexKey(shardLowerBound []byte, escrowId *corepb.EscrowId, autoReleaseAt int64) []byte {
+	return monstera.ConcatBytes(shardLowerBound, autoReleaseAt, escrowId.AccountId, escrowId.EscrowId)
+}
+
+// parseEscrowDeadlineIndexKey returns auto release at, account id, and escrow id from the last 24 bytes of a full
+// index key
+func parseEscrowDeadlineIndexKey(key []byte) (int64, uint64, uint64) {
+	n := len(key)
+	return int64(binary.BigEndian.Uint64(key[n-24 : n-16])), binary.BigEndian.Uint64(key[n-16 : n-8]), binary.BigEndian.Uint64(key[n-8:])
+}
+
+// 1. shard key (by account id)
+// 2. account id
+func spendingTablePK(accountId uint64) []byte {
+	return monstera.ConcatBytes(shardByAccount(accountId), accountId)
+}
+
+// 1. month ("YYYY-MM", fixed length, aggregates are sorted by month)
+// 2. category
+func spendingTableSK(month string, category string) []byte {
+	return monstera.ConcatBytes(month, category)
+}
+
+// 1. shard key (by account id)
+// 2. account id
+func rollupsTablePK(accountId uint64) []byte {
+	return monstera.ConcatBytes(shardByAccount(accountId), accountId)
+}
+
+// 1. month ("YYYY-MM", rollups are sorted by month)
+func rollupsTableSK(month string) []byte {
+	return monstera.ConcatBytes(month)
+}
+
+// calendarMonth returns a calendar month (UTC) of a timestamp in "YYYY-MM" format
+func calendarMonth(timestamp int64) string {
+	return time.Unix(0, timestamp).UTC().Format("2006-01")
+}
+
+// 1. shard lower bound (one outbox per shard)
+func outboxTablePK(shardLowerBound []byte) []byte {
+	return monstera.ConcatBytes(shardLowerBound)
+}
+
+// 1. record id (sequential, records are sorted by time)
+func outboxTableSK(id uint64) []byte {
+	return monstera.ConcatBytes(id)
 }
 
 func panicIfNotNil(err error) {
@@ -1,6 +1,8 @@
 package ledger
 
 import (
+	"bytes"
+	"io"
 	"math/rand/v2"
 	"testing"
 	"time"
@@ -165,6 +167,1486 @@ func TestCreateAndSettleTransaction(t *testing.T) {
 	require.EqualValues(90, response8.Account.SettledBalance)
 }
 
+func TestOpenAndWinDispute(t *testing.T) {
+	require := require.New(t)
+
+	accountsCore := newAccountsCore()
+
+	now := time.Now()
+
+	accountId := rand.Uint64()
+
+	// T+0: create account with a settled topup +100 and a settled purchase -30
+	_, err := accountsCore.CreateAccount(&corepb.CreateAccountRequest{
+		AccountId: accountId,
+		Now:       now.UnixNano(),
+	})
+	require.NoError(err)
+
+	_, err = accountsCore.CreateTransaction(&corepb.CreateTransactionRequest{
+		TransactionId: &corepb.TransactionId{
+			AccountId:     accountId,
+			TransactionId: rand.Uint64(),
+		},
+		Now:     now.UnixNano(),
+		Amount:  100,
+		Settled: true,
+	})
+	require.NoError(err)
+
+	response1, err := accountsCore.CreateTransaction(&corepb.CreateTransactionRequest{
+		TransactionId: &corepb.TransactionId{
+			AccountId:     accountId,
+			TransactionId: rand.Uint64(),
+		},
+		Now:         now.UnixNano(),
+		Description: "Purchase",
+		Amount:      -30,
+		Settled:     true,
+	})
+	require.NoError(err)
+
+	disputeId := &corepb.DisputeId{
+		AccountId: accountId,
+		DisputeId: rand.Uint64(),
+	}
+
+	// T+1m: open dispute for the purchase
+	response2, err := accountsCore.OpenDispute(&corepb.OpenDisputeRequest{
+		DisputeId:                disputeId,
+		TransactionId:            response1.Transaction.Id,
+		Description:              "Not delivered",
+		ProvisionalTransactionId: rand.Uint64(),
+		Now:                      now.Add(time.Minute).UnixNano(),
+	})
+	require.NoError(err)
+	require.NotNil(response2.Dispute)
+	require.Equal(corepb.DisputeStatus_DISPUTE_STATUS_OPENED, response2.Dispute.Status)
+	require.EqualValues(30, response2.Dispute.Amount)
+	require.Equal(response1.Transaction.Id.TransactionId, response2.Dispute.TransactionId.TransactionId)
+	require.NotNil(response2.Dispute.ProvisionalTransactionId)
+	require.Nil(response2.Dispute.FinalTransactionId)
+
+	// T+1m: provisional credit is settled
+	response3, err := accountsCore.GetTransaction(&corepb.GetTransactionRequest{
+		TransactionId: response2.Dispute.ProvisionalTransactionId,
+	})
+	require.NoError(err)
+	require.Equal(corepb.TransactionStatus_TRANSACTION_STATUS_SETTLED, response3.Transaction.Status)
+	require.EqualValues(30, response3.Transaction.Amount)
+	require.Equal(disputeId.DisputeId, response3.Transaction.DisputeId.DisputeId)
+
+	// T+1m: get account
+	response4, err := accountsCore.GetAccount(&corepb.GetAccountRequest{
+		AccountId: accountId,
+	})
+	require.NoError(err)
+	require.EqualValues(100, response4.Account.AvailableBalance)
+	require.EqualValues(100, response4.Account.SettledBalance)
+
+	// T+1m: the same purchase cannot be disputed twice
+	_, err = accountsCore.OpenDispute(&corepb.OpenDisputeRequest{
+		DisputeId: &corepb.DisputeId{
+			AccountId: accountId,
+			DisputeId: rand.Uint64(),
+		},
+		TransactionId:            response1.Transaction.Id,
+		ProvisionalTransactionId: rand.Uint64(),
+		Now:                      now.Add(time.Minute).UnixNano(),
+	})
+	require.Error(err)
+
+	// T+2m: resolve dispute in favor of the account holder
+	response5, err := accountsCore.ResolveDispute(&corepb.ResolveDisputeRequest{
+		DisputeId:          disputeId,
+		Won:                true,
+		FinalTransactionId: rand.Uint64(),
+		Now:                now.Add(2 * time.Minute).UnixNano(),
+	})
+	require.NoError(err)
+	require.Equal(corepb.DisputeStatus_DISPUTE_STATUS_WON, response5.Dispute.Status)
+	require.EqualValues(now.Add(2*time.Minute).UnixNano(), response5.Dispute.UpdatedAt)
+	require.Nil(response5.Dispute.FinalTransactionId)
+
+	// T+2m: get account, provisional credit is kept
+	response6, err := accountsCore.GetAccount(&corepb.GetAccountRequest{
+		AccountId: accountId,
+	})
+	require.NoError(err)
+	require.EqualValues(100, response6.Account.AvailableBalance)
+	require.EqualValues(100, response6.Account.SettledBalance)
+
+	// T+3m: resolved dispute cannot be resolved again
+	_, err = accountsCore.ResolveDispute(&corepb.ResolveDisputeRequest{
+		DisputeId:          disputeId,
+		Won:                false,
+		FinalTransactionId: rand.Uint64(),
+		Now:                now.Add(3 * time.Minute).UnixNano(),
+	})
+	require.Error(err)
+
+	// T+3m: list disputes
+	response7, err := accountsCore.ListDisputes(&corepb.ListDisputesRequest{
+		AccountId: accountId,
+	})
+	require.NoError(err)
+	require.Len(response7.Disputes, 1)
+	require.Equal(corepb.DisputeStatus_DISPUTE_STATUS_WON, response7.Disputes[0].Status)
+}
+
+func TestOpenAndLoseDispute(t *testing.T) {
+	require := require.New(t)
+
+	accountsCore := newAccountsCore()
+
+	now := time.Now()
+
+	accountId := rand.Uint64()
+
+	// T+0: create account with a settled topup +100 and a settled purchase -80
+	_, err := accountsCore.CreateAccount(&corepb.CreateAccountRequest{
+		AccountId: accountId,
+		Now:       now.UnixNano(),
+	})
+	require.NoError(err)
+
+	_, err = accountsCore.CreateTransaction(&corepb.CreateTransactionRequest{
+		TransactionId: &corepb.TransactionId{
+			AccountId:     accountId,
+			TransactionId: rand.Uint64(),
+		},
+		Now:     now.UnixNano(),
+		Amount:  100,
+		Settled: true,
+	})
+	require.NoError(err)
+
+	response1, err := accountsCore.CreateTransaction(&corepb.CreateTransactionRequest{
+		TransactionId: &corepb.TransactionId{
+			AccountId:     accountId,
+			TransactionId: rand.Uint64(),
+		},
+		Now:     now.UnixNano(),
+		Amount:  -80,
+		Settled: true,
+	})
+	require.NoError(err)
+
+	disputeId := &corepb.DisputeId{
+		AccountId: accountId,
+		DisputeId: rand.Uint64(),
+	}
+
+	// T+1m: open partial dispute for 50
+	_, err = accountsCore.OpenDispute(&corepb.OpenDisputeRequest{
+		DisputeId:                disputeId,
+		TransactionId:            response1.Transaction.Id,
+		Amount:                   50,
+		ProvisionalTransactionId: rand.Uint64(),
+		Now:                      now.Add(time.Minute).UnixNano(),
+	})
+	require.NoError(err)
+
+	// T+2m: spend the provisional credit
+	_, err = accountsCore.CreateTransaction(&corepb.CreateTransactionRequest{
+		TransactionId: &corepb.TransactionId{
+			AccountId:     accountId,
+			TransactionId: rand.Uint64(),
+		},
+		Now:     now.Add(2 * time.Minute).UnixNano(),
+		Amount:  -60,
+		Settled: true,
+	})
+	require.NoError(err)
+
+	// T+3m: resolve dispute against the account holder
+	response2, err := accountsCore.ResolveDispute(&corepb.ResolveDisputeRequest{
+		DisputeId:          disputeId,
+		Won:                false,
+		FinalTransactionId: rand.Uint64(),
+		Now:                now.Add(3 * time.Minute).UnixNano(),
+	})
+	require.NoError(err)
+	require.Equal(corepb.DisputeStatus_DISPUTE_STATUS_LOST, response2.Dispute.Status)
+	require.NotNil(response2.Dispute.FinalTransactionId)
+
+	// T+3m: reversal is settled
+	response3, err := accountsCore.GetTransaction(&corepb.GetTransactionRequest{
+		TransactionId: response2.Dispute.FinalTransactionId,
+	})
+	require.NoError(err)
+	require.Equal(corepb.TransactionStatus_TRANSACTION_STATUS_SETTLED, response3.Transaction.Status)
+	require.EqualValues(-50, response3.Transaction.Amount)
+
+	// T+3m: get account, reversal makes the balance negative
+	response4, err := accountsCore.GetAccount(&corepb.GetAccountRequest{
+		AccountId: accountId,
+	})
+	require.NoError(err)
+	require.EqualValues(-40, response4.Account.AvailableBalance)
+	require.EqualValues(-40, response4.Account.SettledBalance)
+
+	// reversal is counted as spending
+	response5, err := accountsCore.GetSpendingSummary(&corepb.GetSpendingSummaryRequest{
+		AccountId: accountId,
+		FromMonth: calendarMonth(now.UnixNano()),
+		ToMonth:   calendarMonth(now.Add(3 * time.Minute).UnixNano()),
+	})
+	require.NoError(err)
+	settledAmount, settledCount := int64(0), int64(0)
+	for _, aggregate := range response5.Aggregates {
+		settledAmount += aggregate.SettledAmount
+		settledCount += aggregate.SettledCount
+	}
+	require.EqualValues(190, settledAmount)
+	require.EqualValues(3, settledCount)
+}
+
+func TestArchiveTransactionsWithOpenedDispute(t *testing.T) {
+	require := require.New(t)
+
+	accountsCore := newAccountsCore()
+
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	accountId := rand.Uint64()
+
+	// T+0: create account with a settled topup +100 and a settled purchase -80
+	_, err := accountsCore.CreateAccount(&corepb.CreateAccountRequest{
+		AccountId: accountId,
+		Now:       now.UnixNano(),
+	})
+	require.NoError(err)
+
+	_, err = accountsCore.CreateTransaction(&corepb.CreateTransactionRequest{
+		TransactionId: &corepb.TransactionId{
+			AccountId:     accountId,
+			TransactionId: rand.Uint64(),
+		},
+		Now:     now.UnixNano(),
+		Amount:  100,
+		Settled: true,
+	})
+	require.NoError(err)
+
+	response1, err := accountsCore.CreateTransaction(&corepb.CreateTransactionRequest{
+		TransactionId: &corepb.TransactionId{
+			AccountId:     accountId,
+			TransactionId: rand.Uint64(),
+		},
+		Now:     now.UnixNano(),
+		Amount:  -80,
+		Settled: true,
+	})
+	require.NoError(err)
+
+	disputeId := &corepb.DisputeId{
+		AccountId: accountId,
+		DisputeId: rand.Uint64(),
+	}
+
+	// T+1h: open dispute for the purchase
+	_, err = accountsCore.OpenDispute(&corepb.OpenDisputeRequest{
+		DisputeId:                disputeId,
+		TransactionId:            response1.Transaction.Id,
+		Amount:                   80,
+		ProvisionalTransactionId: rand.Uint64(),
+		Now:                      now.Add(time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+
+	// T+3h: archive past the opening of the dispute, only the topup is archived
+	response2, err := accountsCore.ArchiveTransactions(&corepb.ArchiveTransactionsRequest{
+		Before: now.Add(2 * time.Hour).UnixNano(),
+		Limit:  10,
+		Now:    now.Add(3 * time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+	require.EqualValues(1, response2.ArchivedTransactions)
+
+	// T+4h: resolve dispute against the account holder
+	response3, err := accountsCore.ResolveDispute(&corepb.ResolveDisputeRequest{
+		DisputeId:          disputeId,
+		Won:                false,
+		FinalTransactionId: rand.Uint64(),
+		Now:                now.Add(4 * time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+	require.Equal(corepb.DisputeStatus_DISPUTE_STATUS_LOST, response3.Dispute.Status)
+
+	// T+6h: dispute is resolved, its transactions can be archived now
+	response4, err := accountsCore.ArchiveTransactions(&corepb.ArchiveTransactionsRequest{
+		Before: now.Add(5 * time.Hour).UnixNano(),
+		Limit:  10,
+		Now:    now.Add(6 * time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+	require.EqualValues(3, response4.ArchivedTransactions)
+}
+
+func TestOpenDisputeForInvalidTransaction(t *testing.T) {
+	require := require.New(t)
+
+	accountsCore := newAccountsCore()
+
+	now := time.Now()
+
+	accountId := rand.Uint64()
+
+	// T+0: create account with a settled topup +100 and a pending purchase -10
+	_, err := accountsCore.CreateAccount(&corepb.CreateAccountRequest{
+		AccountId: accountId,
+		Now:       now.UnixNano(),
+	})
+	require.NoError(err)
+
+	response1, err := accountsCore.CreateTransaction(&corepb.CreateTransactionRequest{
+		TransactionId: &corepb.TransactionId{
+			AccountId:     accountId,
+			TransactionId: rand.Uint64(),
+		},
+		Now:     now.UnixNano(),
+		Amount:  100,
+		Settled: true,
+	})
+	require.NoError(err)
+
+	response2, err := accountsCore.CreateTransaction(&corepb.CreateTransactionRequest{
+		TransactionId: &corepb.TransactionId{
+			AccountId:     accountId,
+			TransactionId: rand.Uint64(),
+		},
+		Now:     now.UnixNano(),
+		Amount:  -10,
+		Settled: false,
+	})
+	require.NoError(err)
+
+	// T+1m: topups cannot be disputed
+	_, err = accountsCore.OpenDispute(&corepb.OpenDisputeRequest{
+		DisputeId: &corepb.DisputeId{
+			AccountId: accountId,
+			DisputeId: rand.Uint64(),
+		},
+		TransactionId:            response1.Transaction.Id,
+		ProvisionalTransactionId: rand.Uint64(),
+		Now:                      now.Add(time.Minute).UnixNano(),
+	})
+	require.Error(err)
+
+	// T+1m: pending purchases cannot be disputed
+	_, err = accountsCore.OpenDispute(&corepb.OpenDisputeRequest{
+		DisputeId: &corepb.DisputeId{
+			AccountId: accountId,
+			DisputeId: rand.Uint64(),
+		},
+		TransactionId:            response2.Transaction.Id,
+		ProvisionalTransactionId: rand.Uint64(),
+		Now:                      now.Add(time.Minute).UnixNano(),
+	})
+	require.Error(err)
+
+	// T+1m: nothing has changed
+	response3, err := accountsCore.ListDisputes(&corepb.ListDisputesRequest{
+		AccountId: accountId,
+	})
+	require.NoError(err)
+	require.Empty(response3.Disputes)
+}
+
+func TestValueDatedTopup(t *testing.T) {
+	require := require.New(t)
+
+	accountsCore := newAccountsCore()
+
+	now := time.Now()
+
+	accountId := rand.Uint64()
+
+	// T+0: create account
+	_, err := accountsCore.CreateAccount(&corepb.CreateAccountRequest{
+		AccountId: accountId,
+		Now:       now.UnixNano(),
+	})
+	require.NoError(err)
+
+	// T+1m: create settled topup +100 available at T+48h
+	response1, err := accountsCore.CreateTransaction(&corepb.CreateTransactionRequest{
+		TransactionId: &corepb.TransactionId{
+			AccountId:     accountId,
+			TransactionId: rand.Uint64(),
+		},
+		Now:         now.Add(time.Minute).UnixNano(),
+		Amount:      100,
+		Settled:     true,
+		AvailableAt: now.Add(48 * time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+	require.Equal(corepb.TransactionStatus_TRANSACTION_STATUS_SETTLED, response1.Transaction.Status)
+	require.EqualValues(now.Add(48*time.Hour).UnixNano(), response1.Transaction.AvailableAt)
+
+	// T+1m: get account
+	response2, err := accountsCore.GetAccount(&corepb.GetAccountRequest{
+		AccountId: accountId,
+		Now:       now.Add(time.Minute).UnixNano(),
+	})
+	require.NoError(err)
+	require.EqualValues(0, response2.Account.AvailableBalance)
+	require.EqualValues(100, response2.Account.SettledBalance)
+	require.EqualValues(100, response2.Account.PendingAvailabilityBalance)
+
+	// T+2m: settled funds cannot be spent before the value date
+	response3, err := accountsCore.CreateTransaction(&corepb.CreateTransactionRequest{
+		TransactionId: &corepb.TransactionId{
+			AccountId:     accountId,
+			TransactionId: rand.Uint64(),
+		},
+		Now:     now.Add(2 * time.Minute).UnixNano(),
+		Amount:  -10,
+		Settled: true,
+	})
+	require.NoError(err)
+	require.Equal(corepb.TransactionStatus_TRANSACTION_STATUS_INSUFFICIENT_FUNDS, response3.Transaction.Status)
+
+	// T+48h: get account, funds are available
+	response4, err := accountsCore.GetAccount(&corepb.GetAccountRequest{
+		AccountId: accountId,
+		Now:       now.Add(48 * time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+	require.EqualValues(100, response4.Account.AvailableBalance)
+	require.EqualValues(100, response4.Account.SettledBalance)
+	require.EqualValues(0, response4.Account.PendingAvailabilityBalance)
+
+	// T+49h: spend available funds
+	response5, err := accountsCore.CreateTransaction(&corepb.CreateTransactionRequest{
+		TransactionId: &corepb.TransactionId{
+			AccountId:     accountId,
+			TransactionId: rand.Uint64(),
+		},
+		Now:     now.Add(49 * time.Hour).UnixNano(),
+		Amount:  -10,
+		Settled: true,
+	})
+	require.NoError(err)
+	require.Equal(corepb.TransactionStatus_TRANSACTION_STATUS_SETTLED, response5.Transaction.Status)
+
+	// T+49h: get account
+	response6, err := accountsCore.GetAccount(&corepb.GetAccountRequest{
+		AccountId: accountId,
+		Now:       now.Add(49 * time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+	require.EqualValues(90, response6.Account.AvailableBalance)
+	require.EqualValues(90, response6.Account.SettledBalance)
+	require.EqualValues(0, response6.Account.PendingAvailabilityBalance)
+
+	// T+49h: funds released by the spending are written to the outbox
+	response7, err := accountsCore.ReadOutbox(&corepb.ReadOutboxRequest{
+		Limit: 10,
+	})
+	require.NoError(err)
+	released := make([]*corepb.OutboxRecord, 0)
+	for _, record := range response7.Records {
+		if record.Type == corepb.OutboxEventType_OUTBOX_EVENT_TYPE_FUNDS_RELEASED {
+			released = append(released, record)
+		}
+	}
+	require.Len(released, 1)
+	require.Equal(response1.Transaction.Id.TransactionId, released[0].Transaction.Id.TransactionId)
+	require.EqualValues(100, released[0].Account.AvailableBalance)
+}
+
+func TestSettleValueDatedTopupAndSweep(t *testing.T) {
+	require := require.New(t)
+
+	accountsCore := newAccountsCore()
+
+	now := time.Now()
+
+	accountId := rand.Uint64()
+
+	// T+0: create account
+	_, err := accountsCore.CreateAccount(&corepb.CreateAccountRequest{
+		AccountId: accountId,
+		Now:       now.UnixNano(),
+	})
+	require.NoError(err)
+
+	// T+1m: create pending topup +100 available at T+48h
+	response1, err := accountsCore.CreateTransaction(&corepb.CreateTransactionRequest{
+		TransactionId: &corepb.TransactionId{
+			AccountId:     accountId,
+			TransactionId: rand.Uint64(),
+		},
+		Now:         now.Add(time.Minute).UnixNano(),
+		Amount:      100,
+		Settled:     false,
+		AvailableAt: now.Add(48 * time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+
+	// T+2m: settle topup
+	_, err = accountsCore.SettleTransaction(&corepb.SettleTransactionRequest{
+		TransactionId: response1.Transaction.Id,
+		Now:           now.Add(2 * time.Minute).UnixNano(),
+	})
+	require.NoError(err)
+
+	// T+2m: get account
+	response2, err := accountsCore.GetAccount(&corepb.GetAccountRequest{
+		AccountId: accountId,
+		Now:       now.Add(2 * time.Minute).UnixNano(),
+	})
+	require.NoError(err)
+	require.EqualValues(0, response2.Account.AvailableBalance)
+	require.EqualValues(100, response2.Account.SettledBalance)
+	require.EqualValues(100, response2.Account.PendingAvailabilityBalance)
+
+	// T+1h: sweep, nothing is available yet
+	response3, err := accountsCore.SweepPendingAvailability(&corepb.SweepPendingAvailabilityRequest{
+		Now:   now.Add(time.Hour).UnixNano(),
+		Limit: 10,
+	})
+	require.NoError(err)
+	require.EqualValues(0, response3.ReleasedTransactions)
+
+	// T+48h: sweep
+	response4, err := accountsCore.SweepPendingAvailability(&corepb.SweepPendingAvailabilityRequest{
+		Now:   now.Add(48 * time.Hour).UnixNano(),
+		Limit: 10,
+	})
+	require.NoError(err)
+	require.EqualValues(1, response4.ReleasedTransactions)
+
+	// T+2m: get account, storage is updated by the sweep
+	response5, err := accountsCore.GetAccount(&corepb.GetAccountRequest{
+		AccountId: accountId,
+		Now:       now.Add(2 * time.Minute).UnixNano(),
+	})
+	require.NoError(err)
+	require.EqualValues(100, response5.Account.AvailableBalance)
+	require.EqualValues(100, response5.Account.SettledBalance)
+	require.EqualValues(0, response5.Account.PendingAvailabilityBalance)
+	require.EqualValues(now.Add(48*time.Hour).UnixNano(), response5.Account.UpdatedAt)
+
+	// T+49h: sweep again, nothing left
+	response6, err := accountsCore.SweepPendingAvailability(&corepb.SweepPendingAvailabilityRequest{
+		Now:   now.Add(49 * time.Hour).UnixNano(),
+		Limit: 10,
+	})
+	require.NoError(err)
+	require.EqualValues(0, response6.ReleasedTransactions)
+}
+
+func TestLowBalanceAlerts(t *testing.T) {
+	require := require.New(t)
+
+	accountsCore := newAccountsCore()
+
+	now := time.Now()
+
+	accountId := rand.Uint64()
+
+	// T+0: create account with a settled topup +100
+	_, err := accountsCore.CreateAccount(&corepb.CreateAccountRequest{
+		AccountId: accountId,
+		Now:       now.UnixNano(),
+	})
+	require.NoError(err)
+
+	_, err = accountsCore.CreateTransaction(&corepb.CreateTransactionRequest{
+		TransactionId: &corepb.TransactionId{
+			AccountId:     accountId,
+			TransactionId: rand.Uint64(),
+		},
+		Now:     now.UnixNano(),
+		Amount:  100,
+		Settled: true,
+	})
+	require.NoError(err)
+
+	// T+1m: create alert rule for available balance dropping below 50
+	response1, err := accountsCore.CreateAlertRule(&corepb.CreateAlertRuleRequest{
+		AlertRuleId: &corepb.AlertRuleId{
+			AccountId:   accountId,
+			AlertRuleId: rand.Uint64(),
+		},
+		Threshold: 50,
+		Direction: corepb.AlertDirection_ALERT_DIRECTION_BELOW,
+		Now:       now.Add(time.Minute).UnixNano(),
+	})
+	require.NoError(err)
+	require.EqualValues(50, response1.AlertRule.Threshold)
+
+	// T+2m: create pending purchase -30, balance stays above the threshold
+	_, err = accountsCore.CreateTransaction(&corepb.CreateTransactionRequest{
+		TransactionId: &corepb.TransactionId{
+			AccountId:     accountId,
+			TransactionId: rand.Uint64(),
+		},
+		Now:     now.Add(2 * time.Minute).UnixNano(),
+		Amount:  -30,
+		Settled: false,
+	})
+	require.NoError(err)
+
+	// T+2m: no alerts
+	response2, err := accountsCore.ListTriggeredAlerts(&corepb.ListTriggeredAlertsRequest{
+		AccountId: accountId,
+	})
+	require.NoError(err)
+	require.Empty(response2.TriggeredAlerts)
+
+	// T+3m: create pending purchase -30, balance drops below the threshold
+	response3, err := accountsCore.CreateTransaction(&corepb.CreateTransactionRequest{
+		TransactionId: &corepb.TransactionId{
+			AccountId:     accountId,
+			TransactionId: rand.Uint64(),
+		},
+		Now:     now.Add(3 * time.Minute).UnixNano(),
+		Amount:  -30,
+		Settled: false,
+	})
+	require.NoError(err)
+
+	// T+4m: settle it, balance stays below the threshold
+	_, err = accountsCore.SettleTransaction(&corepb.SettleTransactionRequest{
+		TransactionId: response3.Transaction.Id,
+		Now:           now.Add(4 * time.Minute).UnixNano(),
+	})
+	require.NoError(err)
+
+	// T+4m: one alert is triggered
+	response4, err := accountsCore.ListTriggeredAlerts(&corepb.ListTriggeredAlertsRequest{
+		AccountId:          accountId,
+		UnacknowledgedOnly: true,
+	})
+	require.NoError(err)
+	require.Len(response4.TriggeredAlerts, 1)
+	require.EqualValues(40, response4.TriggeredAlerts[0].AvailableBalance)
+	require.EqualValues(now.Add(3*time.Minute).UnixNano(), response4.TriggeredAlerts[0].TriggeredAt)
+	require.Equal(response3.Transaction.Id.TransactionId, response4.TriggeredAlerts[0].TransactionId.TransactionId)
+	require.Equal(response1.AlertRule.Id.AlertRuleId, response4.TriggeredAlerts[0].AlertRuleId.AlertRuleId)
+	require.False(response4.TriggeredAlerts[0].Acknowledged)
+
+	// T+5m: ack alert
+	response5, err := accountsCore.AckAlert(&corepb.AckAlertRequest{
+		TriggeredAlertId: response4.TriggeredAlerts[0].Id,
+		Now:              now.Add(5 * time.Minute).UnixNano(),
+	})
+	require.NoError(err)
+	require.True(response5.TriggeredAlert.Acknowledged)
+	require.EqualValues(now.Add(5*time.Minute).UnixNano(), response5.TriggeredAlert.AcknowledgedAt)
+
+	// T+6m: topup +100, balance goes back above the threshold
+	_, err = accountsCore.CreateTransaction(&corepb.CreateTransactionRequest{
+		TransactionId: &corepb.TransactionId{
+			AccountId:     accountId,
+			TransactionId: rand.Uint64(),
+		},
+		Now:     now.Add(6 * time.Minute).UnixNano(),
+		Amount:  100,
+		Settled: true,
+	})
+	require.NoError(err)
+
+	// T+7m: settled purchase -120, balance drops below the threshold again
+	_, err = accountsCore.CreateTransaction(&corepb.CreateTransactionRequest{
+		TransactionId: &corepb.TransactionId{
+			AccountId:     accountId,
+			TransactionId: rand.Uint64(),
+		},
+		Now:     now.Add(7 * time.Minute).UnixNano(),
+		Amount:  -120,
+		Settled: true,
+	})
+	require.NoError(err)
+
+	// T+7m: only the new alert is unacknowledged
+	response6, err := accountsCore.ListTriggeredAlerts(&corepb.ListTriggeredAlertsRequest{
+		AccountId:          accountId,
+		UnacknowledgedOnly: true,
+	})
+	require.NoError(err)
+	require.Len(response6.TriggeredAlerts, 1)
+	require.EqualValues(20, response6.TriggeredAlerts[0].AvailableBalance)
+
+	response7, err := accountsCore.ListTriggeredAlerts(&corepb.ListTriggeredAlertsRequest{
+		AccountId: accountId,
+	})
+	require.NoError(err)
+	require.Len(response7.TriggeredAlerts, 2)
+
+	// T+8m: delete alert rule
+	_, err = accountsCore.DeleteAlertRule(&corepb.DeleteAlertRuleRequest{
+		AlertRuleId: response1.AlertRule.Id,
+	})
+	require.NoError(err)
+
+	response8, err := accountsCore.ListAlertRules(&corepb.ListAlertRulesRequest{
+		AccountId: accountId,
+	})
+	require.NoError(err)
+	require.Empty(response8.AlertRules)
+
+	// T+9m: deleting it again fails
+	_, err = accountsCore.DeleteAlertRule(&corepb.DeleteAlertRuleRequest{
+		AlertRuleId: response1.AlertRule.Id,
+		Now:         now.Add(9 * time.Minute).UnixNano(),
+	})
+	require.Error(err)
+}
+
+func TestOutbox(t *testing.T) {
+	require := require.New(t)
+
+	accountsCore := newAccountsCore()
+
+	now := time.Now()
+
+	accountId := rand.Uint64()
+
+	// T+0: create account
+	_, err := accountsCore.CreateAccount(&corepb.CreateAccountRequest{
+		AccountId: accountId,
+		Now:       now.UnixNano(),
+	})
+	require.NoError(err)
+
+	// T+1m: create pending topup +100
+	response1, err := accountsCore.CreateTransaction(&corepb.CreateTransactionRequest{
+		TransactionId: &corepb.TransactionId{
+			AccountId:     accountId,
+			TransactionId: rand.Uint64(),
+		},
+		Now:     now.Add(time.Minute).UnixNano(),
+		Amount:  100,
+		Settled: false,
+	})
+	require.NoError(err)
+
+	// T+2m: settle it
+	_, err = accountsCore.SettleTransaction(&corepb.SettleTransactionRequest{
+		TransactionId: response1.Transaction.Id,
+		Now:           now.Add(2 * time.Minute).UnixNano(),
+	})
+	require.NoError(err)
+
+	// T+2m: failed update does not write to outbox
+	_, err = accountsCore.SettleTransaction(&corepb.SettleTransactionRequest{
+		TransactionId: response1.Transaction.Id,
+		Now:           now.Add(2 * time.Minute).UnixNano(),
+	})
+	require.Error(err)
+
+	// T+3m: outbox contains all changes in order
+	response2, err := accountsCore.ReadOutbox(&corepb.ReadOutboxRequest{
+		Limit: 10,
+	})
+	require.NoError(err)
+	require.Len(response2.Records, 3)
+
+	require.EqualValues(1, response2.Records[0].Id)
+	require.Equal(corepb.OutboxEventType_OUTBOX_EVENT_TYPE_ACCOUNT_CREATED, response2.Records[0].Type)
+	require.Equal(accountId, response2.Records[0].Account.Id)
+
+	require.EqualValues(2, response2.Records[1].Id)
+	require.Equal(corepb.OutboxEventType_OUTBOX_EVENT_TYPE_TRANSACTION_CREATED, response2.Records[1].Type)
+	require.Equal(corepb.TransactionStatus_TRANSACTION_STATUS_PENDING, response2.Records[1].Transaction.Status)
+
+	require.EqualValues(3, response2.Records[2].Id)
+	require.Equal(corepb.OutboxEventType_OUTBOX_EVENT_TYPE_TRANSACTION_SETTLED, response2.Records[2].Type)
+	require.Equal(corepb.TransactionStatus_TRANSACTION_STATUS_SETTLED, response2.Records[2].Transaction.Status)
+	require.EqualValues(100, response2.Records[2].Account.SettledBalance)
+	require.EqualValues(now.Add(2*time.Minute).UnixNano(), response2.Records[2].CreatedAt)
+
+	// T+3m: limit is respected
+	response3, err := accountsCore.ReadOutbox(&corepb.ReadOutboxRequest{
+		Limit: 2,
+	})
+	require.NoError(err)
+	require.Len(response3.Records, 2)
+
+	// T+3m: ack first two records
+	_, err = accountsCore.AckOutbox(&corepb.AckOutboxRequest{
+		UpToId: 2,
+	})
+	require.NoError(err)
+
+	// T+3m: only the last record is left
+	response4, err := accountsCore.ReadOutbox(&corepb.ReadOutboxRequest{
+		Limit: 10,
+	})
+	require.NoError(err)
+	require.Len(response4.Records, 1)
+	require.EqualValues(3, response4.Records[0].Id)
+
+	// T+3m: ack everything, ids keep growing after the outbox is empty
+	_, err = accountsCore.AckOutbox(&corepb.AckOutboxRequest{
+		UpToId: 3,
+	})
+	require.NoError(err)
+
+	_, err = accountsCore.CreateAlertRule(&corepb.CreateAlertRuleRequest{
+		AlertRuleId: &corepb.AlertRuleId{
+			AccountId:   accountId,
+			AlertRuleId: rand.Uint64(),
+		},
+		Threshold: 50,
+		Direction: corepb.AlertDirection_ALERT_DIRECTION_BELOW,
+		Now:       now.Add(3 * time.Minute).UnixNano(),
+	})
+	require.NoError(err)
+
+	response5, err := accountsCore.ReadOutbox(&corepb.ReadOutboxRequest{
+		Limit: 10,
+	})
+	require.NoError(err)
+	require.Len(response5.Records, 1)
+	require.EqualValues(4, response5.Records[0].Id)
+	require.Equal(corepb.OutboxEventType_OUTBOX_EVENT_TYPE_ALERT_RULE_CREATED, response5.Records[0].Type)
+}
+
+func TestSpendingSummary(t *testing.T) {
+	require := require.New(t)
+
+	accountsCore := newAccountsCore()
+
+	now := time.Date(2025, 1, 31, 23, 0, 0, 0, time.UTC)
+
+	accountId := rand.Uint64()
+
+	createTransaction := func(now time.Time, amount int64, category string, settled bool) *corepb.Transaction {
+		response, err := accountsCore.CreateTransaction(&corepb.CreateTransactionRequest{
+			TransactionId: &corepb.TransactionId{
+				AccountId:     accountId,
+				TransactionId: rand.Uint64(),
+			},
+			Now:      now.UnixNano(),
+			Amount:   amount,
+			Category: category,
+			Settled:  settled,
+		})
+		require.NoError(err)
+		return response.Transaction
+	}
+
+	// T+0: create account with a settled topup +1000 (topups are not spending)
+	_, err := accountsCore.CreateAccount(&corepb.CreateAccountRequest{
+		AccountId: accountId,
+		Now:       now.UnixNano(),
+	})
+	require.NoError(err)
+
+	createTransaction(now, 1000, "salary", true)
+
+	// T+0: January purchases
+	createTransaction(now, -100, "groceries", true)
+	transaction1 := createTransaction(now, -50, "groceries", false)
+	transaction2 := createTransaction(now, -30, "travel", false)
+
+	// T+0: purchase with insufficient funds is not spending
+	createTransaction(now, -10000, "travel", true)
+
+	// T+2h: February purchase
+	createTransaction(now.Add(2*time.Hour), -20, "groceries", true)
+
+	// T+3h: settle January groceries, it stays in January
+	_, err = accountsCore.SettleTransaction(&corepb.SettleTransactionRequest{
+		TransactionId: transaction1.Id,
+		Now:           now.Add(3 * time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+
+	// T+3h: cancel January travel
+	_, err = accountsCore.CancelTransaction(&corepb.CancelTransactionRequest{
+		TransactionId: transaction2.Id,
+		Now:           now.Add(3 * time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+
+	// T+3h: all months
+	response1, err := accountsCore.GetSpendingSummary(&corepb.GetSpendingSummaryRequest{
+		AccountId: accountId,
+	})
+	require.NoError(err)
+	require.Len(response1.Aggregates, 2)
+
+	require.Equal("2025-01", response1.Aggregates[0].Month)
+	require.Equal("groceries", response1.Aggregates[0].Category)
+	require.EqualValues(150, response1.Aggregates[0].SettledAmount)
+	require.EqualValues(2, response1.Aggregates[0].SettledCount)
+	require.EqualValues(0, response1.Aggregates[0].PendingAmount)
+	require.EqualValues(0, response1.Aggregates[0].PendingCount)
+
+	require.Equal("2025-02", response1.Aggregates[1].Month)
+	require.Equal("groceries", response1.Aggregates[1].Category)
+	require.EqualValues(20, response1.Aggregates[1].SettledAmount)
+	require.EqualValues(1, response1.Aggregates[1].SettledCount)
+
+	// T+3h: February only
+	response2, err := accountsCore.GetSpendingSummary(&corepb.GetSpendingSummaryRequest{
+		AccountId: accountId,
+		FromMonth: "2025-02",
+		ToMonth:   "2025-12",
+	})
+	require.NoError(err)
+	require.Len(response2.Aggregates, 1)
+	require.Equal("2025-02", response2.Aggregates[0].Month)
+
+	// T+4h: pending purchase is counted as pending
+	createTransaction(now.Add(4*time.Hour), -5, "", false)
+
+	response3, err := accountsCore.GetSpendingSummary(&corepb.GetSpendingSummaryRequest{
+		AccountId: accountId,
+		FromMonth: "2025-02",
+		ToMonth:   "2025-02",
+	})
+	require.NoError(err)
+	require.Len(response3.Aggregates, 2)
+	require.Equal("", response3.Aggregates[0].Category)
+	require.EqualValues(5, response3.Aggregates[0].PendingAmount)
+	require.EqualValues(1, response3.Aggregates[0].PendingCount)
+	require.EqualValues(0, response3.Aggregates[0].SettledCount)
+}
+
+func TestArchiveTransactions(t *testing.T) {
+	require := require.New(t)
+
+	accountsCore := newAccountsCore()
+
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	accountId := rand.Uint64()
+
+	createTransaction := func(now time.Time, amount int64, settled bool, availableAt int64) *corepb.Transaction {
+		response, err := accountsCore.CreateTransaction(&corepb.CreateTransactionRequest{
+			TransactionId: &corepb.TransactionId{
+				AccountId:     accountId,
+				TransactionId: rand.Uint64(),
+			},
+			Now:         now.UnixNano(),
+			Amount:      amount,
+			Settled:     settled,
+			AvailableAt: availableAt,
+		})
+		require.NoError(err)
+		return response.Transaction
+	}
+
+	_, err := accountsCore.CreateAccount(&corepb.CreateAccountRequest{
+		AccountId: accountId,
+		Now:       now.UnixNano(),
+	})
+	require.NoError(err)
+
+	// T+0: settled topup +100, value-dated topup +50 available at T+3h, and three pending purchases
+	createTransaction(now, 100, true, 0)
+	createTransaction(now, 50, true, now.Add(3*time.Hour).UnixNano())
+	transaction1 := createTransaction(now, -30, false, 0)
+	transaction2 := createTransaction(now, -10, false, 0)
+	createTransaction(now, -5, false, 0)
+
+	// T+1h: settle the first purchase and cancel the second one
+	_, err = accountsCore.SettleTransaction(&corepb.SettleTransactionRequest{
+		TransactionId: transaction1.Id,
+		Now:           now.Add(time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+
+	_, err = accountsCore.CancelTransaction(&corepb.CancelTransactionRequest{
+		TransactionId: transaction2.Id,
+		Now:           now.Add(time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+
+	// T+5h30m: settled topup +20 after the cutoff
+	createTransaction(now.Add(5*time.Hour+30*time.Minute), 20, true, 0)
+
+	cutoff := now.Add(5 * time.Hour).UnixNano()
+
+	// T+6h: balance before archiving
+	response1, err := accountsCore.GetAccount(&corepb.GetAccountRequest{
+		AccountId: accountId,
+		Now:       now.Add(6 * time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+	require.EqualValues(135, response1.Account.AvailableBalance)
+	require.EqualValues(140, response1.Account.SettledBalance)
+
+	// T+6h: list archivable transactions in pages (this is what gets exported)
+	response2, err := accountsCore.ListArchivableTransactions(&corepb.ListArchivableTransactionsRequest{
+		Before: cutoff,
+		Limit:  3,
+		Now:    now.Add(6 * time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+	require.Len(response2.Transactions, 3)
+
+	response3, err := accountsCore.ListArchivableTransactions(&corepb.ListArchivableTransactionsRequest{
+		Before: cutoff,
+		After:  response2.Transactions[2].Id,
+		Limit:  3,
+		Now:    now.Add(6 * time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+	require.Len(response3.Transactions, 1)
+
+	// T+6h: cutoff can not be in the future
+	_, err = accountsCore.ListArchivableTransactions(&corepb.ListArchivableTransactionsRequest{
+		Before: now.Add(7 * time.Hour).UnixNano(),
+		Limit:  3,
+		Now:    now.Add(6 * time.Hour).UnixNano(),
+	})
+	require.Error(err)
+
+	_, err = accountsCore.ArchiveTransactions(&corepb.ArchiveTransactionsRequest{
+		Before: now.Add(7 * time.Hour).UnixNano(),
+		Limit:  3,
+		Now:    now.Add(6 * time.Hour).UnixNano(),
+	})
+	require.Error(err)
+
+	// T+6h: limit is required
+	_, err = accountsCore.ArchiveTransactions(&corepb.ArchiveTransactionsRequest{
+		Before: cutoff,
+		Now:    now.Add(6 * time.Hour).UnixNano(),
+	})
+	require.Error(err)
+
+	// T+6h: archive in batches
+	response4, err := accountsCore.ArchiveTransactions(&corepb.ArchiveTransactionsRequest{
+		Before: cutoff,
+		Limit:  3,
+		Now:    now.Add(6 * time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+	require.EqualValues(3, response4.ArchivedTransactions)
+
+	response5, err := accountsCore.ArchiveTransactions(&corepb.ArchiveTransactionsRequest{
+		Before: cutoff,
+		Limit:  3,
+		Now:    now.Add(6 * time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+	require.EqualValues(1, response5.ArchivedTransactions)
+
+	response6, err := accountsCore.ArchiveTransactions(&corepb.ArchiveTransactionsRequest{
+		Before: cutoff,
+		Limit:  3,
+		Now:    now.Add(6 * time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+	require.EqualValues(0, response6.ArchivedTransactions)
+
+	// T+6h: only the pending purchase and the topup after the cutoff are left
+	response7, err := accountsCore.ListTransactions(&corepb.ListTransactionsRequest{
+		AccountId: accountId,
+	})
+	require.NoError(err)
+	require.Len(response7.Transactions, 2)
+
+	// T+6h: balance is the same, value-dated topup is released
+	response8, err := accountsCore.GetAccount(&corepb.GetAccountRequest{
+		AccountId: accountId,
+		Now:       now.Add(6 * time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+	require.EqualValues(135, response8.Account.AvailableBalance)
+	require.EqualValues(140, response8.Account.SettledBalance)
+	require.EqualValues(0, response8.Account.PendingAvailabilityBalance)
+
+	// T+6h: archived transactions are rolled up by month
+	response9, err := accountsCore.ListTransactionRollups(&corepb.ListTransactionRollupsRequest{
+		AccountId: accountId,
+	})
+	require.NoError(err)
+	require.Len(response9.Rollups, 1)
+	require.Equal("2025-03", response9.Rollups[0].Month)
+	require.EqualValues(150, response9.Rollups[0].TopupAmount)
+	require.EqualValues(2, response9.Rollups[0].TopupCount)
+	require.EqualValues(-30, response9.Rollups[0].PurchaseAmount)
+	require.EqualValues(1, response9.Rollups[0].PurchaseCount)
+	require.EqualValues(1, response9.Rollups[0].CancelledCount)
+
+	// T+6h: each batch is written to the outbox
+	response10, err := accountsCore.ReadOutbox(&corepb.ReadOutboxRequest{
+		Limit: 100,
+	})
+	require.NoError(err)
+	archived := make([]*corepb.OutboxRecord, 0)
+	for _, record := range response10.Records {
+		if record.Type == corepb.OutboxEventType_OUTBOX_EVENT_TYPE_TRANSACTIONS_ARCHIVED {
+			archived = append(archived, record)
+		}
+	}
+	require.Len(archived, 2)
+	require.Len(archived[0].ArchivedTransactions, 3)
+	require.Len(archived[1].ArchivedTransactions, 1)
+	require.Equal(accountId, archived[1].Account.Id)
+}
+
+func TestShardStats(t *testing.T) {
+	require := require.New(t)
+
+	accountsCore := newAccountsCore()
+
+	now := time.Now()
+
+	accountId1 := rand.Uint64()
+	accountId2 := rand.Uint64()
+
+	createTransaction := func(accountId uint64, now time.Time, amount int64, settled bool, availableAt int64) *corepb.Transaction {
+		response, err := accountsCore.CreateTransaction(&corepb.CreateTransactionRequest{
+			TransactionId: &corepb.TransactionId{
+				AccountId:     accountId,
+				TransactionId: rand.Uint64(),
+			},
+			Now:         now.UnixNano(),
+			Amount:      amount,
+			Settled:     settled,
+			AvailableAt: availableAt,
+		})
+		require.NoError(err)
+		return response.Transaction
+	}
+
+	// T+0: empty shard
+	response1, err := accountsCore.GetShardStats(&corepb.GetShardStatsRequest{})
+	require.NoError(err)
+	require.EqualValues(0, response1.Stats.AccountsCount)
+
+	// T+0: create two accounts
+	for _, accountId := range []uint64{accountId1, accountId2} {
+		_, err = accountsCore.CreateAccount(&corepb.CreateAccountRequest{
+			AccountId: accountId,
+			Now:       now.UnixNano(),
+		})
+		require.NoError(err)
+	}
+
+	// T+1m: topups, a value-dated topup available at T+1h, and pending purchases
+	createTransaction(accountId1, now.Add(time.Minute), 100, true, 0)
+	createTransaction(accountId2, now.Add(time.Minute), 40, true, now.Add(time.Hour).UnixNano())
+	transaction1 := createTransaction(accountId1, now.Add(time.Minute), -30, false, 0)
+	transaction2 := createTransaction(accountId1, now.Add(time.Minute), -20, false, 0)
+	createTransaction(accountId1, now.Add(time.Minute), -5, false, 0)
+
+	// T+2m: settle the first purchase and cancel the second one
+	_, err = accountsCore.SettleTransaction(&corepb.SettleTransactionRequest{
+		TransactionId: transaction1.Id,
+		Now:           now.Add(2 * time.Minute).UnixNano(),
+	})
+	require.NoError(err)
+
+	_, err = accountsCore.CancelTransaction(&corepb.CancelTransactionRequest{
+		TransactionId: transaction2.Id,
+		Now:           now.Add(2 * time.Minute).UnixNano(),
+	})
+	require.NoError(err)
+
+	// T+2m: running aggregates
+	response2, err := accountsCore.GetShardStats(&corepb.GetShardStatsRequest{})
+	require.NoError(err)
+	require.EqualValues(2, response2.Stats.AccountsCount)
+	require.EqualValues(65, response2.Stats.TotalAvailableBalance)
+	require.EqualValues(110, response2.Stats.TotalSettledBalance)
+	require.EqualValues(40, response2.Stats.TotalPendingAvailabilityBalance)
+	require.EqualValues(1, response2.Stats.PendingTransactionsCount)
+
+	// T+1h: sweep releases the value-dated topup
+	_, err = accountsCore.SweepPendingAvailability(&corepb.SweepPendingAvailabilityRequest{
+		Now:   now.Add(time.Hour).UnixNano(),
+		Limit: 10,
+	})
+	require.NoError(err)
+
+	response3, err := accountsCore.GetShardStats(&corepb.GetShardStatsRequest{})
+	require.NoError(err)
+	require.EqualValues(105, response3.Stats.TotalAvailableBalance)
+	require.EqualValues(110, response3.Stats.TotalSettledBalance)
+	require.EqualValues(0, response3.Stats.TotalPendingAvailabilityBalance)
+
+	// T+2h: rebuild from scratch gives the same aggregates
+	response4, err := accountsCore.RebuildShardStats(&corepb.RebuildShardStatsRequest{
+		Now: now.Add(2 * time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+	require.EqualValues(response3.Stats.AccountsCount, response4.Stats.AccountsCount)
+	require.EqualValues(response3.Stats.TotalAvailableBalance, response4.Stats.TotalAvailableBalance)
+	require.EqualValues(response3.Stats.TotalSettledBalance, response4.Stats.TotalSettledBalance)
+	require.EqualValues(response3.Stats.TotalPendingAvailabilityBalance, response4.Stats.TotalPendingAvailabilityBalance)
+	require.EqualValues(response3.Stats.PendingTransactionsCount, response4.Stats.PendingTransactionsCount)
+	require.EqualValues(now.Add(2*time.Hour).UnixNano(), response4.Stats.UpdatedAt)
+}
+
+func TestEscrow(t *testing.T) {
+	require := require.New(t)
+
+	accountsCore := newAccountsCore()
+
+	now := time.Now()
+
+	accountId := rand.Uint64()
+
+	// T+0: create account with a settled topup +100
+	_, err := accountsCore.CreateAccount(&corepb.CreateAccountRequest{
+		AccountId: accountId,
+		Now:       now.UnixNano(),
+	})
+	require.NoError(err)
+
+	_, err = accountsCore.CreateTransaction(&corepb.CreateTransactionRequest{
+		TransactionId: &corepb.TransactionId{
+			AccountId:     accountId,
+			TransactionId: rand.Uint64(),
+		},
+		Now:     now.UnixNano(),
+		Amount:  100,
+		Settled: true,
+	})
+	require.NoError(err)
+
+	hold := func(amount int64, autoReleaseAt time.Time, at time.Time) (*corepb.Escrow, error) {
+		var autoReleaseAtNano int64
+		if !autoReleaseAt.IsZero() {
+			autoReleaseAtNano = autoReleaseAt.UnixNano()
+		}
+		response, err := accountsCore.HoldEscrow(&corepb.HoldEscrowRequest{
+			EscrowId: &corepb.EscrowId{
+				AccountId: accountId,
+				EscrowId:  rand.Uint64(),
+			},
+			Name:                 "order",
+			Amount:               amount,
+			AutoReleaseAt:        autoReleaseAtNano,
+			ReleaseTransactionId: rand.Uint64(),
+			Now:                  at.UnixNano(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return response.Escrow, nil
+	}
+
+	requireBalances := func(available int64, settled int64, escrow int64) {
+		response, err := accountsCore.GetAccount(&corepb.GetAccountRequest{
+			AccountId: accountId,
+			Now:       now.UnixNano(),
+		})
+		require.NoError(err)
+		require.EqualValues(available, response.Account.AvailableBalance)
+		require.EqualValues(settled, response.Account.SettledBalance)
+		require.EqualValues(escrow, response.Account.EscrowBalance)
+	}
+
+	// T+1m: hold 3 escrows for 20, 30 and 40
+	escrow1, err := hold(20, time.Time{}, now.Add(time.Minute))
+	require.NoError(err)
+	require.Equal(corepb.EscrowStatus_ESCROW_STATUS_HELD, escrow1.Status)
+
+	escrow2, err := hold(30, time.Time{}, now.Add(time.Minute))
+	require.NoError(err)
+
+	escrow3, err := hold(40, now.Add(time.Hour), now.Add(time.Minute))
+	require.NoError(err)
+
+	requireBalances(10, 100, 90)
+
+	// T+1m: held funds can not be spent or held again
+	_, err = hold(20, time.Time{}, now.Add(time.Minute))
+	require.Error(err)
+
+	response1, err := accountsCore.CreateTransaction(&corepb.CreateTransactionRequest{
+		TransactionId: &corepb.TransactionId{
+			AccountId:     accountId,
+			TransactionId: rand.Uint64(),
+		},
+		Now:     now.Add(time.Minute).UnixNano(),
+		Amount:  -20,
+		Settled: true,
+	})
+	require.NoError(err)
+	require.Equal(corepb.TransactionStatus_TRANSACTION_STATUS_INSUFFICIENT_FUNDS, response1.Transaction.Status)
+
+	// T+2m: release escrow1 in full, settled balance is debited
+	response2, err := accountsCore.ReleaseEscrow(&corepb.ReleaseEscrowRequest{
+		EscrowId:       escrow1.Id,
+		ReleasedAmount: 20,
+		Now:            now.Add(2 * time.Minute).UnixNano(),
+	})
+	require.NoError(err)
+	require.Equal(corepb.EscrowStatus_ESCROW_STATUS_RELEASED, response2.Escrow.Status)
+	requireBalances(10, 80, 70)
+
+	response3, err := accountsCore.GetTransaction(&corepb.GetTransactionRequest{
+		TransactionId: escrow1.ReleaseTransactionId,
+	})
+	require.NoError(err)
+	require.EqualValues(-20, response3.Transaction.Amount)
+	require.Equal(corepb.TransactionStatus_TRANSACTION_STATUS_SETTLED, response3.Transaction.Status)
+
+	// T+2m: escrow1 can not be released again
+	_, err = accountsCore.ReleaseEscrow(&corepb.ReleaseEscrowRequest{
+		EscrowId:       escrow1.Id,
+		ReleasedAmount: 0,
+		Now:            now.Add(2 * time.Minute).UnixNano(),
+	})
+	require.Error(err)
+
+	// T+3m: split escrow2, 10 is released and 20 is refunded
+	_, err = accountsCore.ReleaseEscrow(&corepb.ReleaseEscrowRequest{
+		EscrowId:       escrow2.Id,
+		ReleasedAmount: 31,
+		Now:            now.Add(3 * time.Minute).UnixNano(),
+	})
+	require.Error(err)
+
+	response4, err := accountsCore.ReleaseEscrow(&corepb.ReleaseEscrowRequest{
+		EscrowId:       escrow2.Id,
+		ReleasedAmount: 10,
+		Now:            now.Add(3 * time.Minute).UnixNano(),
+	})
+	require.NoError(err)
+	require.Equal(corepb.EscrowStatus_ESCROW_STATUS_SPLIT, response4.Escrow.Status)
+	require.EqualValues(10, response4.Escrow.ReleasedAmount)
+	require.EqualValues(20, response4.Escrow.RefundedAmount)
+	requireBalances(30, 70, 40)
+
+	// T+30m: sweep before the deadline of escrow3 does nothing
+	response5, err := accountsCore.SweepEscrows(&corepb.SweepEscrowsRequest{
+		Now:   now.Add(30 * time.Minute).UnixNano(),
+		Limit: 10,
+	})
+	require.NoError(err)
+	require.EqualValues(0, response5.ReleasedEscrows)
+
+	// T+2h: escrow3 can not be released by the caller after its deadline
+	_, err = accountsCore.ReleaseEscrow(&corepb.ReleaseEscrowRequest{
+		EscrowId:       escrow3.Id,
+		ReleasedAmount: 0,
+		Now:            now.Add(2 * time.Hour).UnixNano(),
+	})
+	require.Error(err)
+
+	// T+2h: sweep auto-releases escrow3 in full
+	response6, err := accountsCore.SweepEscrows(&corepb.SweepEscrowsRequest{
+		Now:   now.Add(2 * time.Hour).UnixNano(),
+		Limit: 10,
+	})
+	require.NoError(err)
+	require.EqualValues(1, response6.ReleasedEscrows)
+	requireBalances(30, 30, 0)
+
+	response7, err := accountsCore.GetEscrow(&corepb.GetEscrowRequest{
+		EscrowId: escrow3.Id,
+	})
+	require.NoError(err)
+	require.Equal(corepb.EscrowStatus_ESCROW_STATUS_RELEASED, response7.Escrow.Status)
+	require.True(response7.Escrow.AutoReleased)
+
+	// T+3h: nothing left to sweep
+	response8, err := accountsCore.SweepEscrows(&corepb.SweepEscrowsRequest{
+		Now:   now.Add(3 * time.Hour).UnixNano(),
+		Limit: 10,
+	})
+	require.NoError(err)
+	require.EqualValues(0, response8.ReleasedEscrows)
+
+	// list escrows
+	response9, err := accountsCore.ListEscrows(&corepb.ListEscrowsRequest{
+		AccountId: accountId,
+	})
+	require.NoError(err)
+	require.Len(response9.Escrows, 3)
+}
+
+func TestEscrowSnapshotAndRestore(t *testing.T) {
+	require := require.New(t)
+
+	accountsCore1 := newAccountsCore()
+
+	now := time.Now()
+
+	accountId := rand.Uint64()
+
+	_, err := accountsCore1.CreateAccount(&corepb.CreateAccountRequest{
+		AccountId: accountId,
+		Now:       now.UnixNano(),
+	})
+	require.NoError(err)
+
+	_, err = accountsCore1.CreateTransaction(&corepb.CreateTransactionRequest{
+		TransactionId: &corepb.TransactionId{
+			AccountId:     accountId,
+			TransactionId: rand.Uint64(),
+		},
+		Now:     now.UnixNano(),
+		Amount:  100,
+		Settled: true,
+	})
+	require.NoError(err)
+
+	escrowId := &corepb.EscrowId{
+		AccountId: accountId,
+		EscrowId:  rand.Uint64(),
+	}
+
+	_, err = accountsCore1.HoldEscrow(&corepb.HoldEscrowRequest{
+		EscrowId:             escrowId,
+		Name:                 "order",
+		Amount:               60,
+		AutoReleaseAt:        now.Add(time.Hour).UnixNano(),
+		ReleaseTransactionId: rand.Uint64(),
+		Now:                  now.UnixNano(),
+	})
+	require.NoError(err)
+
+	// snapshot and restore into another core
+	snapshot := accountsCore1.Snapshot()
+	defer snapshot.Release()
+
+	buf := bytes.Buffer{}
+	require.NoError(snapshot.Write(&buf))
+
+	accountsCore2 := newAccountsCore()
+	require.NoError(accountsCore2.Restore(io.NopCloser(&buf)))
+
+	// escrow and held balance are restored
+	response1, err := accountsCore2.GetEscrow(&corepb.GetEscrowRequest{
+		EscrowId: escrowId,
+	})
+	require.NoError(err)
+	require.Equal(corepb.EscrowStatus_ESCROW_STATUS_HELD, response1.Escrow.Status)
+	require.EqualValues(60, response1.Escrow.Amount)
+
+	response2, err := accountsCore2.GetAccount(&corepb.GetAccountRequest{
+		AccountId: accountId,
+		Now:       now.UnixNano(),
+	})
+	require.NoError(err)
+	require.EqualValues(40, response2.Account.AvailableBalance)
+	require.EqualValues(60, response2.Account.EscrowBalance)
+
+	// auto-release deadline is restored as well
+	response3, err := accountsCore2.SweepEscrows(&corepb.SweepEscrowsRequest{
+		Now:   now.Add(2 * time.Hour).UnixNano(),
+		Limit: 10,
+	})
+	require.NoError(err)
+	require.EqualValues(1, response3.ReleasedEscrows)
+
+	response4, err := accountsCore2.GetAccount(&corepb.GetAccountRequest{
+		AccountId: accountId,
+		Now:       now.Add(2 * time.Hour).UnixNano(),
+	})
+	require.NoError(err)
+	require.EqualValues(40, response4.Account.AvailableBalance)
+	require.EqualValues(40, response4.Account.SettledBalance)
+	require.EqualValues(0, response4.Account.EscrowBalance)
+}
+
 func newAccountsCore() *AccountsCore {
 	return NewAccountsCore(monstera.NewBadgerInMemoryStore(), []byte{0x00, 0x00}, []byte{0xff, 0xff})
 }
@@ -58,6 +58,54 @@ func (a *AccountsCoreAdapter) Update(request []byte) []byte {
 		r, err := a.accountsCore.CreateAccount(req.CreateAccountRequest)
 		updateResponse.Response = &corepb.UpdateResponse_CreateAccountResponse{CreateAccountResponse: r}
 		updateResponse.Error = monsterax.WrapError(err)
+	case *corepb.UpdateRequest_OpenDisputeRequest:
+		r, err := a.accountsCore.OpenDispute(req.OpenDisputeRequest)
+		updateResponse.Response = &corepb.UpdateResponse_OpenDisputeResponse{OpenDisputeResponse: r}
+		updateResponse.Error = monsterax.WrapError(err)
+	case *corepb.UpdateRequest_ResolveDisputeRequest:
+		r, err := a.accountsCore.ResolveDispute(req.ResolveDisputeRequest)
+		updateResponse.Response = &corepb.UpdateResponse_ResolveDisputeResponse{ResolveDisputeResponse: r}
+		updateResponse.Error = monsterax.WrapError(err)
+	case *corepb.UpdateRequest_SweepPendingAvailabilityRequest:
+		r, err := a.accountsCore.SweepPendingAvailability(req.SweepPendingAvailabilityRequest)
+		updateResponse.Response = &corepb.UpdateResponse_SweepPendingAvailabilityResponse{SweepPendingAvailabilityResponse: r}
+		updateResponse.Error = monsterax.WrapError(err)
+	case *corepb.UpdateRequest_CreateAlertRuleRequest:
+		r, err := a.accountsCore.CreateAlertRule(req.CreateAlertRuleRequest)
+		updateResponse.Response = &corepb.UpdateResponse_CreateAlertRuleResponse{CreateAlertRuleResponse: r}
+		updateResponse.Error = monsterax.WrapError(err)
+	case *corepb.UpdateRequest_DeleteAlertRuleRequest:
+		r, err := a.accountsCore.DeleteAlertRule(req.DeleteAlertRuleRequest)
+		updateResponse.Response = &corepb.UpdateResponse_DeleteAlertRuleResponse{DeleteAlertRuleResponse: r}
+		updateResponse.Error = monsterax.WrapError(err)
+	case *corepb.UpdateRequest_AckAlertRequest:
+		r, err := a.accountsCore.AckAlert(req.AckAlertRequest)
+		updateResponse.Response = &corepb.UpdateResponse_AckAlertResponse{AckAlertResponse: r}
+		updateResponse.Error = monsterax.WrapError(err)
+	case *corepb.UpdateRequest_AckOutboxRequest:
+		r, err := a.accountsCore.AckOutbox(req.AckOutboxRequest)
+		updateResponse.Response = &corepb.UpdateResponse_AckOutboxResponse{AckOutboxResponse: r}
+		updateResponse.Error = monsterax.WrapError(err)
+	case *corepb.UpdateRequest_ArchiveTransactionsRequest:
+		r, err := a.accountsCore.ArchiveTransactions(req.ArchiveTransactionsRequest)
+		updateResponse.Response = &corepb.UpdateResponse_ArchiveTransactionsResponse{ArchiveTransactionsResponse: r}
+		updateResponse.Error = monsterax.WrapError(err)
+	case *corepb.UpdateRequest_RebuildShardStatsRequest:
+		r, err := a.accountsCore.RebuildShardStats(req.RebuildShardStatsRequest)
+		updateResponse.Response = &corepb.UpdateResponse_RebuildShardStatsResponse{RebuildShardStatsResponse: r}
+		updateResponse.Error = monsterax.WrapError(err)
+	case *corepb.UpdateRequest_HoldEscrowRequest:
+		r, err := a.accountsCore.HoldEscrow(req.HoldEscrowRequest)
+		updateResponse.Response = &corepb.UpdateResponse_HoldEscrowResponse{HoldEscrowResponse: r}
+		updateResponse.Error = monsterax.WrapError(err)
+	case *corepb.UpdateRequest_ReleaseEscrowRequest:
+		r, err := a.accountsCore.ReleaseEscrow(req.ReleaseEscrowRequest)
+		updateResponse.Response = &corepb.UpdateResponse_ReleaseEscrowResponse{ReleaseEscrowResponse: r}
+		updateResponse.Error = monsterax.WrapError(err)
+	case *corepb.UpdateRequest_SweepEscrowsRequest:
+		r, err := a.accountsCore.SweepEscrows(req.SweepEscrowsRequest)
+		updateResponse.Response = &corepb.UpdateResponse_SweepEscrowsResponse{SweepEscrowsResponse: r}
+		updateResponse.Error = monsterax.WrapError(err)
 	default:
 		panic("no matching handlers")
 	}
@@ -91,6 +139,138 @@ func (a *AccountsCoreAdapter) Read(request []byte) []byte {
 		r, err := a.accountsCore.GetAccount(req.GetAccountRequest)
 		readResponse.Response = &corepb.ReadResponse_GetAccountResponse{GetAccountResponse: r}
 		readResponse.Error = monsterax.WrapError(err)
+	case *corepb.ReadRequest_GetDisputeRequest:
+		r, err := a.accountsCore.GetDispute(req.GetDisputeRequest)
+		readResponse.Response = &corepb.ReadResponse_GetDisputeResponse{GetDisputeResponse: r}
+		readResponse.Error = monsterax.WrapError(err)
+	case *corepb.ReadRequest_ListDisputesRequest:
+		r, err := a.accountsCore.ListDisputes(req.ListDisputesRequest)
+		readResponse.Response = &corepb.ReadResponse_ListDisputesResponse{ListDisputesResponse: r}
+		readResponse.Error = monsterax.WrapError(err)
+	case *corepb.ReadRequest_ListAlertRulesRequest:
+		r, err := a.accountsCore.ListAlertRules(req.ListAlertRulesRequest)
+		readResponse.Response = &corepb.ReadResponse_ListAlertRulesResponse{ListAlertRulesResponse: r}
+		readResponse.Error = monsterax.WrapError(err)
+	case *corepb.ReadRequest_ListTriggeredAlertsRequest:
+		r, err := a.accountsCore.ListTriggeredAlerts(req.ListTriggeredAlertsRequest)
+		readResponse.Response = &corepb.ReadResponse_ListTriggeredAlertsResponse{ListTriggeredAlertsResponse: r}
+		readResponse.Error = monsterax.WrapError(err)
+	case *corepb.ReadRequest_ReadOutboxRequest:
+		r, err := a.accountsCore.ReadOutbox(req.ReadOutboxRequest)
+		readResponse.Response = &corepb.ReadResponse_ReadOutboxResponse{ReadOutboxResponse: r}
+		readResponse.Error = monsterax.WrapError(err)
+	case *corepb.ReadRequest_GetSpendingSummaryRequest:
+		r, err := a.accountsCore.GetSpendingSummary(req.GetSpendingSummaryRequest)
+		readResponse.Response = &corepb.ReadResponse_GetSpendingSummaryResponse{GetSpendingSummaryResponse: r}
+		readResponse.Error = monsterax.WrapError(err)
+	case *corepb.ReadRequest_ListArchivableTransactionsRequest:
+		r, err := a.accountsCore.ListArchivableTransactions(req.ListArchivableTransactionsRequest)
+		readResponse.Response = &corepb.ReadResponse_ListArchivableTransactionsResponse{ListArchivableTransactionsResponse: r}
+		readResponse.Error = monsterax.WrapError(err)
+	case *corepb.ReadRequest_ListTransactionRollupsRequest:
+		r, err := a.accountsCore.ListTransactionRollups(req.ListTransactionRollupsRequest)
+		readResponse.Response = &corepb.ReadResponse_ListTransactionRollupsResponse{ListTransactionRollupsResponse: r}
+		readResponse.Error = monsterax.WrapError(err)
+	case *corepb.ReadRequest_GetShardStatsRequest:
+		r, err := a.accountsCore.GetShardStats(req.GetShardStatsRequest)
+		readResponse.Response = &corepb.ReadResponse_GetShardStatsResponse{GetShardStatsResponse: r}
+		readResponse.Error = monsterax.WrapError(err)
+	case *corepb.ReadRequest_GetAccountFromFollowerRequest:
+		r, err := a.accountsCore.GetAccountFromFollower(req.GetAccountFromFollowerRequest)
+		readResponse.Response = &corepb.ReadResponse_GetAccountFromFollowerResponse{GetAccountFromFollowerResponse: r}
+		readResponse.Error = monsterax.WrapError(err)
+	case *corepb.ReadRequest_ListTransactionsFromFollowerRequest:
+		r, err := a.accountsCore.ListTransactionsFromFollower(req.ListTransactionsFromFollowerRequest)
+		readResponse.Response = &corepb.ReadResponse_ListTransactionsFromFollowerResponse{ListTransactionsFromFollowerResponse: r}
+		readResponse.Error = monsterax.WrapError(err)
+	case *corepb.ReadRequest_GetEscrowRequest:
+		r, err := a.accountsCore.GetEscrow(req.GetEscrowRequest)
+		readResponse.Response = &corepb.ReadResponse_GetEscrowResponse{GetEscrowResponse: r}
+		readResponse.Error = monsterax.WrapError(err)
+	case *corepb.ReadRequest_ListEscrowsRequest:
+		r, err := a.accountsCore.ListEscrows(req.ListEscrowsRequest)
+		readResponse.Response = &corepb.ReadResponse_ListEscrowsResponse{ListEscrowsResponse: r}
+		readResponse.Error = monsterax.WrapError(err)
+	default:
+		panic("no matching handlers")
+	}
+	response, err := proto.Marshal(readResponse)
+	if err != nil {
+		panic(err)
+	}
+
+	return response
+}
+
+type CustomersCoreAdapter struct {
+	customersCore CustomersCoreApi
+}
+
+var _ monstera.ApplicationCore = &CustomersCoreAdapter{}
+
+func NewCustomersCoreAdapter(customersCore CustomersCoreApi) *CustomersCoreAdapter {
+	return &CustomersCoreAdapter{customersCore: customersCore}
+}
+
+func (a *CustomersCoreAdapter) Snapshot() monstera.ApplicationCoreSnapshot {
+	return a.customersCore.Snapshot()
+}
+
+func (a *CustomersCoreAdapter) Restore(r io.ReadCloser) error {
+	return a.customersCore.Restore(r)
+}
+
+func (a *CustomersCoreAdapter) Close() {
+	a.customersCore.Close()
+}
+
+func (a *CustomersCoreAdapter) Update(request []byte) []byte {
+	updateRequest := &corepb.UpdateRequest{}
+	updateResponse := &corepb.UpdateResponse{}
+
+	err := proto.Unmarshal(request, updateRequest)
+	if err != nil {
+		panic(err)
+	}
+
+	switch req := updateRequest.Request.(type) {
+	case *corepb.UpdateRequest_CreateCustomerRequest:
+		r, err := a.customersCore.CreateCustomer(req.CreateCustomerRequest)
+		updateResponse.Response = &corepb.UpdateResponse_CreateCustomerResponse{CreateCustomerResponse: r}
+		updateResponse.Error = monsterax.WrapError(err)
+	case *corepb.UpdateRequest_AddCustomerAccountRequest:
+		r, err := a.customersCore.AddCustomerAccount(req.AddCustomerAccountRequest)
+		updateResponse.Response = &corepb.UpdateResponse_AddCustomerAccountResponse{AddCustomerAccountResponse: r}
+		updateResponse.Error = monsterax.WrapError(err)
+	case *corepb.UpdateRequest_RemoveCustomerAccountRequest:
+		r, err := a.customersCore.RemoveCustomerAccount(req.RemoveCustomerAccountRequest)
+		updateResponse.Response = &corepb.UpdateResponse_RemoveCustomerAccountResponse{RemoveCustomerAccountResponse: r}
+		updateResponse.Error = monsterax.WrapError(err)
+	default:
+		panic("no matching handlers")
+	}
+	response, err := proto.Marshal(updateResponse)
+	if err != nil {
+		panic(err)
+	}
+
+	return response
+}
+
+func (a *CustomersCoreAdapter) Read(request []byte) []byte {
+	readRequest := &corepb.ReadRequest{}
+	readResponse := &corepb.ReadResponse{}
+
+	err := proto.Unmarshal(request, readRequest)
+	if err != nil {
+		panic(err)
+	}
+
+	switch req := readRequest.Request.(type) {
+	case *corepb.ReadRequest_GetCustomerRequest:
+		r, err := a.customersCore.GetCustomer(req.GetCustomerRequest)
+		readResponse.Response = &corepb.ReadResponse_GetCustomerResponse{GetCustomerResponse: r}
+		readResponse.Error = monsterax.WrapError(err)
 	default:
 		panic("no matching handlers")
 	}
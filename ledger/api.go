@@ -13,10 +13,40 @@ type LedgerServiceCoreApi interface {
 	ListTransactions(ctx context.Context, request *corepb.ListTransactionsRequest) (*corepb.ListTransactionsResponse, error)
 	GetTransaction(ctx context.Context, request *corepb.GetTransactionRequest) (*corepb.GetTransactionResponse, error)
 	GetAccount(ctx context.Context, request *corepb.GetAccountRequest) (*corepb.GetAccountResponse, error)
+	GetDispute(ctx context.Context, request *corepb.GetDisputeRequest) (*corepb.GetDisputeResponse, error)
+	ListDisputes(ctx context.Context, request *corepb.ListDisputesRequest) (*corepb.ListDisputesResponse, error)
+	ListAlertRules(ctx context.Context, request *corepb.ListAlertRulesRequest) (*corepb.ListAlertRulesResponse, error)
+	ListTriggeredAlerts(ctx context.Context, request *corepb.ListTriggeredAlertsRequest) (*corepb.ListTriggeredAlertsResponse, error)
+	ReadOutbox(ctx context.Context, request *corepb.ReadOutboxRequest, shardId string) (*corepb.ReadOutboxResponse, error)
+	GetSpendingSummary(ctx context.Context, request *corepb.GetSpendingSummaryRequest) (*corepb.GetSpendingSummaryResponse, error)
+	ListArchivableTransactions(ctx context.Context, request *corepb.ListArchivableTransactionsRequest, shardId string) (*corepb.ListArchivableTransactionsResponse, error)
+	ListTransactionRollups(ctx context.Context, request *corepb.ListTransactionRollupsRequest) (*corepb.ListTransactionRollupsResponse, error)
+	GetShardStats(ctx context.Context, request *corepb.GetShardStatsRequest, shardId string) (*corepb.GetShardStatsResponse, error)
+	GetAccountFromFollower(ctx context.Context, request *corepb.GetAccountFromFollowerRequest) (*corepb.GetAccountFromFollowerResponse, error)
+	ListTransactionsFromFollower(ctx context.Context, request *corepb.ListTransactionsFromFollowerRequest) (*corepb.ListTransactionsFromFollowerResponse, error)
+	GetEscrow(ctx context.Context, request *corepb.GetEscrowRequest) (*corepb.GetEscrowResponse, error)
+	ListEscrows(ctx context.Context, request *corepb.ListEscrowsRequest) (*corepb.ListEscrowsResponse, error)
 	CreateTransaction(ctx context.Context, request *corepb.CreateTransactionRequest) (*corepb.CreateTransactionResponse, error)
 	CancelTransaction(ctx context.Context, request *corepb.CancelTransactionRequest) (*corepb.CancelTransactionResponse, error)
 	SettleTransaction(ctx context.Context, request *corepb.SettleTransactionRequest) (*corepb.SettleTransactionResponse, error)
 	CreateAccount(ctx context.Context, request *corepb.CreateAccountRequest) (*corepb.CreateAccountResponse, error)
+	OpenDispute(ctx context.Context, request *corepb.OpenDisputeRequest) (*corepb.OpenDisputeResponse, error)
+	ResolveDispute(ctx context.Context, request *corepb.ResolveDisputeRequest) (*corepb.ResolveDisputeResponse, error)
+	SweepPendingAvailability(ctx context.Context, request *corepb.SweepPendingAvailabilityRequest, shardId string) (*corepb.SweepPendingAvailabilityResponse, error)
+	CreateAlertRule(ctx context.Context, request *corepb.CreateAlertRuleRequest) (*corepb.CreateAlertRuleResponse, error)
+	DeleteAlertRule(ctx context.Context, request *corepb.DeleteAlertRuleRequest) (*corepb.DeleteAlertRuleResponse, error)
+	AckAlert(ctx context.Context, request *corepb.AckAlertRequest) (*corepb.AckAlertResponse, error)
+	AckOutbox(ctx context.Context, request *corepb.AckOutboxRequest, shardId string) (*corepb.AckOutboxResponse, error)
+	ArchiveTransactions(ctx context.Context, request *corepb.ArchiveTransactionsRequest, shardId string) (*corepb.ArchiveTransactionsResponse, error)
+	RebuildShardStats(ctx context.Context, request *corepb.RebuildShardStatsRequest, shardId string) (*corepb.RebuildShardStatsResponse, error)
+	HoldEscrow(ctx context.Context, request *corepb.HoldEscrowRequest) (*corepb.HoldEscrowResponse, error)
+	ReleaseEscrow(ctx context.Context, request *corepb.ReleaseEscrowRequest) (*corepb.ReleaseEscrowResponse, error)
+	SweepEscrows(ctx context.Context, request *corepb.SweepEscrowsRequest, shardId string) (*corepb.SweepEscrowsResponse, error)
+
+	GetCustomer(ctx context.Context, request *corepb.GetCustomerRequest) (*corepb.GetCustomerResponse, error)
+	CreateCustomer(ctx context.Context, request *corepb.CreateCustomerRequest) (*corepb.CreateCustomerResponse, error)
+	AddCustomerAccount(ctx context.Context, request *corepb.AddCustomerAccountRequest) (*corepb.AddCustomerAccountResponse, error)
+	RemoveCustomerAccount(ctx context.Context, request *corepb.RemoveCustomerAccountRequest) (*corepb.RemoveCustomerAccountResponse, error)
 }
 
 var _ LedgerServiceCoreApi = &UnimplementedLedgerServiceCoreApi{}
@@ -35,6 +65,58 @@ func (a *UnimplementedLedgerServiceCoreApi) GetAccount(ctx context.Context, requ
 	panic("not implemented")
 }
 
+func (a *UnimplementedLedgerServiceCoreApi) GetDispute(ctx context.Context, request *corepb.GetDisputeRequest) (*corepb.GetDisputeResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLedgerServiceCoreApi) ListDisputes(ctx context.Context, request *corepb.ListDisputesRequest) (*corepb.ListDisputesResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLedgerServiceCoreApi) ListAlertRules(ctx context.Context, request *corepb.ListAlertRulesRequest) (*corepb.ListAlertRulesResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLedgerServiceCoreApi) ListTriggeredAlerts(ctx context.Context, request *corepb.ListTriggeredAlertsRequest) (*corepb.ListTriggeredAlertsResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLedgerServiceCoreApi) ReadOutbox(ctx context.Context, request *corepb.ReadOutboxRequest, shardId string) (*corepb.ReadOutboxResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLedgerServiceCoreApi) GetSpendingSummary(ctx context.Context, request *corepb.GetSpendingSummaryRequest) (*corepb.GetSpendingSummaryResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLedgerServiceCoreApi) ListArchivableTransactions(ctx context.Context, request *corepb.ListArchivableTransactionsRequest, shardId string) (*corepb.ListArchivableTransactionsResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLedgerServiceCoreApi) ListTransactionRollups(ctx context.Context, request *corepb.ListTransactionRollupsRequest) (*corepb.ListTransactionRollupsResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLedgerServiceCoreApi) GetShardStats(ctx context.Context, request *corepb.GetShardStatsRequest, shardId string) (*corepb.GetShardStatsResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLedgerServiceCoreApi) GetAccountFromFollower(ctx context.Context, request *corepb.GetAccountFromFollowerRequest) (*corepb.GetAccountFromFollowerResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLedgerServiceCoreApi) ListTransactionsFromFollower(ctx context.Context, request *corepb.ListTransactionsFromFollowerRequest) (*corepb.ListTransactionsFromFollowerResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLedgerServiceCoreApi) GetEscrow(ctx context.Context, request *corepb.GetEscrowRequest) (*corepb.GetEscrowResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLedgerServiceCoreApi) ListEscrows(ctx context.Context, request *corepb.ListEscrowsRequest) (*corepb.ListEscrowsResponse, error) {
+	panic("not implemented")
+}
+
 func (a *UnimplementedLedgerServiceCoreApi) CreateTransaction(ctx context.Context, request *corepb.CreateTransactionRequest) (*corepb.CreateTransactionResponse, error) {
 	panic("not implemented")
 }
@@ -51,6 +133,70 @@ func (a *UnimplementedLedgerServiceCoreApi) CreateAccount(ctx context.Context, r
 	panic("not implemented")
 }
 
+func (a *UnimplementedLedgerServiceCoreApi) OpenDispute(ctx context.Context, request *corepb.OpenDisputeRequest) (*corepb.OpenDisputeResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLedgerServiceCoreApi) ResolveDispute(ctx context.Context, request *corepb.ResolveDisputeRequest) (*corepb.ResolveDisputeResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLedgerServiceCoreApi) SweepPendingAvailability(ctx context.Context, request *corepb.SweepPendingAvailabilityRequest, shardId string) (*corepb.SweepPendingAvailabilityResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLedgerServiceCoreApi) CreateAlertRule(ctx context.Context, request *corepb.CreateAlertRuleRequest) (*corepb.CreateAlertRuleResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLedgerServiceCoreApi) DeleteAlertRule(ctx context.Context, request *corepb.DeleteAlertRuleRequest) (*corepb.DeleteAlertRuleResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLedgerServiceCoreApi) AckAlert(ctx context.Context, request *corepb.AckAlertRequest) (*corepb.AckAlertResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLedgerServiceCoreApi) AckOutbox(ctx context.Context, request *corepb.AckOutboxRequest, shardId string) (*corepb.AckOutboxResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLedgerServiceCoreApi) ArchiveTransactions(ctx context.Context, request *corepb.ArchiveTransactionsRequest, shardId string) (*corepb.ArchiveTransactionsResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLedgerServiceCoreApi) RebuildShardStats(ctx context.Context, request *corepb.RebuildShardStatsRequest, shardId string) (*corepb.RebuildShardStatsResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLedgerServiceCoreApi) HoldEscrow(ctx context.Context, request *corepb.HoldEscrowRequest) (*corepb.HoldEscrowResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLedgerServiceCoreApi) ReleaseEscrow(ctx context.Context, request *corepb.ReleaseEscrowRequest) (*corepb.ReleaseEscrowResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLedgerServiceCoreApi) SweepEscrows(ctx context.Context, request *corepb.SweepEscrowsRequest, shardId string) (*corepb.SweepEscrowsResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLedgerServiceCoreApi) GetCustomer(ctx context.Context, request *corepb.GetCustomerRequest) (*corepb.GetCustomerResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLedgerServiceCoreApi) CreateCustomer(ctx context.Context, request *corepb.CreateCustomerRequest) (*corepb.CreateCustomerResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLedgerServiceCoreApi) AddCustomerAccount(ctx context.Context, request *corepb.AddCustomerAccountRequest) (*corepb.AddCustomerAccountResponse, error) {
+	panic("not implemented")
+}
+
+func (a *UnimplementedLedgerServiceCoreApi) RemoveCustomerAccount(ctx context.Context, request *corepb.RemoveCustomerAccountRequest) (*corepb.RemoveCustomerAccountResponse, error) {
+	panic("not implemented")
+}
+
 type AccountsCoreApi interface {
 	Snapshot() monstera.ApplicationCoreSnapshot
 	Restore(reader io.ReadCloser) error
@@ -58,8 +204,43 @@ type AccountsCoreApi interface {
 	ListTransactions(request *corepb.ListTransactionsRequest) (*corepb.ListTransactionsResponse, error)
 	GetTransaction(request *corepb.GetTransactionRequest) (*corepb.GetTransactionResponse, error)
 	GetAccount(request *corepb.GetAccountRequest) (*corepb.GetAccountResponse, error)
+	GetDispute(request *corepb.GetDisputeRequest) (*corepb.GetDisputeResponse, error)
+	ListDisputes(request *corepb.ListDisputesRequest) (*corepb.ListDisputesResponse, error)
+	ListAlertRules(request *corepb.ListAlertRulesRequest) (*corepb.ListAlertRulesResponse, error)
+	ListTriggeredAlerts(request *corepb.ListTriggeredAlertsRequest) (*corepb.ListTriggeredAlertsResponse, error)
+	ReadOutbox(request *corepb.ReadOutboxRequest) (*corepb.ReadOutboxResponse, error)
+	GetSpendingSummary(request *corepb.GetSpendingSummaryRequest) (*corepb.GetSpendingSummaryResponse, error)
+	ListArchivableTransactions(request *corepb.ListArchivableTransactionsRequest) (*corepb.ListArchivableTransactionsResponse, error)
+	ListTransactionRollups(request *corepb.ListTransactionRollupsRequest) (*corepb.ListTransactionRollupsResponse, error)
+	GetShardStats(request *corepb.GetShardStatsRequest) (*corepb.GetShardStatsResponse, error)
+	GetAccountFromFollower(request *corepb.GetAccountFromFollowerRequest) (*corepb.GetAccountFromFollowerResponse, error)
+	ListTransactionsFromFollower(request *corepb.ListTransactionsFromFollowerRequest) (*corepb.ListTransactionsFromFollowerResponse, error)
+	GetEscrow(request *corepb.GetEscrowRequest) (*corepb.GetEscrowResponse, error)
+	ListEscrows(request *corepb.ListEscrowsRequest) (*corepb.ListEscrowsResponse, error)
 	CreateTransaction(request *corepb.CreateTransactionRequest) (*corepb.CreateTransactionResponse, error)
 	CancelTransaction(request *corepb.CancelTransactionRequest) (*corepb.CancelTransactionResponse, error)
 	SettleTransaction(request *corepb.SettleTransactionRequest) (*corepb.SettleTransactionResponse, error)
 	CreateAccount(request *corepb.CreateAccountRequest) (*corepb.CreateAccountResponse, error)
+	OpenDispute(request *corepb.OpenDisputeRequest) (*corepb.OpenDisputeResponse, error)
+	ResolveDispute(request *corepb.ResolveDisputeRequest) (*corepb.ResolveDisputeResponse, error)
+	SweepPendingAvailability(request *corepb.SweepPendingAvailabilityRequest) (*corepb.SweepPendingAvailabilityResponse, error)
+	CreateAlertRule(request *corepb.CreateAlertRuleRequest) (*corepb.CreateAlertRuleResponse, error)
+	DeleteAlertRule(request *corepb.DeleteAlertRuleRequest) (*corepb.DeleteAlertRuleResponse, error)
+	AckAlert(request *corepb.AckAlertRequest) (*corepb.AckAlertResponse, error)
+	AckOutbox(request *corepb.AckOutboxRequest) (*corepb.AckOutboxResponse, error)
+	ArchiveTransactions(request *corepb.ArchiveTransactionsRequest) (*corepb.ArchiveTransactionsResponse, error)
+	RebuildShardStats(request *corepb.RebuildShardStatsRequest) (*corepb.RebuildShardStatsResponse, error)
+	HoldEscrow(request *corepb.HoldEscrowRequest) (*corepb.HoldEscrowResponse, error)
+	ReleaseEscrow(request *corepb.ReleaseEscrowRequest) (*corepb.ReleaseEscrowResponse, error)
+	SweepEscrows(request *corepb.SweepEscrowsRequest) (*corepb.SweepEscrowsResponse, error)
+}
+
+type CustomersCoreApi interface {
+	Snapshot() monstera.ApplicationCoreSnapshot
+	Restore(reader io.ReadCloser) error
+	Close()
+	GetCustomer(request *corepb.GetCustomerRequest) (*corepb.GetCustomerResponse, error)
+	CreateCustomer(request *corepb.CreateCustomerRequest) (*corepb.CreateCustomerResponse, error)
+	AddCustomerAccount(request *corepb.AddCustomerAccountRequest) (*corepb.AddCustomerAccountResponse, error)
+	RemoveCustomerAccount(request *corepb.RemoveCustomerAccountRequest) (*corepb.RemoveCustomerAccountResponse, error)
 }
@@ -0,0 +1,328 @@
+// Package client is a typed Go client of the ledger gateway API. It converts ids and timestamps, maps errors to
+// typed ones, retries calls which failed with Unavailable and applies a default deadline to every call.
+package client
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+
+	"github.com/evrblk/monstera-example/ledger/gatewaypb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultMaxAttempts = 5
+	DefaultMinBackoff  = 50 * time.Millisecond
+	DefaultMaxBackoff  = 2 * time.Second
+	DefaultTimeout     = 10 * time.Second
+)
+
+// Options of a client, zero values are replaced with defaults.
+type Options struct {
+	// MaxAttempts is the number of attempts of a call failing with Unavailable, including the first one.
+	MaxAttempts int
+	// MinBackoff is a delay before the first retry, it is doubled for every next retry up to MaxBackoff.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// Timeout is a deadline of a call (including all retries) when the context has no deadline.
+	Timeout time.Duration
+}
+
+type Account struct {
+	Id                         AccountId
+	AvailableBalance           int64
+	SettledBalance             int64
+	PendingAvailabilityBalance int64
+	// CustomerId is empty if the account does not belong to a customer.
+	CustomerId string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type Transaction struct {
+	Id          TransactionId
+	Amount      int64
+	Description string
+	Category    string
+	Status      gatewaypb.TransactionStatus
+	// AvailableAt is a value date of a topup, zero if the amount is available right after settlement.
+	AvailableAt time.Time
+	// DisputeId is empty if the transaction is not disputed.
+	DisputeId string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewTransaction is a transaction to create. Positive amounts are topups, negative amounts are purchases.
+type NewTransaction struct {
+	Amount      int64
+	Description string
+	Category    string
+	Settled     bool
+	// AvailableAt is an optional value date of a topup.
+	AvailableAt time.Time
+}
+
+// Client of the ledger gateway. Reads, settlements and cancellations are retried with exponential backoff when
+// they fail with Unavailable: repeating them can not change balances twice. Creates are not retried once sent,
+// because gateway assigns new ids on every call and a retry could create a duplicate; instead they wait for
+// the connection to become ready.
+type Client struct {
+	api     gatewaypb.LedgerServiceApiClient
+	options Options
+}
+
+func NewClient(conn grpc.ClientConnInterface, options Options) *Client {
+	if options.MaxAttempts <= 0 {
+		options.MaxAttempts = DefaultMaxAttempts
+	}
+	if options.MinBackoff <= 0 {
+		options.MinBackoff = DefaultMinBackoff
+	}
+	if options.MaxBackoff <= 0 {
+		options.MaxBackoff = DefaultMaxBackoff
+	}
+	if options.Timeout <= 0 {
+		options.Timeout = DefaultTimeout
+	}
+
+	return &Client{
+		api:     gatewaypb.NewLedgerServiceApiClient(conn),
+		options: options,
+	}
+}
+
+// Api returns the underlying gateway client for methods not covered by Client (disputes, alerts, customers).
+func (c *Client) Api() gatewaypb.LedgerServiceApiClient {
+	return c.api
+}
+
+func (c *Client) CreateAccount(ctx context.Context) (*Account, error) {
+	return c.CreateCustomerAccount(ctx, "")
+}
+
+func (c *Client) CreateCustomerAccount(ctx context.Context, customerId string) (*Account, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.api.CreateAccount(ctx, &gatewaypb.CreateAccountRequest{
+		CustomerId: customerId,
+	}, grpc.WaitForReady(true))
+	if err != nil {
+		return nil, fromGRPC(err)
+	}
+
+	return accountFromApi(resp.Account)
+}
+
+func (c *Client) GetAccount(ctx context.Context, accountId AccountId) (*Account, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	var resp *gatewaypb.GetAccountResponse
+	err := c.retry(ctx, func() (err error) {
+		resp, err = c.api.GetAccount(ctx, &gatewaypb.GetAccountRequest{
+			AccountId: accountId.String(),
+		})
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return accountFromApi(resp.Account)
+}
+
+// CreateTransaction creates a transaction on an account. A purchase rejected because of insufficient funds is
+// returned along with ErrInsufficientFunds.
+func (c *Client) CreateTransaction(ctx context.Context, accountId AccountId, transaction NewTransaction) (*Transaction, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	var availableAt int64
+	if !transaction.AvailableAt.IsZero() {
+		availableAt = transaction.AvailableAt.UnixNano()
+	}
+
+	resp, err := c.api.CreateTransaction(ctx, &gatewaypb.CreateTransactionRequest{
+		AccountId:   accountId.String(),
+		Amount:      transaction.Amount,
+		Description: transaction.Description,
+		Settled:     transaction.Settled,
+		AvailableAt: availableAt,
+		Category:    transaction.Category,
+	}, grpc.WaitForReady(true))
+	if err != nil {
+		return nil, fromGRPC(err)
+	}
+
+	result, err := transactionFromApi(resp.Transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Status == gatewaypb.TransactionStatus_TRANSACTION_STATUS_INSUFFICIENT_FUNDS {
+		return result, ErrInsufficientFunds
+	}
+
+	return result, nil
+}
+
+func (c *Client) GetTransaction(ctx context.Context, transactionId TransactionId) (*Transaction, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	var resp *gatewaypb.GetTransactionResponse
+	err := c.retry(ctx, func() (err error) {
+		resp, err = c.api.GetTransaction(ctx, &gatewaypb.GetTransactionRequest{
+			TransactionId: transactionId.String(),
+		})
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transactionFromApi(resp.Transaction)
+}
+
+func (c *Client) ListTransactions(ctx context.Context, accountId AccountId) ([]*Transaction, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	var resp *gatewaypb.ListTransactionsResponse
+	err := c.retry(ctx, func() (err error) {
+		resp, err = c.api.ListTransactions(ctx, &gatewaypb.ListTransactionsRequest{
+			AccountId: accountId.String(),
+		})
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*Transaction, len(resp.Transactions))
+	for i, transaction := range resp.Transactions {
+		transactions[i], err = transactionFromApi(transaction)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return transactions, nil
+}
+
+// SettleTransaction settles a pending transaction. If a retried call was in fact applied before, the retry fails
+// with ErrNotFound because the transaction is not pending anymore.
+func (c *Client) SettleTransaction(ctx context.Context, transactionId TransactionId) (*Transaction, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	var resp *gatewaypb.SettleTransactionResponse
+	err := c.retry(ctx, func() (err error) {
+		resp, err = c.api.SettleTransaction(ctx, &gatewaypb.SettleTransactionRequest{
+			TransactionId: transactionId.String(),
+		})
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transactionFromApi(resp.Transaction)
+}
+
+// CancelTransaction cancels a pending transaction. If a retried call was in fact applied before, the retry fails
+// with ErrNotFound because the transaction is not pending anymore.
+func (c *Client) CancelTransaction(ctx context.Context, transactionId TransactionId) (*Transaction, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	var resp *gatewaypb.CancelTransactionResponse
+	err := c.retry(ctx, func() (err error) {
+		resp, err = c.api.CancelTransaction(ctx, &gatewaypb.CancelTransactionRequest{
+			TransactionId: transactionId.String(),
+		})
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transactionFromApi(resp.Transaction)
+}
+
+func (c *Client) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, c.options.Timeout)
+}
+
+// retry calls fn until it succeeds, fails with anything but Unavailable, attempts are exhausted or ctx is done.
+// Errors are mapped to typed errors.
+func (c *Client) retry(ctx context.Context, fn func() error) error {
+	backoff := c.options.MinBackoff
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil {
+			return nil
+		}
+
+		if status.Code(err) != codes.Unavailable || attempt >= c.options.MaxAttempts {
+			return fromGRPC(err)
+		}
+
+		// full jitter, so that many clients do not retry in lockstep
+		select {
+		case <-ctx.Done():
+			return fromGRPC(err)
+		case <-time.After(rand.N(backoff) + 1):
+		}
+
+		backoff = min(backoff*2, c.options.MaxBackoff)
+	}
+}
+
+func accountFromApi(account *gatewaypb.Account) (*Account, error) {
+	id, err := ParseAccountId(account.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Account{
+		Id:                         id,
+		AvailableBalance:           account.AvailableBalance,
+		SettledBalance:             account.SettledBalance,
+		PendingAvailabilityBalance: account.PendingAvailabilityBalance,
+		CustomerId:                 account.CustomerId,
+		CreatedAt:                  time.Unix(0, account.CreatedAt),
+		UpdatedAt:                  time.Unix(0, account.UpdatedAt),
+	}, nil
+}
+
+func transactionFromApi(transaction *gatewaypb.Transaction) (*Transaction, error) {
+	id, err := ParseTransactionId(transaction.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var availableAt time.Time
+	if transaction.AvailableAt != 0 {
+		availableAt = time.Unix(0, transaction.AvailableAt)
+	}
+
+	return &Transaction{
+		Id:          id,
+		Amount:      transaction.Amount,
+		Description: transaction.Description,
+		Category:    transaction.Category,
+		Status:      transaction.Status,
+		AvailableAt: availableAt,
+		DisputeId:   transaction.DisputeId,
+		CreatedAt:   time.Unix(0, transaction.CreatedAt),
+		UpdatedAt:   time.Unix(0, transaction.UpdatedAt),
+	}, nil
+}
@@ -0,0 +1,197 @@
+package client
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/evrblk/monstera"
+	"github.com/evrblk/monstera-example/ledger"
+	"github.com/evrblk/monstera-example/ledger/gatewaypb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestAccountsAndTransactions(t *testing.T) {
+	require := require.New(t)
+
+	client := newTestClient(t, &unavailableInjector{})
+	ctx := context.Background()
+
+	// create account
+	account, err := client.CreateAccount(ctx)
+	require.NoError(err)
+	require.EqualValues(0, account.AvailableBalance)
+	require.Empty(account.CustomerId)
+
+	// ids survive a round trip through strings
+	accountId, err := ParseAccountId(account.Id.String())
+	require.NoError(err)
+	require.Equal(account.Id, accountId)
+
+	// settled topup +100
+	topup, err := client.CreateTransaction(ctx, account.Id, NewTransaction{
+		Amount:  100,
+		Settled: true,
+	})
+	require.NoError(err)
+	require.Equal(gatewaypb.TransactionStatus_TRANSACTION_STATUS_SETTLED, topup.Status)
+	require.Equal(account.Id, topup.Id.AccountId)
+	require.True(topup.AvailableAt.IsZero())
+
+	// pending purchase -30
+	purchase, err := client.CreateTransaction(ctx, account.Id, NewTransaction{
+		Amount:   -30,
+		Category: "groceries",
+	})
+	require.NoError(err)
+	require.Equal(gatewaypb.TransactionStatus_TRANSACTION_STATUS_PENDING, purchase.Status)
+
+	// purchase -500 is rejected but recorded
+	rejected, err := client.CreateTransaction(ctx, account.Id, NewTransaction{
+		Amount: -500,
+	})
+	require.ErrorIs(err, ErrInsufficientFunds)
+	require.NotNil(rejected)
+	require.Equal(gatewaypb.TransactionStatus_TRANSACTION_STATUS_INSUFFICIENT_FUNDS, rejected.Status)
+
+	// settle purchase
+	settled, err := client.SettleTransaction(ctx, purchase.Id)
+	require.NoError(err)
+	require.Equal(gatewaypb.TransactionStatus_TRANSACTION_STATUS_SETTLED, settled.Status)
+
+	// settling it again fails, it is not pending anymore
+	_, err = client.SettleTransaction(ctx, purchase.Id)
+	require.ErrorIs(err, ErrNotFound)
+
+	// get transaction
+	transaction, err := client.GetTransaction(ctx, purchase.Id)
+	require.NoError(err)
+	require.Equal(purchase.Id, transaction.Id)
+	require.Equal("groceries", transaction.Category)
+
+	// list transactions
+	transactions, err := client.ListTransactions(ctx, account.Id)
+	require.NoError(err)
+	require.Len(transactions, 3)
+
+	// balances
+	account, err = client.GetAccount(ctx, account.Id)
+	require.NoError(err)
+	require.EqualValues(70, account.AvailableBalance)
+	require.EqualValues(70, account.SettledBalance)
+}
+
+func TestNotFound(t *testing.T) {
+	require := require.New(t)
+
+	client := newTestClient(t, &unavailableInjector{})
+	ctx := context.Background()
+
+	_, err := client.GetAccount(ctx, AccountId(12345))
+	require.ErrorIs(err, ErrNotFound)
+
+	_, err = client.CreateTransaction(ctx, AccountId(12345), NewTransaction{Amount: 10})
+	require.ErrorIs(err, ErrNotFound)
+
+	_, err = client.CancelTransaction(ctx, TransactionId{AccountId: 12345, TransactionId: 1})
+	require.ErrorIs(err, ErrNotFound)
+}
+
+func TestRetryUnavailable(t *testing.T) {
+	require := require.New(t)
+
+	injector := &unavailableInjector{}
+	client := newTestClient(t, injector)
+	ctx := context.Background()
+
+	account, err := client.CreateAccount(ctx)
+	require.NoError(err)
+	injector.calls.Store(0)
+
+	// reads are retried until they succeed
+	injector.failures.Store(3)
+	_, err = client.GetAccount(ctx, account.Id)
+	require.NoError(err)
+	require.EqualValues(4, injector.calls.Swap(0))
+
+	// attempts are limited
+	injector.failures.Store(10)
+	_, err = client.GetAccount(ctx, account.Id)
+	require.Equal(codes.Unavailable, status.Code(err))
+	require.EqualValues(DefaultMaxAttempts, injector.calls.Swap(0))
+	injector.failures.Store(0)
+
+	// creates are not retried, a retry could create a duplicate
+	injector.failures.Store(1)
+	_, err = client.CreateTransaction(ctx, account.Id, NewTransaction{Amount: 10, Settled: true})
+	require.Equal(codes.Unavailable, status.Code(err))
+	require.EqualValues(1, injector.calls.Swap(0))
+
+	transactions, err := client.ListTransactions(ctx, account.Id)
+	require.NoError(err)
+	require.Empty(transactions)
+}
+
+func TestDeadline(t *testing.T) {
+	require := require.New(t)
+
+	injector := &unavailableInjector{}
+	client := newTestClient(t, injector)
+
+	account, err := client.CreateAccount(context.Background())
+	require.NoError(err)
+
+	// retries stop when the context deadline is exceeded
+	injector.failures.Store(1000)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err = client.GetAccount(ctx, account.Id)
+	require.Error(err)
+	require.Less(injector.calls.Load(), int64(1000))
+}
+
+// unavailableInjector fails the given number of next calls with Unavailable, before they reach the server
+type unavailableInjector struct {
+	failures atomic.Int64
+	calls    atomic.Int64
+}
+
+func (i *unavailableInjector) intercept(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	i.calls.Add(1)
+	if i.failures.Add(-1) >= 0 {
+		return nil, status.Error(codes.Unavailable, "injected")
+	}
+	i.failures.Store(0)
+	return handler(ctx, req)
+}
+
+// newTestClient starts the standalone server in-process on an in-memory connection and returns a client to it
+func newTestClient(t *testing.T, injector *unavailableInjector) *Client {
+	lis := bufconn.Listen(1024 * 1024)
+
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(injector.intercept))
+	gatewaypb.RegisterLedgerServiceApiServer(grpcServer, ledger.NewStandaloneApiServer(monstera.NewBadgerInMemoryStore()))
+	go grpcServer.Serve(lis)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return NewClient(conn, Options{
+		MinBackoff: time.Millisecond,
+		MaxBackoff: 5 * time.Millisecond,
+	})
+}
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// ErrNotFound is returned when an account or a transaction does not exist, or when a transaction is not in
+	// a state required by the operation (e.g. settling a transaction which is not pending).
+	ErrNotFound = errors.New("ledger: not found")
+
+	// ErrInsufficientFunds is returned by CreateTransaction when a purchase was rejected because of insufficient
+	// available balance. The transaction is still recorded and returned along with the error.
+	ErrInsufficientFunds = errors.New("ledger: insufficient funds")
+
+	ErrInvalidArgument = errors.New("ledger: invalid argument")
+)
+
+// fromGRPC maps gRPC status errors to typed errors, the original message is kept. Other errors (including
+// Unavailable after all retries and context errors) are returned as is.
+func fromGRPC(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	switch st.Code() {
+	case codes.NotFound:
+		return fmt.Errorf("%w: %s", ErrNotFound, st.Message())
+	case codes.InvalidArgument:
+		return fmt.Errorf("%w: %s", ErrInvalidArgument, st.Message())
+	default:
+		return err
+	}
+}
@@ -0,0 +1,45 @@
+package client
+
+import (
+	"github.com/evrblk/monstera-example/ledger"
+	"github.com/evrblk/monstera-example/ledger/corepb"
+)
+
+// AccountId is an id of an account, its string form is what gateway API accepts and returns.
+type AccountId uint64
+
+func ParseAccountId(s string) (AccountId, error) {
+	id, err := ledger.DecodeAccountId(s)
+	if err != nil {
+		return 0, err
+	}
+	return AccountId(id), nil
+}
+
+func (id AccountId) String() string {
+	return ledger.EncodeAccountId(uint64(id))
+}
+
+// TransactionId is an id of a transaction. Transactions belong to accounts, so their ids include account id.
+type TransactionId struct {
+	AccountId     AccountId
+	TransactionId uint64
+}
+
+func ParseTransactionId(s string) (TransactionId, error) {
+	id, err := ledger.DecodeTransactionId(s)
+	if err != nil {
+		return TransactionId{}, err
+	}
+	return TransactionId{
+		AccountId:     AccountId(id.AccountId),
+		TransactionId: id.TransactionId,
+	}, nil
+}
+
+func (id TransactionId) String() string {
+	return ledger.EncodeTransactionId(&corepb.TransactionId{
+		AccountId:     uint64(id.AccountId),
+		TransactionId: id.TransactionId,
+	})
+}
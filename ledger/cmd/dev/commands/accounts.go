@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/evrblk/monstera-example/ledger/gatewaypb"
+	"github.com/spf13/cobra"
+)
+
+var (
+	createAccountCustomerId string
+	spendingFromMonth       string
+	spendingToMonth         string
+)
+
+var accountsCmd = &cobra.Command{
+	Use:   "accounts",
+	Short: "Manage accounts",
+}
+
+var getAccountCmd = &cobra.Command{
+	Use:   "get <account-id>",
+	Short: "Get an account with its balances",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return call(func(ctx context.Context, client gatewaypb.LedgerServiceApiClient) error {
+			resp, err := client.GetAccount(ctx, &gatewaypb.GetAccountRequest{
+				AccountId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return printResponse(resp, accountHeader, accountRow(resp.Account))
+		})
+	},
+}
+
+var createAccountCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create an account",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return call(func(ctx context.Context, client gatewaypb.LedgerServiceApiClient) error {
+			resp, err := client.CreateAccount(ctx, &gatewaypb.CreateAccountRequest{
+				CustomerId: createAccountCustomerId,
+			})
+			if err != nil {
+				return err
+			}
+			return printResponse(resp, accountHeader, accountRow(resp.Account))
+		})
+	},
+}
+
+var spendingCmd = &cobra.Command{
+	Use:   "spending <account-id>",
+	Short: "Get monthly spending summaries of an account by category",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return call(func(ctx context.Context, client gatewaypb.LedgerServiceApiClient) error {
+			resp, err := client.GetSpendingSummary(ctx, &gatewaypb.GetSpendingSummaryRequest{
+				AccountId: args[0],
+				FromMonth: spendingFromMonth,
+				ToMonth:   spendingToMonth,
+			})
+			if err != nil {
+				return err
+			}
+			return printResponse(resp, spendingHeader, spendingRows(resp.Summaries)...)
+		})
+	},
+}
+
+func init() {
+	addGatewayCommand(accountsCmd)
+	accountsCmd.AddCommand(getAccountCmd)
+	accountsCmd.AddCommand(createAccountCmd)
+	accountsCmd.AddCommand(spendingCmd)
+
+	createAccountCmd.Flags().StringVarP(&createAccountCustomerId, "customer-id", "", "", "Optional customer who owns the account")
+
+	currentMonth := time.Now().UTC().Format("2006-01")
+	spendingCmd.Flags().StringVarP(&spendingFromMonth, "from", "", currentMonth, "First month (YYYY-MM, UTC)")
+	spendingCmd.Flags().StringVarP(&spendingToMonth, "to", "", currentMonth, "Last month (YYYY-MM, UTC)")
+}
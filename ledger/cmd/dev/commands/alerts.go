@@ -0,0 +1,144 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/evrblk/monstera-example/ledger/gatewaypb"
+	"github.com/spf13/cobra"
+)
+
+var (
+	createAlertRuleAccountId     string
+	createAlertRuleThreshold     int64
+	createAlertRuleDirection     string
+	listAlertsUnacknowledgedOnly bool
+)
+
+var alertsCmd = &cobra.Command{
+	Use:   "alerts",
+	Short: "Manage balance alert rules and triggered alerts",
+}
+
+var alertRulesCmd = &cobra.Command{
+	Use:   "rules",
+	Short: "Manage balance alert rules",
+}
+
+var listAlertRulesCmd = &cobra.Command{
+	Use:   "list <account-id>",
+	Short: "List alert rules of an account",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return call(func(ctx context.Context, client gatewaypb.LedgerServiceApiClient) error {
+			resp, err := client.ListAlertRules(ctx, &gatewaypb.ListAlertRulesRequest{
+				AccountId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return printResponse(resp, alertRuleHeader, alertRuleRows(resp.AlertRules)...)
+		})
+	},
+}
+
+var createAlertRuleCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create an alert rule triggered when available balance crosses a threshold",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var direction gatewaypb.AlertDirection
+		switch createAlertRuleDirection {
+		case "below":
+			direction = gatewaypb.AlertDirection_ALERT_DIRECTION_BELOW
+		case "above":
+			direction = gatewaypb.AlertDirection_ALERT_DIRECTION_ABOVE
+		default:
+			return fmt.Errorf("invalid direction %q, expected below or above", createAlertRuleDirection)
+		}
+
+		return call(func(ctx context.Context, client gatewaypb.LedgerServiceApiClient) error {
+			resp, err := client.CreateAlertRule(ctx, &gatewaypb.CreateAlertRuleRequest{
+				AccountId: createAlertRuleAccountId,
+				Threshold: createAlertRuleThreshold,
+				Direction: direction,
+			})
+			if err != nil {
+				return err
+			}
+			return printResponse(resp, alertRuleHeader, alertRuleRow(resp.AlertRule))
+		})
+	},
+}
+
+var deleteAlertRuleCmd = &cobra.Command{
+	Use:   "delete <alert-rule-id>",
+	Short: "Delete an alert rule",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return call(func(ctx context.Context, client gatewaypb.LedgerServiceApiClient) error {
+			resp, err := client.DeleteAlertRule(ctx, &gatewaypb.DeleteAlertRuleRequest{
+				AlertRuleId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return printResponse(resp, "DELETED", args[0])
+		})
+	},
+}
+
+var listTriggeredAlertsCmd = &cobra.Command{
+	Use:   "list <account-id>",
+	Short: "List triggered alerts of an account",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return call(func(ctx context.Context, client gatewaypb.LedgerServiceApiClient) error {
+			resp, err := client.ListTriggeredAlerts(ctx, &gatewaypb.ListTriggeredAlertsRequest{
+				AccountId:          args[0],
+				UnacknowledgedOnly: listAlertsUnacknowledgedOnly,
+			})
+			if err != nil {
+				return err
+			}
+			return printResponse(resp, triggeredAlertHeader, triggeredAlertRows(resp.TriggeredAlerts)...)
+		})
+	},
+}
+
+var ackAlertCmd = &cobra.Command{
+	Use:   "ack <triggered-alert-id>",
+	Short: "Acknowledge a triggered alert",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return call(func(ctx context.Context, client gatewaypb.LedgerServiceApiClient) error {
+			resp, err := client.AckAlert(ctx, &gatewaypb.AckAlertRequest{
+				TriggeredAlertId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return printResponse(resp, triggeredAlertHeader, triggeredAlertRow(resp.TriggeredAlert))
+		})
+	},
+}
+
+func init() {
+	addGatewayCommand(alertsCmd)
+	alertsCmd.AddCommand(alertRulesCmd)
+	alertsCmd.AddCommand(listTriggeredAlertsCmd)
+	alertsCmd.AddCommand(ackAlertCmd)
+	alertRulesCmd.AddCommand(listAlertRulesCmd)
+	alertRulesCmd.AddCommand(createAlertRuleCmd)
+	alertRulesCmd.AddCommand(deleteAlertRuleCmd)
+
+	createAlertRuleCmd.Flags().StringVarP(&createAlertRuleAccountId, "account-id", "", "", "Account id")
+	createAlertRuleCmd.Flags().Int64VarP(&createAlertRuleThreshold, "threshold", "", 0, "Threshold of available balance")
+	createAlertRuleCmd.Flags().StringVarP(&createAlertRuleDirection, "direction", "", "below", "Direction of crossing: below or above")
+	err := createAlertRuleCmd.MarkFlagRequired("account-id")
+	if err != nil {
+		panic(err)
+	}
+
+	listTriggeredAlertsCmd.Flags().BoolVarP(&listAlertsUnacknowledgedOnly, "unacknowledged", "", false, "Only unacknowledged alerts")
+}
@@ -0,0 +1,151 @@
+package commands
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/evrblk/monstera"
+	"github.com/evrblk/monstera-example/ledger"
+	"github.com/evrblk/monstera-example/ledger/corepb"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const archiveBatchSize = 1000
+
+var (
+	archiveBefore     string
+	archiveOutput     string
+	archiveExportOnly bool
+)
+
+var archiveTransactionsCmd = &cobra.Command{
+	Use:   "archive-transactions",
+	Short: "Export settled and cancelled transactions older than a cutoff to a file and archive them",
+	Run: func(cmd *cobra.Command, args []string) {
+		before, err := time.Parse(time.DateOnly, archiveBefore)
+		if err != nil {
+			log.Fatalf("invalid cutoff date: %v", err)
+		}
+		if before.After(time.Now()) {
+			log.Fatalf("cutoff date is in the future")
+		}
+
+		// Monstera cluster config
+		data, err := os.ReadFile("./cluster_config.pb")
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		clusterConfig, err := monstera.LoadConfigFromProto(data)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		// Monstera client
+		monsteraClient := monstera.NewMonsteraClient(clusterConfig)
+
+		// LedgerService client
+		ledgerServiceCoreApiClient := ledger.NewLedgerServiceCoreApiMonsteraStub(monsteraClient, &ledger.ShardKeyCalculator{})
+
+		shards, err := monsteraClient.ListShards("Accounts")
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		ctx := context.Background()
+
+		// Export archivable transactions of all shards first, one JSON per line
+		file, err := os.Create(archiveOutput)
+		if err != nil {
+			log.Fatal(err)
+		}
+		writer := bufio.NewWriter(file)
+
+		exported := 0
+		for _, shard := range shards {
+			var after *corepb.TransactionId
+			for {
+				resp, err := ledgerServiceCoreApiClient.ListArchivableTransactions(ctx, &corepb.ListArchivableTransactionsRequest{
+					Before: before.UnixNano(),
+					After:  after,
+					Limit:  archiveBatchSize,
+					Now:    time.Now().UnixNano(),
+				}, shard.Id)
+				if err != nil {
+					log.Fatalf("could not list archivable transactions: %v", err)
+				}
+
+				for _, transaction := range resp.Transactions {
+					line, err := protojson.Marshal(transaction)
+					if err != nil {
+						log.Fatal(err)
+					}
+					writer.Write(line)
+					writer.WriteString("\n")
+				}
+				exported += len(resp.Transactions)
+
+				if len(resp.Transactions) < archiveBatchSize {
+					break
+				}
+				after = resp.Transactions[len(resp.Transactions)-1].Id
+			}
+		}
+
+		if err := writer.Flush(); err != nil {
+			log.Fatal(err)
+		}
+		if err := file.Sync(); err != nil {
+			log.Fatal(err)
+		}
+		if err := file.Close(); err != nil {
+			log.Fatal(err)
+		}
+
+		fmt.Printf("exported %d transactions to %s\n", exported, archiveOutput)
+
+		if archiveExportOnly {
+			return
+		}
+
+		// Archive exported transactions, final transactions never change so the same ones are archived
+		archived := 0
+		for _, shard := range shards {
+			for {
+				resp, err := ledgerServiceCoreApiClient.ArchiveTransactions(ctx, &corepb.ArchiveTransactionsRequest{
+					Before: before.UnixNano(),
+					Limit:  archiveBatchSize,
+					Now:    time.Now().UnixNano(),
+				}, shard.Id)
+				if err != nil {
+					log.Fatalf("could not archive transactions: %v", err)
+				}
+
+				archived += int(resp.ArchivedTransactions)
+
+				if resp.ArchivedTransactions == 0 {
+					break
+				}
+			}
+		}
+
+		fmt.Printf("archived %d transactions\n", archived)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(archiveTransactionsCmd)
+
+	archiveTransactionsCmd.PersistentFlags().StringVarP(&archiveBefore, "before", "", "", "Cutoff date (YYYY-MM-DD, UTC)")
+	archiveTransactionsCmd.PersistentFlags().StringVarP(&archiveOutput, "output", "", "archive.jsonl", "Export file path")
+	archiveTransactionsCmd.PersistentFlags().BoolVarP(&archiveExportOnly, "export-only", "", false, "Only export, do not archive")
+	err := archiveTransactionsCmd.MarkPersistentFlagRequired("before")
+	if err != nil {
+		panic(err)
+	}
+}
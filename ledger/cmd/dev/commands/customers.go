@@ -0,0 +1,89 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/evrblk/monstera-example/ledger/gatewaypb"
+	"github.com/spf13/cobra"
+)
+
+var (
+	createCustomerName  string
+	createCustomerEmail string
+)
+
+var customersCmd = &cobra.Command{
+	Use:   "customers",
+	Short: "Manage customers",
+}
+
+var createCustomerCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a customer",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return call(func(ctx context.Context, client gatewaypb.LedgerServiceApiClient) error {
+			resp, err := client.CreateCustomer(ctx, &gatewaypb.CreateCustomerRequest{
+				Name:  createCustomerName,
+				Email: createCustomerEmail,
+			})
+			if err != nil {
+				return err
+			}
+			return printResponse(resp, customerHeader, customerRow(resp.Customer))
+		})
+	},
+}
+
+var listCustomerAccountsCmd = &cobra.Command{
+	Use:   "accounts <customer-id>",
+	Short: "List account ids of a customer",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return call(func(ctx context.Context, client gatewaypb.LedgerServiceApiClient) error {
+			resp, err := client.ListCustomerAccounts(ctx, &gatewaypb.ListCustomerAccountsRequest{
+				CustomerId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return printResponse(resp, "ACCOUNT ID", resp.AccountIds...)
+		})
+	},
+}
+
+var getCustomerBalancesCmd = &cobra.Command{
+	Use:   "balances <customer-id>",
+	Short: "Get balances of all accounts of a customer and their totals",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return call(func(ctx context.Context, client gatewaypb.LedgerServiceApiClient) error {
+			resp, err := client.GetCustomerBalances(ctx, &gatewaypb.GetCustomerBalancesRequest{
+				CustomerId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			rows := accountRows(resp.Accounts)
+			rows = append(rows, fmt.Sprintf("TOTAL\t%d\t%d\t%d\t\t\t",
+				resp.TotalAvailableBalance, resp.TotalSettledBalance, resp.TotalPendingAvailabilityBalance))
+			return printResponse(resp, accountHeader, rows...)
+		})
+	},
+}
+
+func init() {
+	addGatewayCommand(customersCmd)
+	customersCmd.AddCommand(createCustomerCmd)
+	customersCmd.AddCommand(listCustomerAccountsCmd)
+	customersCmd.AddCommand(getCustomerBalancesCmd)
+
+	createCustomerCmd.Flags().StringVarP(&createCustomerName, "name", "", "", "Customer name")
+	createCustomerCmd.Flags().StringVarP(&createCustomerEmail, "email", "", "", "Customer email")
+	err := createCustomerCmd.MarkFlagRequired("name")
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,102 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/evrblk/monstera-example/ledger/gatewaypb"
+	"github.com/spf13/cobra"
+)
+
+var (
+	openDisputeAmount      int64
+	openDisputeDescription string
+	resolveDisputeWon      bool
+)
+
+var disputesCmd = &cobra.Command{
+	Use:   "disputes",
+	Short: "Manage disputes",
+}
+
+var getDisputeCmd = &cobra.Command{
+	Use:   "get <dispute-id>",
+	Short: "Get a dispute",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return call(func(ctx context.Context, client gatewaypb.LedgerServiceApiClient) error {
+			resp, err := client.GetDispute(ctx, &gatewaypb.GetDisputeRequest{
+				DisputeId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return printResponse(resp, disputeHeader, disputeRow(resp.Dispute))
+		})
+	},
+}
+
+var listDisputesCmd = &cobra.Command{
+	Use:   "list <account-id>",
+	Short: "List disputes of an account",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return call(func(ctx context.Context, client gatewaypb.LedgerServiceApiClient) error {
+			resp, err := client.ListDisputes(ctx, &gatewaypb.ListDisputesRequest{
+				AccountId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return printResponse(resp, disputeHeader, disputeRows(resp.Disputes)...)
+		})
+	},
+}
+
+var openDisputeCmd = &cobra.Command{
+	Use:   "open <transaction-id>",
+	Short: "Open a dispute for a settled purchase",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return call(func(ctx context.Context, client gatewaypb.LedgerServiceApiClient) error {
+			resp, err := client.OpenDispute(ctx, &gatewaypb.OpenDisputeRequest{
+				TransactionId: args[0],
+				Amount:        openDisputeAmount,
+				Description:   openDisputeDescription,
+			})
+			if err != nil {
+				return err
+			}
+			return printResponse(resp, disputeHeader, disputeRow(resp.Dispute))
+		})
+	},
+}
+
+var resolveDisputeCmd = &cobra.Command{
+	Use:   "resolve <dispute-id>",
+	Short: "Resolve an opened dispute as won (--won) or lost",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return call(func(ctx context.Context, client gatewaypb.LedgerServiceApiClient) error {
+			resp, err := client.ResolveDispute(ctx, &gatewaypb.ResolveDisputeRequest{
+				DisputeId: args[0],
+				Won:       resolveDisputeWon,
+			})
+			if err != nil {
+				return err
+			}
+			return printResponse(resp, disputeHeader, disputeRow(resp.Dispute))
+		})
+	},
+}
+
+func init() {
+	addGatewayCommand(disputesCmd)
+	disputesCmd.AddCommand(getDisputeCmd)
+	disputesCmd.AddCommand(listDisputesCmd)
+	disputesCmd.AddCommand(openDisputeCmd)
+	disputesCmd.AddCommand(resolveDisputeCmd)
+
+	openDisputeCmd.Flags().Int64VarP(&openDisputeAmount, "amount", "", 0, "Disputed amount (positive), full amount of the transaction if 0")
+	openDisputeCmd.Flags().StringVarP(&openDisputeDescription, "description", "", "", "Description")
+	resolveDisputeCmd.Flags().BoolVarP(&resolveDisputeWon, "won", "", false, "Dispute is won")
+}
@@ -0,0 +1,150 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evrblk/monstera-example/ledger/gatewaypb"
+	"github.com/spf13/cobra"
+)
+
+var (
+	holdEscrowName          string
+	holdEscrowAmount        int64
+	holdEscrowAutoReleaseAt string
+	splitEscrowAmount       int64
+)
+
+var escrowsCmd = &cobra.Command{
+	Use:   "escrows",
+	Short: "Manage escrows",
+}
+
+var getEscrowCmd = &cobra.Command{
+	Use:   "get <escrow-id>",
+	Short: "Get an escrow",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return call(func(ctx context.Context, client gatewaypb.LedgerServiceApiClient) error {
+			resp, err := client.GetEscrow(ctx, &gatewaypb.GetEscrowRequest{
+				EscrowId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return printResponse(resp, escrowHeader, escrowRow(resp.Escrow))
+		})
+	},
+}
+
+var listEscrowsCmd = &cobra.Command{
+	Use:   "list <account-id>",
+	Short: "List escrows of an account",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return call(func(ctx context.Context, client gatewaypb.LedgerServiceApiClient) error {
+			resp, err := client.ListEscrows(ctx, &gatewaypb.ListEscrowsRequest{
+				AccountId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return printResponse(resp, escrowHeader, escrowRows(resp.Escrows)...)
+		})
+	},
+}
+
+var holdEscrowCmd = &cobra.Command{
+	Use:   "hold <account-id>",
+	Short: "Move funds out of available balance into an escrow",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var autoReleaseAt int64
+		if holdEscrowAutoReleaseAt != "" {
+			t, err := time.Parse(time.RFC3339, holdEscrowAutoReleaseAt)
+			if err != nil {
+				return fmt.Errorf("invalid auto release deadline: %w", err)
+			}
+			autoReleaseAt = t.UnixNano()
+		}
+
+		return call(func(ctx context.Context, client gatewaypb.LedgerServiceApiClient) error {
+			resp, err := client.HoldEscrow(ctx, &gatewaypb.HoldEscrowRequest{
+				AccountId:     args[0],
+				Name:          holdEscrowName,
+				Amount:        holdEscrowAmount,
+				AutoReleaseAt: autoReleaseAt,
+			})
+			if err != nil {
+				return err
+			}
+			return printResponse(resp, escrowHeader, escrowRow(resp.Escrow))
+		})
+	},
+}
+
+var releaseEscrowCmd = &cobra.Command{
+	Use:   "release <escrow-id>",
+	Short: "Release the full amount of a held escrow, it is debited from settled balance",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return resolveEscrow(args[0], gatewaypb.EscrowResolution_ESCROW_RESOLUTION_RELEASE, 0)
+	},
+}
+
+var refundEscrowCmd = &cobra.Command{
+	Use:   "refund <escrow-id>",
+	Short: "Refund the full amount of a held escrow to available balance",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return resolveEscrow(args[0], gatewaypb.EscrowResolution_ESCROW_RESOLUTION_REFUND, 0)
+	},
+}
+
+var splitEscrowCmd = &cobra.Command{
+	Use:   "split <escrow-id>",
+	Short: "Release a part of a held escrow and refund the rest",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return resolveEscrow(args[0], gatewaypb.EscrowResolution_ESCROW_RESOLUTION_SPLIT, splitEscrowAmount)
+	},
+}
+
+func resolveEscrow(escrowId string, resolution gatewaypb.EscrowResolution, releasedAmount int64) error {
+	return call(func(ctx context.Context, client gatewaypb.LedgerServiceApiClient) error {
+		resp, err := client.ReleaseEscrow(ctx, &gatewaypb.ReleaseEscrowRequest{
+			EscrowId:       escrowId,
+			Resolution:     resolution,
+			ReleasedAmount: releasedAmount,
+		})
+		if err != nil {
+			return err
+		}
+		return printResponse(resp, escrowHeader, escrowRow(resp.Escrow))
+	})
+}
+
+func init() {
+	addGatewayCommand(escrowsCmd)
+	escrowsCmd.AddCommand(getEscrowCmd)
+	escrowsCmd.AddCommand(listEscrowsCmd)
+	escrowsCmd.AddCommand(holdEscrowCmd)
+	escrowsCmd.AddCommand(releaseEscrowCmd)
+	escrowsCmd.AddCommand(refundEscrowCmd)
+	escrowsCmd.AddCommand(splitEscrowCmd)
+
+	holdEscrowCmd.Flags().StringVarP(&holdEscrowName, "name", "", "", "Name of the escrow, e.g. an order id")
+	holdEscrowCmd.Flags().Int64VarP(&holdEscrowAmount, "amount", "", 0, "Held amount (positive)")
+	holdEscrowCmd.Flags().StringVarP(&holdEscrowAutoReleaseAt, "auto-release-at", "", "", "Deadline of auto-release in full (RFC3339)")
+	splitEscrowCmd.Flags().Int64VarP(&splitEscrowAmount, "released-amount", "", 0, "Released amount, the rest is refunded")
+
+	err := holdEscrowCmd.MarkFlagRequired("amount")
+	if err != nil {
+		panic(err)
+	}
+	err = splitEscrowCmd.MarkFlagRequired("released-amount")
+	if err != nil {
+		panic(err)
+	}
+}
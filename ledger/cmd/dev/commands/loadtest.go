@@ -0,0 +1,446 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/evrblk/monstera-example/ledger/gatewaypb"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	loadTestCreate = "create"
+	loadTestSettle = "settle"
+	loadTestCancel = "cancel"
+	loadTestGet    = "get"
+)
+
+var loadTestOperations = []string{loadTestCreate, loadTestSettle, loadTestCancel, loadTestGet}
+
+// upper bounds of latency histogram buckets, the last bucket is unbounded
+var loadTestBuckets = []time.Duration{
+	250 * time.Microsecond,
+	500 * time.Microsecond,
+	time.Millisecond,
+	2500 * time.Microsecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+}
+
+var (
+	loadTestAccounts       int
+	loadTestConcurrency    int
+	loadTestDuration       time.Duration
+	loadTestMix            string
+	loadTestInitialBalance int64
+	loadTestOutput         string
+)
+
+var loadTestCmd = &cobra.Command{
+	Use:   "loadtest",
+	Short: "Generate load against the gateway and report throughput, latencies and errors per RPC",
+	Long: `Creates accounts with an initial settled topup, then runs a mix of operations from many goroutines
+for a fixed duration:
+
+  create  CreateTransaction, a pending purchase on a random account
+  settle  SettleTransaction of a pending purchase created before
+  cancel  CancelTransaction of a pending purchase created before
+  get     GetAccount of a random account
+
+Settle and cancel fall back to create when there are no pending purchases to use.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if loadTestAccounts < 1 {
+			log.Fatalf("invalid accounts: %d, expected at least 1", loadTestAccounts)
+		}
+		if loadTestConcurrency < 1 {
+			log.Fatalf("invalid concurrency: %d, expected at least 1", loadTestConcurrency)
+		}
+
+		weights, err := parseLoadTestMix(loadTestMix)
+		if err != nil {
+			log.Fatalf("invalid mix: %v", err)
+		}
+
+		conn, err := grpc.NewClient(gatewayAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Fatalf("did not connect: %v", err)
+		}
+		defer conn.Close()
+
+		client := gatewaypb.NewLedgerServiceApiClient(conn)
+
+		log.Printf("Creating %d accounts...", loadTestAccounts)
+		accountIds, err := createLoadTestAccounts(client, loadTestAccounts, loadTestConcurrency, loadTestInitialBalance)
+		if err != nil {
+			log.Fatalf("could not create accounts: %v", err)
+		}
+
+		log.Printf("Running %s mix with %d goroutines for %s...", loadTestMix, loadTestConcurrency, loadTestDuration)
+		result := runLoadTest(client, accountIds, weights)
+
+		printLoadTestResult(result)
+
+		if loadTestOutput != "" {
+			data, err := json.MarshalIndent(result, "", "  ")
+			if err != nil {
+				log.Fatal(err)
+			}
+			err = os.WriteFile(loadTestOutput, data, 0644)
+			if err != nil {
+				log.Fatalf("could not write results: %v", err)
+			}
+			log.Printf("Results are written to %s", loadTestOutput)
+		}
+	},
+}
+
+// loadTestResult is a report of a run, it is also exported as JSON for comparison between runs
+type loadTestResult struct {
+	StartedAt   time.Time                           `json:"started_at"`
+	DurationSec float64                             `json:"duration_sec"`
+	Accounts    int                                 `json:"accounts"`
+	Concurrency int                                 `json:"concurrency"`
+	Mix         string                              `json:"mix"`
+	Total       loadTestOperationResult             `json:"total"`
+	Operations  map[string]*loadTestOperationResult `json:"operations"`
+}
+
+type loadTestOperationResult struct {
+	Count      int            `json:"count"`
+	Errors     int            `json:"errors"`
+	ErrorRate  float64        `json:"error_rate"`
+	Throughput float64        `json:"throughput"`
+	ErrorCodes map[string]int `json:"error_codes,omitempty"`
+	LatencyMs  struct {
+		Mean float64 `json:"mean"`
+		P50  float64 `json:"p50"`
+		P95  float64 `json:"p95"`
+		P99  float64 `json:"p99"`
+		Max  float64 `json:"max"`
+	} `json:"latency_ms"`
+	Histogram []loadTestBucket `json:"histogram"`
+}
+
+type loadTestBucket struct {
+	// upper bound of the bucket, 0 for the last unbounded bucket
+	UpToMs float64 `json:"up_to_ms"`
+	Count  int     `json:"count"`
+}
+
+// loadTestSamples are latencies and errors of one operation collected by one goroutine, they are merged after the run
+type loadTestSamples struct {
+	latencies  []time.Duration
+	errorCodes map[string]int
+}
+
+func (s *loadTestSamples) add(latency time.Duration, err error) {
+	s.latencies = append(s.latencies, latency)
+	if err != nil {
+		if s.errorCodes == nil {
+			s.errorCodes = make(map[string]int)
+		}
+		s.errorCodes[status.Code(err).String()]++
+	}
+}
+
+func (s *loadTestSamples) merge(other *loadTestSamples) {
+	s.latencies = append(s.latencies, other.latencies...)
+	for code, n := range other.errorCodes {
+		if s.errorCodes == nil {
+			s.errorCodes = make(map[string]int)
+		}
+		s.errorCodes[code] += n
+	}
+}
+
+func (s *loadTestSamples) result(duration time.Duration) *loadTestOperationResult {
+	result := &loadTestOperationResult{
+		Count:      len(s.latencies),
+		ErrorCodes: s.errorCodes,
+	}
+	for _, n := range s.errorCodes {
+		result.Errors += n
+	}
+
+	result.Histogram = make([]loadTestBucket, len(loadTestBuckets)+1)
+	for i, bound := range loadTestBuckets {
+		result.Histogram[i].UpToMs = milliseconds(bound)
+	}
+
+	if result.Count == 0 {
+		return result
+	}
+
+	result.ErrorRate = float64(result.Errors) / float64(result.Count)
+	result.Throughput = float64(result.Count) / duration.Seconds()
+
+	latencies := slices.Clone(s.latencies)
+	slices.Sort(latencies)
+
+	var sum time.Duration
+	for _, latency := range latencies {
+		sum += latency
+		bucket, _ := slices.BinarySearch(loadTestBuckets, latency)
+		result.Histogram[bucket].Count++
+	}
+
+	percentile := func(p float64) float64 {
+		return milliseconds(latencies[int(p*float64(len(latencies)-1))])
+	}
+
+	result.LatencyMs.Mean = milliseconds(sum / time.Duration(len(latencies)))
+	result.LatencyMs.P50 = percentile(0.50)
+	result.LatencyMs.P95 = percentile(0.95)
+	result.LatencyMs.P99 = percentile(0.99)
+	result.LatencyMs.Max = milliseconds(latencies[len(latencies)-1])
+
+	return result
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// parseLoadTestMix parses "create=40,settle=20,cancel=10,get=30" into weights of operations
+func parseLoadTestMix(mix string) (map[string]int, error) {
+	weights := make(map[string]int)
+	total := 0
+	for _, part := range strings.Split(mix, ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok || !slices.Contains(loadTestOperations, name) {
+			return nil, fmt.Errorf("expected <operation>=<weight> with operations %v, got %q", loadTestOperations, part)
+		}
+		weight, err := strconv.Atoi(value)
+		if err != nil || weight < 0 {
+			return nil, fmt.Errorf("invalid weight of %s: %q", name, value)
+		}
+		weights[name] = weight
+		total += weight
+	}
+	if total == 0 {
+		return nil, fmt.Errorf("sum of weights is 0")
+	}
+	return weights, nil
+}
+
+func createLoadTestAccounts(client gatewaypb.LedgerServiceApiClient, n int, concurrency int, initialBalance int64) ([]string, error) {
+	accountIds := make([]string, n)
+	errs := make(chan error, n)
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			ctx := context.Background()
+
+			resp, err := client.CreateAccount(ctx, &gatewaypb.CreateAccountRequest{})
+			if err != nil {
+				errs <- err
+				return
+			}
+			accountIds[i] = resp.Account.Id
+
+			_, err = client.CreateTransaction(ctx, &gatewaypb.CreateTransactionRequest{
+				AccountId:   resp.Account.Id,
+				Amount:      initialBalance,
+				Description: "Load test topup",
+				Settled:     true,
+			})
+			if err != nil {
+				errs <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	return accountIds, <-errs
+}
+
+func runLoadTest(client gatewaypb.LedgerServiceApiClient, accountIds []string, weights map[string]int) *loadTestResult {
+	// pending purchases created during the run, settle and cancel take them from here
+	pending := make(chan string, 100_000)
+
+	totalWeight := 0
+	for _, weight := range weights {
+		totalWeight += weight
+	}
+
+	pickOperation := func() string {
+		n := rand.IntN(totalWeight)
+		for _, operation := range loadTestOperations {
+			n -= weights[operation]
+			if n < 0 {
+				return operation
+			}
+		}
+		panic("unreachable")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), loadTestDuration)
+	defer cancel()
+
+	samples := make([]map[string]*loadTestSamples, loadTestConcurrency)
+
+	startedAt := time.Now()
+
+	var wg sync.WaitGroup
+	for w := 0; w < loadTestConcurrency; w++ {
+		samples[w] = make(map[string]*loadTestSamples)
+		for _, operation := range loadTestOperations {
+			samples[w][operation] = &loadTestSamples{}
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for ctx.Err() == nil {
+				operation := pickOperation()
+
+				var transactionId string
+				if operation == loadTestSettle || operation == loadTestCancel {
+					select {
+					case transactionId = <-pending:
+					default:
+						operation = loadTestCreate
+					}
+				}
+
+				start := time.Now()
+				var err error
+				switch operation {
+				case loadTestCreate:
+					var resp *gatewaypb.CreateTransactionResponse
+					resp, err = client.CreateTransaction(ctx, &gatewaypb.CreateTransactionRequest{
+						AccountId:   accountIds[rand.IntN(len(accountIds))],
+						Amount:      -rand.Int64N(100) - 1,
+						Description: "Load test purchase",
+					})
+					if err == nil && resp.Transaction.Status == gatewaypb.TransactionStatus_TRANSACTION_STATUS_PENDING {
+						select {
+						case pending <- resp.Transaction.Id:
+						default:
+						}
+					}
+				case loadTestSettle:
+					_, err = client.SettleTransaction(ctx, &gatewaypb.SettleTransactionRequest{
+						TransactionId: transactionId,
+					})
+				case loadTestCancel:
+					_, err = client.CancelTransaction(ctx, &gatewaypb.CancelTransactionRequest{
+						TransactionId: transactionId,
+					})
+				case loadTestGet:
+					_, err = client.GetAccount(ctx, &gatewaypb.GetAccountRequest{
+						AccountId: accountIds[rand.IntN(len(accountIds))],
+					})
+				}
+				latency := time.Since(start)
+
+				// calls interrupted by the end of the run are not counted
+				if ctx.Err() != nil {
+					return
+				}
+
+				samples[w][operation].add(latency, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	duration := time.Since(startedAt)
+
+	result := &loadTestResult{
+		StartedAt:   startedAt,
+		DurationSec: duration.Seconds(),
+		Accounts:    len(accountIds),
+		Concurrency: loadTestConcurrency,
+		Mix:         loadTestMix,
+		Operations:  make(map[string]*loadTestOperationResult),
+	}
+
+	total := &loadTestSamples{}
+	for _, operation := range loadTestOperations {
+		merged := &loadTestSamples{}
+		for w := range samples {
+			merged.merge(samples[w][operation])
+		}
+		total.merge(merged)
+		result.Operations[operation] = merged.result(duration)
+	}
+	result.Total = *total.result(duration)
+
+	return result
+}
+
+func printLoadTestResult(result *loadTestResult) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "OPERATION\tCOUNT\tRPS\tERRORS\tERROR RATE\tMEAN\tP50\tP95\tP99\tMAX")
+	row := func(name string, r *loadTestOperationResult) {
+		fmt.Fprintf(w, "%s\t%d\t%.1f\t%d\t%.2f%%\t%.2fms\t%.2fms\t%.2fms\t%.2fms\t%.2fms\n",
+			name, r.Count, r.Throughput, r.Errors, r.ErrorRate*100,
+			r.LatencyMs.Mean, r.LatencyMs.P50, r.LatencyMs.P95, r.LatencyMs.P99, r.LatencyMs.Max)
+	}
+	for _, operation := range loadTestOperations {
+		row(operation, result.Operations[operation])
+	}
+	row("total", &result.Total)
+	w.Flush()
+
+	for _, operation := range loadTestOperations {
+		r := result.Operations[operation]
+		if r.Count == 0 {
+			continue
+		}
+
+		fmt.Printf("\n%s latency histogram\n", operation)
+		for i, bucket := range r.Histogram {
+			label := fmt.Sprintf("> %gms", r.Histogram[len(r.Histogram)-2].UpToMs)
+			if i < len(r.Histogram)-1 {
+				label = fmt.Sprintf("<= %gms", bucket.UpToMs)
+			}
+			fmt.Printf("  %10s %8d %s\n", label, bucket.Count, strings.Repeat("#", bucket.Count*50/r.Count))
+		}
+
+		for code, n := range r.ErrorCodes {
+			fmt.Printf("  error %s: %d\n", code, n)
+		}
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(loadTestCmd)
+
+	loadTestCmd.Flags().IntVarP(&loadTestAccounts, "accounts", "", 1000, "Number of accounts to create")
+	loadTestCmd.Flags().IntVarP(&loadTestConcurrency, "concurrency", "", 64, "Number of goroutines sending requests")
+	loadTestCmd.Flags().DurationVarP(&loadTestDuration, "duration", "", 30*time.Second, "Duration of the run (after accounts are created)")
+	loadTestCmd.Flags().StringVarP(&loadTestMix, "mix", "", "create=40,settle=20,cancel=10,get=30", "Weights of operations")
+	loadTestCmd.Flags().Int64VarP(&loadTestInitialBalance, "initial-balance", "", 1_000_000, "Settled topup of every created account")
+	loadTestCmd.Flags().StringVarP(&loadTestOutput, "output", "", "", "Write results as JSON to this file")
+}
@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/evrblk/monstera"
+	"github.com/evrblk/monstera-example/ledger"
+	"github.com/evrblk/monstera-example/ledger/corepb"
+	"github.com/spf13/cobra"
+)
+
+var rebuildShardStatsCmd = &cobra.Command{
+	Use:   "rebuild-shard-stats",
+	Short: "Recompute running aggregates on all shards of Accounts",
+	Run: func(cmd *cobra.Command, args []string) {
+		// Monstera cluster config
+		data, err := os.ReadFile("./cluster_config.pb")
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		clusterConfig, err := monstera.LoadConfigFromProto(data)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		// Monstera client
+		monsteraClient := monstera.NewMonsteraClient(clusterConfig)
+
+		// LedgerService client
+		ledgerServiceCoreApiClient := ledger.NewLedgerServiceCoreApiMonsteraStub(monsteraClient, &ledger.ShardKeyCalculator{})
+
+		shards, err := monsteraClient.ListShards("Accounts")
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		for _, shard := range shards {
+			resp, err := ledgerServiceCoreApiClient.RebuildShardStats(context.Background(), &corepb.RebuildShardStatsRequest{
+				Now: time.Now().UnixNano(),
+			}, shard.Id)
+			if err != nil {
+				log.Fatalf("could not rebuild stats: %v", err)
+			}
+
+			fmt.Printf("shard %s: %d accounts, %d pending transactions\n", shard.Id, resp.Stats.AccountsCount, resp.Stats.PendingTransactionsCount)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(rebuildShardStatsCmd)
+}
@@ -1,21 +1,127 @@
 package commands
 
 import (
+	"context"
+	"fmt"
 	"os"
+	"text/tabwriter"
+	"time"
 
+	"github.com/evrblk/monstera-example/ledger/gatewaypb"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// exitUsage is an exit code of invalid arguments and flags, failed gateway calls exit with gRPC status code
+const exitUsage = 64
+
+var (
+	gatewayAddress string
+	outputFormat   string
+	callTimeout    time.Duration
 )
 
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
 	Use:   "dev",
 	Short: "Run dev tools and scripts",
-	Long:  "",
+	Long: "Run dev tools and scripts, and manage accounts and transactions via ledger gateway API.\n\n" +
+		"Exit code is the gRPC status code of a failed call (e.g. 5 for NOT_FOUND), or 64 for invalid arguments.",
+	SilenceErrors: true,
 }
 
 func Execute() {
 	err := rootCmd.Execute()
 	if err != nil {
-		os.Exit(1)
+		if st, ok := status.FromError(err); ok {
+			fmt.Fprintf(os.Stderr, "Error: %s: %s\n", st.Code(), st.Message())
+		} else {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		}
+		os.Exit(exitCode(err))
+	}
+}
+
+func init() {
+	defaultAddress := os.Getenv("LEDGER_GATEWAY_ADDRESS")
+	if defaultAddress == "" {
+		defaultAddress = "localhost:8000"
+	}
+
+	rootCmd.PersistentFlags().StringVarP(&gatewayAddress, "address", "", defaultAddress, "Gateway address (or LEDGER_GATEWAY_ADDRESS)")
+	rootCmd.PersistentFlags().DurationVarP(&callTimeout, "timeout", "", 10*time.Second, "Timeout of a gateway call")
+}
+
+// addGatewayCommand adds a resource command group calling gateway API, with -o flag shared by its subcommands
+func addGatewayCommand(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "table", "Output format: table or json")
+	cmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		if outputFormat != "table" && outputFormat != "json" {
+			return fmt.Errorf("invalid output format %q, expected table or json", outputFormat)
+		}
+		// arguments are valid, a failed call should not print usage
+		cmd.SilenceUsage = true
+		return nil
+	}
+	rootCmd.AddCommand(cmd)
+}
+
+func exitCode(err error) int {
+	if st, ok := status.FromError(err); ok {
+		return int(st.Code())
+	}
+	return exitUsage
+}
+
+// call connects to the gateway and calls fn with a context limited by --timeout
+func call(fn func(ctx context.Context, client gatewaypb.LedgerServiceApiClient) error) error {
+	conn, err := grpc.NewClient(gatewayAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+	defer cancel()
+
+	return fn(ctx, gatewaypb.NewLedgerServiceApiClient(conn))
+}
+
+// printResponse writes a response as JSON, or as a table with the given tab separated header and rows
+func printResponse(response proto.Message, header string, rows ...string) error {
+	if outputFormat == "json" {
+		data, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(response)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, header)
+	for _, row := range rows {
+		fmt.Fprintln(w, row)
+	}
+	return w.Flush()
+}
+
+// formatTime formats unix nanoseconds, 0 is formatted as an empty value
+func formatTime(ts int64) string {
+	if ts == 0 {
+		return "-"
+	}
+	return time.Unix(0, ts).UTC().Format(time.RFC3339)
+}
+
+// formatId formats an optional id
+func formatId(id string) string {
+	if id == "" {
+		return "-"
 	}
+	return id
 }
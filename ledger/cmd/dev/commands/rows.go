@@ -0,0 +1,127 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/evrblk/monstera-example/ledger/gatewaypb"
+)
+
+const (
+	accountHeader        = "ID\tAVAILABLE\tSETTLED\tPENDING AVAILABILITY\tESCROW\tCUSTOMER\tCREATED\tUPDATED"
+	transactionHeader    = "ID\tAMOUNT\tSTATUS\tCATEGORY\tDESCRIPTION\tAVAILABLE AT\tDISPUTE\tCREATED\tUPDATED"
+	customerHeader       = "ID\tNAME\tEMAIL\tACCOUNTS\tCREATED\tUPDATED"
+	disputeHeader        = "ID\tTRANSACTION\tAMOUNT\tSTATUS\tPROVISIONAL\tFINAL\tDESCRIPTION\tCREATED\tUPDATED"
+	escrowHeader         = "ID\tNAME\tAMOUNT\tSTATUS\tRELEASED\tREFUNDED\tAUTO RELEASE AT\tRELEASE TRANSACTION\tCREATED\tUPDATED"
+	alertRuleHeader      = "ID\tTHRESHOLD\tDIRECTION\tCREATED"
+	triggeredAlertHeader = "ID\tALERT RULE\tTHRESHOLD\tDIRECTION\tAVAILABLE\tTRANSACTION\tTRIGGERED\tACKNOWLEDGED"
+	spendingHeader       = "MONTH\tCATEGORY\tSETTLED AMOUNT\tSETTLED COUNT\tPENDING AMOUNT\tPENDING COUNT"
+)
+
+func accountRow(account *gatewaypb.Account) string {
+	return fmt.Sprintf("%s\t%d\t%d\t%d\t%d\t%s\t%s\t%s",
+		account.Id, account.AvailableBalance, account.SettledBalance, account.PendingAvailabilityBalance,
+		account.EscrowBalance, formatId(account.CustomerId), formatTime(account.CreatedAt), formatTime(account.UpdatedAt))
+}
+
+func accountRows(accounts []*gatewaypb.Account) []string {
+	rows := make([]string, len(accounts))
+	for i, account := range accounts {
+		rows[i] = accountRow(account)
+	}
+	return rows
+}
+
+func transactionRow(transaction *gatewaypb.Transaction) string {
+	return fmt.Sprintf("%s\t%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s",
+		transaction.Id, transaction.Amount, strings.TrimPrefix(transaction.Status.String(), "TRANSACTION_STATUS_"),
+		formatId(transaction.Category), formatId(transaction.Description), formatTime(transaction.AvailableAt),
+		formatId(transaction.DisputeId), formatTime(transaction.CreatedAt), formatTime(transaction.UpdatedAt))
+}
+
+func transactionRows(transactions []*gatewaypb.Transaction) []string {
+	rows := make([]string, len(transactions))
+	for i, transaction := range transactions {
+		rows[i] = transactionRow(transaction)
+	}
+	return rows
+}
+
+func customerRow(customer *gatewaypb.Customer) string {
+	return fmt.Sprintf("%s\t%s\t%s\t%d\t%s\t%s",
+		customer.Id, customer.Name, formatId(customer.Email), len(customer.AccountIds),
+		formatTime(customer.CreatedAt), formatTime(customer.UpdatedAt))
+}
+
+func disputeRow(dispute *gatewaypb.Dispute) string {
+	return fmt.Sprintf("%s\t%s\t%d\t%s\t%s\t%s\t%s\t%s\t%s",
+		dispute.Id, dispute.TransactionId, dispute.Amount, strings.TrimPrefix(dispute.Status.String(), "DISPUTE_STATUS_"),
+		formatId(dispute.ProvisionalTransactionId), formatId(dispute.FinalTransactionId), formatId(dispute.Description),
+		formatTime(dispute.CreatedAt), formatTime(dispute.UpdatedAt))
+}
+
+func disputeRows(disputes []*gatewaypb.Dispute) []string {
+	rows := make([]string, len(disputes))
+	for i, dispute := range disputes {
+		rows[i] = disputeRow(dispute)
+	}
+	return rows
+}
+
+func escrowRow(escrow *gatewaypb.Escrow) string {
+	return fmt.Sprintf("%s\t%s\t%d\t%s\t%d\t%d\t%s\t%s\t%s\t%s",
+		escrow.Id, formatId(escrow.Name), escrow.Amount, strings.TrimPrefix(escrow.Status.String(), "ESCROW_STATUS_"),
+		escrow.ReleasedAmount, escrow.RefundedAmount, formatTime(escrow.AutoReleaseAt),
+		formatId(escrow.ReleaseTransactionId), formatTime(escrow.CreatedAt), formatTime(escrow.UpdatedAt))
+}
+
+func escrowRows(escrows []*gatewaypb.Escrow) []string {
+	rows := make([]string, len(escrows))
+	for i, escrow := range escrows {
+		rows[i] = escrowRow(escrow)
+	}
+	return rows
+}
+
+func alertRuleRow(alertRule *gatewaypb.AlertRule) string {
+	return fmt.Sprintf("%s\t%d\t%s\t%s",
+		alertRule.Id, alertRule.Threshold, strings.TrimPrefix(alertRule.Direction.String(), "ALERT_DIRECTION_"),
+		formatTime(alertRule.CreatedAt))
+}
+
+func alertRuleRows(alertRules []*gatewaypb.AlertRule) []string {
+	rows := make([]string, len(alertRules))
+	for i, alertRule := range alertRules {
+		rows[i] = alertRuleRow(alertRule)
+	}
+	return rows
+}
+
+func triggeredAlertRow(alert *gatewaypb.TriggeredAlert) string {
+	acknowledged := "-"
+	if alert.Acknowledged {
+		acknowledged = formatTime(alert.AcknowledgedAt)
+	}
+
+	return fmt.Sprintf("%s\t%s\t%d\t%s\t%d\t%s\t%s\t%s",
+		alert.Id, alert.AlertRuleId, alert.Threshold, strings.TrimPrefix(alert.Direction.String(), "ALERT_DIRECTION_"),
+		alert.AvailableBalance, formatId(alert.TransactionId), formatTime(alert.TriggeredAt), acknowledged)
+}
+
+func triggeredAlertRows(alerts []*gatewaypb.TriggeredAlert) []string {
+	rows := make([]string, len(alerts))
+	for i, alert := range alerts {
+		rows[i] = triggeredAlertRow(alert)
+	}
+	return rows
+}
+
+func spendingRows(summaries []*gatewaypb.SpendingSummary) []string {
+	rows := make([]string, len(summaries))
+	for i, summary := range summaries {
+		rows[i] = fmt.Sprintf("%s\t%s\t%d\t%d\t%d\t%d",
+			summary.Month, formatId(summary.Category), summary.SettledAmount, summary.SettledCount,
+			summary.PendingAmount, summary.PendingCount)
+	}
+	return rows
+}
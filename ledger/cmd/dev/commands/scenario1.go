@@ -19,7 +19,7 @@ var scenario1Cmd = &cobra.Command{
 		fmt.Printf("account id: %s\n", accountId)
 
 		// Connect to grpc server
-		conn, err := grpc.NewClient("localhost:8000", grpc.WithTransportCredentials(insecure.NewCredentials()))
+		conn, err := grpc.NewClient(gatewayAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
 		if err != nil {
 			log.Fatalf("did not connect: %v", err)
 		}
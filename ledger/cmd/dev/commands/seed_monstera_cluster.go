@@ -66,6 +66,12 @@ var (
 			ReplicationFactor: 3,
 			ShardsCount:       16,
 		},
+		{
+			Name:              "Customers",
+			Implementation:    "Customers",
+			ReplicationFactor: 3,
+			ShardsCount:       16,
+		},
 	}
 )
 
@@ -0,0 +1,30 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/evrblk/monstera-example/ledger/gatewaypb"
+	"github.com/spf13/cobra"
+)
+
+var statsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Get ledger-wide totals of balances and pending transactions",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return call(func(ctx context.Context, client gatewaypb.LedgerServiceApiClient) error {
+			resp, err := client.GetLedgerStats(ctx, &gatewaypb.GetLedgerStatsRequest{})
+			if err != nil {
+				return err
+			}
+			return printResponse(resp, "ACCOUNTS\tAVAILABLE\tSETTLED\tPENDING AVAILABILITY\tPENDING TRANSACTIONS",
+				fmt.Sprintf("%d\t%d\t%d\t%d\t%d", resp.AccountsCount, resp.TotalAvailableBalance, resp.TotalSettledBalance,
+					resp.TotalPendingAvailabilityBalance, resp.PendingTransactionsCount))
+		})
+	},
+}
+
+func init() {
+	addGatewayCommand(statsCmd)
+}
@@ -0,0 +1,145 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evrblk/monstera-example/ledger/gatewaypb"
+	"github.com/spf13/cobra"
+)
+
+var (
+	createTransactionAccountId   string
+	createTransactionAmount      int64
+	createTransactionDescription string
+	createTransactionSettled     bool
+	createTransactionAvailableAt string
+	createTransactionCategory    string
+)
+
+var transactionsCmd = &cobra.Command{
+	Use:   "transactions",
+	Short: "Manage transactions",
+}
+
+var getTransactionCmd = &cobra.Command{
+	Use:   "get <transaction-id>",
+	Short: "Get a transaction",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return call(func(ctx context.Context, client gatewaypb.LedgerServiceApiClient) error {
+			resp, err := client.GetTransaction(ctx, &gatewaypb.GetTransactionRequest{
+				TransactionId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return printResponse(resp, transactionHeader, transactionRow(resp.Transaction))
+		})
+	},
+}
+
+var listTransactionsCmd = &cobra.Command{
+	Use:   "list <account-id>",
+	Short: "List transactions of an account",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return call(func(ctx context.Context, client gatewaypb.LedgerServiceApiClient) error {
+			resp, err := client.ListTransactions(ctx, &gatewaypb.ListTransactionsRequest{
+				AccountId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return printResponse(resp, transactionHeader, transactionRows(resp.Transactions)...)
+		})
+	},
+}
+
+var createTransactionCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a transaction, positive amount is a topup and negative amount is a purchase",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var availableAt int64
+		if createTransactionAvailableAt != "" {
+			t, err := time.Parse(time.RFC3339, createTransactionAvailableAt)
+			if err != nil {
+				return fmt.Errorf("invalid value date: %w", err)
+			}
+			availableAt = t.UnixNano()
+		}
+
+		return call(func(ctx context.Context, client gatewaypb.LedgerServiceApiClient) error {
+			resp, err := client.CreateTransaction(ctx, &gatewaypb.CreateTransactionRequest{
+				AccountId:   createTransactionAccountId,
+				Amount:      createTransactionAmount,
+				Description: createTransactionDescription,
+				Settled:     createTransactionSettled,
+				AvailableAt: availableAt,
+				Category:    createTransactionCategory,
+			})
+			if err != nil {
+				return err
+			}
+			return printResponse(resp, transactionHeader, transactionRow(resp.Transaction))
+		})
+	},
+}
+
+var settleTransactionCmd = &cobra.Command{
+	Use:   "settle <transaction-id>",
+	Short: "Settle a pending transaction",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return call(func(ctx context.Context, client gatewaypb.LedgerServiceApiClient) error {
+			resp, err := client.SettleTransaction(ctx, &gatewaypb.SettleTransactionRequest{
+				TransactionId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return printResponse(resp, transactionHeader, transactionRow(resp.Transaction))
+		})
+	},
+}
+
+var cancelTransactionCmd = &cobra.Command{
+	Use:   "cancel <transaction-id>",
+	Short: "Cancel a pending transaction",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return call(func(ctx context.Context, client gatewaypb.LedgerServiceApiClient) error {
+			resp, err := client.CancelTransaction(ctx, &gatewaypb.CancelTransactionRequest{
+				TransactionId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return printResponse(resp, transactionHeader, transactionRow(resp.Transaction))
+		})
+	},
+}
+
+func init() {
+	addGatewayCommand(transactionsCmd)
+	transactionsCmd.AddCommand(getTransactionCmd)
+	transactionsCmd.AddCommand(listTransactionsCmd)
+	transactionsCmd.AddCommand(createTransactionCmd)
+	transactionsCmd.AddCommand(settleTransactionCmd)
+	transactionsCmd.AddCommand(cancelTransactionCmd)
+
+	createTransactionCmd.Flags().StringVarP(&createTransactionAccountId, "account-id", "", "", "Account id")
+	createTransactionCmd.Flags().Int64VarP(&createTransactionAmount, "amount", "", 0, "Amount, negative for purchases")
+	createTransactionCmd.Flags().StringVarP(&createTransactionDescription, "description", "", "", "Description")
+	createTransactionCmd.Flags().BoolVarP(&createTransactionSettled, "settled", "", false, "Create already settled transaction")
+	createTransactionCmd.Flags().StringVarP(&createTransactionAvailableAt, "available-at", "", "", "Value date of a topup (RFC3339)")
+	createTransactionCmd.Flags().StringVarP(&createTransactionCategory, "category", "", "", "Spending category")
+	for _, flag := range []string{"account-id", "amount"} {
+		err := createTransactionCmd.MarkFlagRequired(flag)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
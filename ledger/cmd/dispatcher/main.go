@@ -0,0 +1,71 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/evrblk/monstera"
+	"github.com/evrblk/monstera-example/ledger"
+)
+
+var (
+	monsteraConfigPath = flag.String("monstera-config", "", "Monstera cluster config path")
+	webhooksPath       = flag.String("webhooks", "", "Webhooks config path (JSON array of {url, secret, event_types})")
+	pollInterval       = flag.Duration("poll-interval", time.Second, "Interval between polls of shard outboxes")
+)
+
+func main() {
+	log.Println("Initializing Webhook Dispatcher...")
+
+	flag.Parse()
+
+	// Load monstera cluster config
+	data, err := os.ReadFile(*monsteraConfigPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	clusterConfig, err := monstera.LoadConfigFromProto(data)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	webhooks, err := ledger.LoadWebhooks(*webhooksPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Create Monstera client
+	monsteraClient := monstera.NewMonsteraClient(clusterConfig)
+	monsteraClient.Start()
+	defer monsteraClient.Stop()
+
+	// LedgerService client
+	ledgerServiceCoreApiClient := ledger.NewLedgerServiceCoreApiMonsteraStub(monsteraClient, &ledger.ShardKeyCalculator{})
+
+	// Drain outboxes of all shards of Accounts
+	shards, err := monsteraClient.ListShards("Accounts")
+	if err != nil {
+		log.Fatal(err)
+	}
+	shardIds := make([]string, len(shards))
+	for i, shard := range shards {
+		shardIds[i] = shard.Id
+	}
+
+	dispatcher := ledger.NewWebhookDispatcher(ledgerServiceCoreApiClient, shardIds, webhooks, *pollInterval)
+
+	log.Println("Starting Webhook Dispatcher...")
+	dispatcher.Start()
+
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGINT, syscall.SIGTERM, os.Interrupt)
+	<-c
+
+	log.Println("Received SIGINT. Shutting down...")
+	dispatcher.Stop()
+}
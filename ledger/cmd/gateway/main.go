@@ -2,13 +2,16 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/evrblk/monstera"
 	"github.com/evrblk/monstera-example/ledger"
@@ -19,6 +22,8 @@ import (
 var (
 	port               = flag.Int("port", 0, "The server port")
 	monsteraConfigPath = flag.String("monstera-config", "", "Monstera cluster config path")
+	httpPort           = flag.Int("http-port", 0, "The HTTP/JSON server port (0 to disable)")
+	sweepInterval      = flag.Duration("sweep-interval", time.Minute, "Interval between sweeps of value-dated topups and escrow deadlines (0 to disable)")
 )
 
 func main() {
@@ -50,6 +55,7 @@ func main() {
 	ledgerServiceCoreApiClient := ledger.NewLedgerServiceCoreApiMonsteraStub(monsteraClient, &ledger.ShardKeyCalculator{})
 
 	grpcServer := grpc.NewServer()
+	httpServer := &http.Server{Addr: fmt.Sprintf(":%d", *httpPort)}
 
 	ctx, cancel := context.WithCancel(context.Background())
 	c := make(chan os.Signal, 1)
@@ -60,6 +66,7 @@ func main() {
 			log.Println("Received SIGINT. Shutting down...")
 			cancel()
 			grpcServer.GracefulStop()
+			httpServer.Shutdown(context.Background())
 			monsteraClient.Stop()
 		case <-ctx.Done():
 		}
@@ -69,11 +76,40 @@ func main() {
 		cancel()
 	}()
 
+	// All shards of Accounts, for sweeper and ledger-wide stats
+	shards, err := monsteraClient.ListShards("Accounts")
+	if err != nil {
+		log.Fatal(err)
+	}
+	shardIds := make([]string, len(shards))
+	for i, shard := range shards {
+		shardIds[i] = shard.Id
+	}
+
+	// Start sweeper of value-dated topups on all shards of Accounts
+	if *sweepInterval > 0 {
+		sweeper := ledger.NewPendingAvailabilitySweeper(ledgerServiceCoreApiClient, shardIds, *sweepInterval)
+		sweeper.Start()
+		defer sweeper.Stop()
+	}
+
 	// Create and register Gateway server
-	ledgerServiceApiGatewayServer := ledger.NewLedgerServiceApiServer(ledgerServiceCoreApiClient)
+	ledgerServiceApiGatewayServer := ledger.NewLedgerServiceApiServer(ledgerServiceCoreApiClient, shardIds)
 	defer ledgerServiceApiGatewayServer.Close()
 	gatewaypb.RegisterLedgerServiceApiServer(grpcServer, ledgerServiceApiGatewayServer)
 
+	// HTTP/JSON front-end on the same server
+	if *httpPort > 0 {
+		httpServer.Handler = ledger.NewRestHandler(ledgerServiceApiGatewayServer)
+		go func() {
+			log.Println("Starting HTTP/JSON Server...")
+			err := httpServer.ListenAndServe()
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.Fatalf("failed to serve HTTP: %v", err)
+			}
+		}()
+	}
+
 	log.Println("Starting API Gateway Server...")
 	grpcServer.Serve(lis)
 }
@@ -38,16 +38,35 @@ func main() {
 
 	dataStore := monstera.NewBadgerStore(filepath.Join(*dataDir, "data"))
 
+	// replicas are created by the node, so raft stats of a replica are looked up when a core asks for them
+	var monsteraNode *monstera.MonsteraNode
+	raftStats := func(replicaId string) func() map[string]string {
+		return func() map[string]string {
+			for _, r := range monsteraNode.ListCores() {
+				if r.ReplicaId == replicaId {
+					return r.GetRaftStats()
+				}
+			}
+			return map[string]string{}
+		}
+	}
+
 	coreDescriptors := monstera.ApplicationCoreDescriptors{
 		"Accounts": {
 			RestoreSnapshotOnStart: false,
 			CoreFactoryFunc: func(shard *monstera.Shard, replica *monstera.Replica) monstera.ApplicationCore {
-				return ledger.NewAccountsCoreAdapter(ledger.NewAccountsCore(dataStore, shard.LowerBound, shard.UpperBound))
+				return ledger.NewAccountsCoreAdapter(ledger.NewAccountsCore(dataStore, shard.LowerBound, shard.UpperBound).WithRaftStats(raftStats(replica.Id)))
+			},
+		},
+		"Customers": {
+			RestoreSnapshotOnStart: false,
+			CoreFactoryFunc: func(shard *monstera.Shard, replica *monstera.Replica) monstera.ApplicationCore {
+				return ledger.NewCustomersCoreAdapter(ledger.NewCustomersCore(dataStore, shard.LowerBound, shard.UpperBound))
 			},
 		},
 	}
 
-	monsteraNode, err := monstera.NewNode(*dataDir, *nodeId, clusterConfig, coreDescriptors, monstera.DefaultMonsteraNodeConfig)
+	monsteraNode, err = monstera.NewNode(*dataDir, *nodeId, clusterConfig, coreDescriptors, monstera.DefaultMonsteraNodeConfig)
 	if err != nil {
 		log.Fatal(err)
 	}
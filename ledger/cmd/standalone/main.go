@@ -32,15 +32,10 @@ func main() {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	accountsCore := ledger.NewAccountsCore(dataStore, []byte{0x00, 0x00, 0x00, 0x00}, []byte{0x00, 0x00, 0x00, 0x00})
-
-	// LedgerService client
-	ledgerServiceCoreApiClient := ledger.NewLedgerServiceCoreApiStandaloneStub(accountsCore)
-
 	grpcServer := grpc.NewServer()
 
-	// Create and register Gateway server
-	ledgerServiceApiGatewayServer := ledger.NewLedgerServiceApiServer(ledgerServiceCoreApiClient)
+	// Create and register Gateway server, all cores run in-process
+	ledgerServiceApiGatewayServer := ledger.NewStandaloneApiServer(dataStore)
 	defer ledgerServiceApiGatewayServer.Close()
 	gatewaypb.RegisterLedgerServiceApiServer(grpcServer, ledgerServiceApiGatewayServer)
 
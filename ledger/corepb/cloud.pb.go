@@ -28,6 +28,20 @@ type ReadRequest struct {
 	//	*ReadRequest_GetTransactionRequest
 	//	*ReadRequest_ListTransactionsRequest
 	//	*ReadRequest_GetAccountRequest
+	//	*ReadRequest_GetDisputeRequest
+	//	*ReadRequest_ListDisputesRequest
+	//	*ReadRequest_ListAlertRulesRequest
+	//	*ReadRequest_ListTriggeredAlertsRequest
+	//	*ReadRequest_ReadOutboxRequest
+	//	*ReadRequest_GetSpendingSummaryRequest
+	//	*ReadRequest_ListArchivableTransactionsRequest
+	//	*ReadRequest_ListTransactionRollupsRequest
+	//	*ReadRequest_GetCustomerRequest
+	//	*ReadRequest_GetShardStatsRequest
+	//	*ReadRequest_GetAccountFromFollowerRequest
+	//	*ReadRequest_ListTransactionsFromFollowerRequest
+	//	*ReadRequest_GetEscrowRequest
+	//	*ReadRequest_ListEscrowsRequest
 	Request       isReadRequest_Request `protobuf_oneof:"request"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -97,6 +111,132 @@ func (x *ReadRequest) GetGetAccountRequest() *GetAccountRequest {
 	return nil
 }
 
+func (x *ReadRequest) GetGetDisputeRequest() *GetDisputeRequest {
+	if x != nil {
+		if x, ok := x.Request.(*ReadRequest_GetDisputeRequest); ok {
+			return x.GetDisputeRequest
+		}
+	}
+	return nil
+}
+
+func (x *ReadRequest) GetListDisputesRequest() *ListDisputesRequest {
+	if x != nil {
+		if x, ok := x.Request.(*ReadRequest_ListDisputesRequest); ok {
+			return x.ListDisputesRequest
+		}
+	}
+	return nil
+}
+
+func (x *ReadRequest) GetListAlertRulesRequest() *ListAlertRulesRequest {
+	if x != nil {
+		if x, ok := x.Request.(*ReadRequest_ListAlertRulesRequest); ok {
+			return x.ListAlertRulesRequest
+		}
+	}
+	return nil
+}
+
+func (x *ReadRequest) GetListTriggeredAlertsRequest() *ListTriggeredAlertsRequest {
+	if x != nil {
+		if x, ok := x.Request.(*ReadRequest_ListTriggeredAlertsRequest); ok {
+			return x.ListTriggeredAlertsRequest
+		}
+	}
+	return nil
+}
+
+func (x *ReadRequest) GetReadOutboxRequest() *ReadOutboxRequest {
+	if x != nil {
+		if x, ok := x.Request.(*ReadRequest_ReadOutboxRequest); ok {
+			return x.ReadOutboxRequest
+		}
+	}
+	return nil
+}
+
+func (x *ReadRequest) GetGetSpendingSummaryRequest() *GetSpendingSummaryRequest {
+	if x != nil {
+		if x, ok := x.Request.(*ReadRequest_GetSpendingSummaryRequest); ok {
+			return x.GetSpendingSummaryRequest
+		}
+	}
+	return nil
+}
+
+func (x *ReadRequest) GetListArchivableTransactionsRequest() *ListArchivableTransactionsRequest {
+	if x != nil {
+		if x, ok := x.Request.(*ReadRequest_ListArchivableTransactionsRequest); ok {
+			return x.ListArchivableTransactionsRequest
+		}
+	}
+	return nil
+}
+
+func (x *ReadRequest) GetListTransactionRollupsRequest() *ListTransactionRollupsRequest {
+	if x != nil {
+		if x, ok := x.Request.(*ReadRequest_ListTransactionRollupsRequest); ok {
+			return x.ListTransactionRollupsRequest
+		}
+	}
+	return nil
+}
+
+func (x *ReadRequest) GetGetCustomerRequest() *GetCustomerRequest {
+	if x != nil {
+		if x, ok := x.Request.(*ReadRequest_GetCustomerRequest); ok {
+			return x.GetCustomerRequest
+		}
+	}
+	return nil
+}
+
+func (x *ReadRequest) GetGetShardStatsRequest() *GetShardStatsRequest {
+	if x != nil {
+		if x, ok := x.Request.(*ReadRequest_GetShardStatsRequest); ok {
+			return x.GetShardStatsRequest
+		}
+	}
+	return nil
+}
+
+func (x *ReadRequest) GetGetAccountFromFollowerRequest() *GetAccountFromFollowerRequest {
+	if x != nil {
+		if x, ok := x.Request.(*ReadRequest_GetAccountFromFollowerRequest); ok {
+			return x.GetAccountFromFollowerRequest
+		}
+	}
+	return nil
+}
+
+func (x *ReadRequest) GetListTransactionsFromFollowerRequest() *ListTransactionsFromFollowerRequest {
+	if x != nil {
+		if x, ok := x.Request.(*ReadRequest_ListTransactionsFromFollowerRequest); ok {
+			return x.ListTransactionsFromFollowerRequest
+		}
+	}
+	return nil
+}
+
+func (x *ReadRequest) GetGetEscrowRequest() *GetEscrowRequest {
+	if x != nil {
+		if x, ok := x.Request.(*ReadRequest_GetEscrowRequest); ok {
+			return x.GetEscrowRequest
+		}
+	}
+	return nil
+}
+
+func (x *ReadRequest) GetListEscrowsRequest() *ListEscrowsRequest {
+	if x != nil {
+		if x, ok := x.Request.(*ReadRequest_ListEscrowsRequest); ok {
+			return x.ListEscrowsRequest
+		}
+	}
+	return nil
+}
+
 type isReadRequest_Request interface {
 	isReadRequest_Request()
 }
@@ -113,12 +253,96 @@ type ReadRequest_GetAccountRequest struct {
 	GetAccountRequest *GetAccountRequest `protobuf:"bytes,4,opt,name=get_account_request,json=getAccountRequest,proto3,oneof"`
 }
 
+type ReadRequest_GetDisputeRequest struct {
+	GetDisputeRequest *GetDisputeRequest `protobuf:"bytes,5,opt,name=get_dispute_request,json=getDisputeRequest,proto3,oneof"`
+}
+
+type ReadRequest_ListDisputesRequest struct {
+	ListDisputesRequest *ListDisputesRequest `protobuf:"bytes,6,opt,name=list_disputes_request,json=listDisputesRequest,proto3,oneof"`
+}
+
+type ReadRequest_ListAlertRulesRequest struct {
+	ListAlertRulesRequest *ListAlertRulesRequest `protobuf:"bytes,7,opt,name=list_alert_rules_request,json=listAlertRulesRequest,proto3,oneof"`
+}
+
+type ReadRequest_ListTriggeredAlertsRequest struct {
+	ListTriggeredAlertsRequest *ListTriggeredAlertsRequest `protobuf:"bytes,8,opt,name=list_triggered_alerts_request,json=listTriggeredAlertsRequest,proto3,oneof"`
+}
+
+type ReadRequest_ReadOutboxRequest struct {
+	ReadOutboxRequest *ReadOutboxRequest `protobuf:"bytes,9,opt,name=read_outbox_request,json=readOutboxRequest,proto3,oneof"`
+}
+
+type ReadRequest_GetSpendingSummaryRequest struct {
+	GetSpendingSummaryRequest *GetSpendingSummaryRequest `protobuf:"bytes,10,opt,name=get_spending_summary_request,json=getSpendingSummaryRequest,proto3,oneof"`
+}
+
+type ReadRequest_ListArchivableTransactionsRequest struct {
+	ListArchivableTransactionsRequest *ListArchivableTransactionsRequest `protobuf:"bytes,11,opt,name=list_archivable_transactions_request,json=listArchivableTransactionsRequest,proto3,oneof"`
+}
+
+type ReadRequest_ListTransactionRollupsRequest struct {
+	ListTransactionRollupsRequest *ListTransactionRollupsRequest `protobuf:"bytes,12,opt,name=list_transaction_rollups_request,json=listTransactionRollupsRequest,proto3,oneof"`
+}
+
+type ReadRequest_GetCustomerRequest struct {
+	GetCustomerRequest *GetCustomerRequest `protobuf:"bytes,13,opt,name=get_customer_request,json=getCustomerRequest,proto3,oneof"`
+}
+
+type ReadRequest_GetShardStatsRequest struct {
+	GetShardStatsRequest *GetShardStatsRequest `protobuf:"bytes,14,opt,name=get_shard_stats_request,json=getShardStatsRequest,proto3,oneof"`
+}
+
+type ReadRequest_GetAccountFromFollowerRequest struct {
+	GetAccountFromFollowerRequest *GetAccountFromFollowerRequest `protobuf:"bytes,15,opt,name=get_account_from_follower_request,json=getAccountFromFollowerRequest,proto3,oneof"`
+}
+
+type ReadRequest_ListTransactionsFromFollowerRequest struct {
+	ListTransactionsFromFollowerRequest *ListTransactionsFromFollowerRequest `protobuf:"bytes,16,opt,name=list_transactions_from_follower_request,json=listTransactionsFromFollowerRequest,proto3,oneof"`
+}
+
+type ReadRequest_GetEscrowRequest struct {
+	GetEscrowRequest *GetEscrowRequest `protobuf:"bytes,17,opt,name=get_escrow_request,json=getEscrowRequest,proto3,oneof"`
+}
+
+type ReadRequest_ListEscrowsRequest struct {
+	ListEscrowsRequest *ListEscrowsRequest `protobuf:"bytes,18,opt,name=list_escrows_request,json=listEscrowsRequest,proto3,oneof"`
+}
+
 func (*ReadRequest_GetTransactionRequest) isReadRequest_Request() {}
 
 func (*ReadRequest_ListTransactionsRequest) isReadRequest_Request() {}
 
 func (*ReadRequest_GetAccountRequest) isReadRequest_Request() {}
 
+func (*ReadRequest_GetDisputeRequest) isReadRequest_Request() {}
+
+func (*ReadRequest_ListDisputesRequest) isReadRequest_Request() {}
+
+func (*ReadRequest_ListAlertRulesRequest) isReadRequest_Request() {}
+
+func (*ReadRequest_ListTriggeredAlertsRequest) isReadRequest_Request() {}
+
+func (*ReadRequest_ReadOutboxRequest) isReadRequest_Request() {}
+
+func (*ReadRequest_GetSpendingSummaryRequest) isReadRequest_Request() {}
+
+func (*ReadRequest_ListArchivableTransactionsRequest) isReadRequest_Request() {}
+
+func (*ReadRequest_ListTransactionRollupsRequest) isReadRequest_Request() {}
+
+func (*ReadRequest_GetCustomerRequest) isReadRequest_Request() {}
+
+func (*ReadRequest_GetShardStatsRequest) isReadRequest_Request() {}
+
+func (*ReadRequest_GetAccountFromFollowerRequest) isReadRequest_Request() {}
+
+func (*ReadRequest_ListTransactionsFromFollowerRequest) isReadRequest_Request() {}
+
+func (*ReadRequest_GetEscrowRequest) isReadRequest_Request() {}
+
+func (*ReadRequest_ListEscrowsRequest) isReadRequest_Request() {}
+
 type ReadResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Error *x.Error               `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
@@ -127,6 +351,20 @@ type ReadResponse struct {
 	//	*ReadResponse_GetTransactionResponse
 	//	*ReadResponse_ListTransactionsResponse
 	//	*ReadResponse_GetAccountResponse
+	//	*ReadResponse_GetDisputeResponse
+	//	*ReadResponse_ListDisputesResponse
+	//	*ReadResponse_ListAlertRulesResponse
+	//	*ReadResponse_ListTriggeredAlertsResponse
+	//	*ReadResponse_ReadOutboxResponse
+	//	*ReadResponse_GetSpendingSummaryResponse
+	//	*ReadResponse_ListArchivableTransactionsResponse
+	//	*ReadResponse_ListTransactionRollupsResponse
+	//	*ReadResponse_GetCustomerResponse
+	//	*ReadResponse_GetShardStatsResponse
+	//	*ReadResponse_GetAccountFromFollowerResponse
+	//	*ReadResponse_ListTransactionsFromFollowerResponse
+	//	*ReadResponse_GetEscrowResponse
+	//	*ReadResponse_ListEscrowsResponse
 	Response      isReadResponse_Response `protobuf_oneof:"response"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -203,6 +441,132 @@ func (x *ReadResponse) GetGetAccountResponse() *GetAccountResponse {
 	return nil
 }
 
+func (x *ReadResponse) GetGetDisputeResponse() *GetDisputeResponse {
+	if x != nil {
+		if x, ok := x.Response.(*ReadResponse_GetDisputeResponse); ok {
+			return x.GetDisputeResponse
+		}
+	}
+	return nil
+}
+
+func (x *ReadResponse) GetListDisputesResponse() *ListDisputesResponse {
+	if x != nil {
+		if x, ok := x.Response.(*ReadResponse_ListDisputesResponse); ok {
+			return x.ListDisputesResponse
+		}
+	}
+	return nil
+}
+
+func (x *ReadResponse) GetListAlertRulesResponse() *ListAlertRulesResponse {
+	if x != nil {
+		if x, ok := x.Response.(*ReadResponse_ListAlertRulesResponse); ok {
+			return x.ListAlertRulesResponse
+		}
+	}
+	return nil
+}
+
+func (x *ReadResponse) GetListTriggeredAlertsResponse() *ListTriggeredAlertsResponse {
+	if x != nil {
+		if x, ok := x.Response.(*ReadResponse_ListTriggeredAlertsResponse); ok {
+			return x.ListTriggeredAlertsResponse
+		}
+	}
+	return nil
+}
+
+func (x *ReadResponse) GetReadOutboxResponse() *ReadOutboxResponse {
+	if x != nil {
+		if x, ok := x.Response.(*ReadResponse_ReadOutboxResponse); ok {
+			return x.ReadOutboxResponse
+		}
+	}
+	return nil
+}
+
+func (x *ReadResponse) GetGetSpendingSummaryResponse() *GetSpendingSummaryResponse {
+	if x != nil {
+		if x, ok := x.Response.(*ReadResponse_GetSpendingSummaryResponse); ok {
+			return x.GetSpendingSummaryResponse
+		}
+	}
+	return nil
+}
+
+func (x *ReadResponse) GetListArchivableTransactionsResponse() *ListArchivableTransactionsResponse {
+	if x != nil {
+		if x, ok := x.Response.(*ReadResponse_ListArchivableTransactionsResponse); ok {
+			return x.ListArchivableTransactionsResponse
+		}
+	}
+	return nil
+}
+
+func (x *ReadResponse) GetListTransactionRollupsResponse() *ListTransactionRollupsResponse {
+	if x != nil {
+		if x, ok := x.Response.(*ReadResponse_ListTransactionRollupsResponse); ok {
+			return x.ListTransactionRollupsResponse
+		}
+	}
+	return nil
+}
+
+func (x *ReadResponse) GetGetCustomerResponse() *GetCustomerResponse {
+	if x != nil {
+		if x, ok := x.Response.(*ReadResponse_GetCustomerResponse); ok {
+			return x.GetCustomerResponse
+		}
+	}
+	return nil
+}
+
+func (x *ReadResponse) GetGetShardStatsResponse() *GetShardStatsResponse {
+	if x != nil {
+		if x, ok := x.Response.(*ReadResponse_GetShardStatsResponse); ok {
+			return x.GetShardStatsResponse
+		}
+	}
+	return nil
+}
+
+func (x *ReadResponse) GetGetAccountFromFollowerResponse() *GetAccountFromFollowerResponse {
+	if x != nil {
+		if x, ok := x.Response.(*ReadResponse_GetAccountFromFollowerResponse); ok {
+			return x.GetAccountFromFollowerResponse
+		}
+	}
+	return nil
+}
+
+func (x *ReadResponse) GetListTransactionsFromFollowerResponse() *ListTransactionsFromFollowerResponse {
+	if x != nil {
+		if x, ok := x.Response.(*ReadResponse_ListTransactionsFromFollowerResponse); ok {
+			return x.ListTransactionsFromFollowerResponse
+		}
+	}
+	return nil
+}
+
+func (x *ReadResponse) GetGetEscrowResponse() *GetEscrowResponse {
+	if x != nil {
+		if x, ok := x.Response.(*ReadResponse_GetEscrowResponse); ok {
+			return x.GetEscrowResponse
+		}
+	}
+	return nil
+}
+
+func (x *ReadResponse) GetListEscrowsResponse() *ListEscrowsResponse {
+	if x != nil {
+		if x, ok := x.Response.(*ReadResponse_ListEscrowsResponse); ok {
+			return x.ListEscrowsResponse
+		}
+	}
+	return nil
+}
+
 type isReadResponse_Response interface {
 	isReadResponse_Response()
 }
@@ -219,12 +583,96 @@ type ReadResponse_GetAccountResponse struct {
 	GetAccountResponse *GetAccountResponse `protobuf:"bytes,4,opt,name=get_account_response,json=getAccountResponse,proto3,oneof"`
 }
 
+type ReadResponse_GetDisputeResponse struct {
+	GetDisputeResponse *GetDisputeResponse `protobuf:"bytes,5,opt,name=get_dispute_response,json=getDisputeResponse,proto3,oneof"`
+}
+
+type ReadResponse_ListDisputesResponse struct {
+	ListDisputesResponse *ListDisputesResponse `protobuf:"bytes,6,opt,name=list_disputes_response,json=listDisputesResponse,proto3,oneof"`
+}
+
+type ReadResponse_ListAlertRulesResponse struct {
+	ListAlertRulesResponse *ListAlertRulesResponse `protobuf:"bytes,7,opt,name=list_alert_rules_response,json=listAlertRulesResponse,proto3,oneof"`
+}
+
+type ReadResponse_ListTriggeredAlertsResponse struct {
+	ListTriggeredAlertsResponse *ListTriggeredAlertsResponse `protobuf:"bytes,8,opt,name=list_triggered_alerts_response,json=listTriggeredAlertsResponse,proto3,oneof"`
+}
+
+type ReadResponse_ReadOutboxResponse struct {
+	ReadOutboxResponse *ReadOutboxResponse `protobuf:"bytes,9,opt,name=read_outbox_response,json=readOutboxResponse,proto3,oneof"`
+}
+
+type ReadResponse_GetSpendingSummaryResponse struct {
+	GetSpendingSummaryResponse *GetSpendingSummaryResponse `protobuf:"bytes,10,opt,name=get_spending_summary_response,json=getSpendingSummaryResponse,proto3,oneof"`
+}
+
+type ReadResponse_ListArchivableTransactionsResponse struct {
+	ListArchivableTransactionsResponse *ListArchivableTransactionsResponse `protobuf:"bytes,11,opt,name=list_archivable_transactions_response,json=listArchivableTransactionsResponse,proto3,oneof"`
+}
+
+type ReadResponse_ListTransactionRollupsResponse struct {
+	ListTransactionRollupsResponse *ListTransactionRollupsResponse `protobuf:"bytes,12,opt,name=list_transaction_rollups_response,json=listTransactionRollupsResponse,proto3,oneof"`
+}
+
+type ReadResponse_GetCustomerResponse struct {
+	GetCustomerResponse *GetCustomerResponse `protobuf:"bytes,13,opt,name=get_customer_response,json=getCustomerResponse,proto3,oneof"`
+}
+
+type ReadResponse_GetShardStatsResponse struct {
+	GetShardStatsResponse *GetShardStatsResponse `protobuf:"bytes,14,opt,name=get_shard_stats_response,json=getShardStatsResponse,proto3,oneof"`
+}
+
+type ReadResponse_GetAccountFromFollowerResponse struct {
+	GetAccountFromFollowerResponse *GetAccountFromFollowerResponse `protobuf:"bytes,15,opt,name=get_account_from_follower_response,json=getAccountFromFollowerResponse,proto3,oneof"`
+}
+
+type ReadResponse_ListTransactionsFromFollowerResponse struct {
+	ListTransactionsFromFollowerResponse *ListTransactionsFromFollowerResponse `protobuf:"bytes,16,opt,name=list_transactions_from_follower_response,json=listTransactionsFromFollowerResponse,proto3,oneof"`
+}
+
+type ReadResponse_GetEscrowResponse struct {
+	GetEscrowResponse *GetEscrowResponse `protobuf:"bytes,17,opt,name=get_escrow_response,json=getEscrowResponse,proto3,oneof"`
+}
+
+type ReadResponse_ListEscrowsResponse struct {
+	ListEscrowsResponse *ListEscrowsResponse `protobuf:"bytes,18,opt,name=list_escrows_response,json=listEscrowsResponse,proto3,oneof"`
+}
+
 func (*ReadResponse_GetTransactionResponse) isReadResponse_Response() {}
 
 func (*ReadResponse_ListTransactionsResponse) isReadResponse_Response() {}
 
 func (*ReadResponse_GetAccountResponse) isReadResponse_Response() {}
 
+func (*ReadResponse_GetDisputeResponse) isReadResponse_Response() {}
+
+func (*ReadResponse_ListDisputesResponse) isReadResponse_Response() {}
+
+func (*ReadResponse_ListAlertRulesResponse) isReadResponse_Response() {}
+
+func (*ReadResponse_ListTriggeredAlertsResponse) isReadResponse_Response() {}
+
+func (*ReadResponse_ReadOutboxResponse) isReadResponse_Response() {}
+
+func (*ReadResponse_GetSpendingSummaryResponse) isReadResponse_Response() {}
+
+func (*ReadResponse_ListArchivableTransactionsResponse) isReadResponse_Response() {}
+
+func (*ReadResponse_ListTransactionRollupsResponse) isReadResponse_Response() {}
+
+func (*ReadResponse_GetCustomerResponse) isReadResponse_Response() {}
+
+func (*ReadResponse_GetShardStatsResponse) isReadResponse_Response() {}
+
+func (*ReadResponse_GetAccountFromFollowerResponse) isReadResponse_Response() {}
+
+func (*ReadResponse_ListTransactionsFromFollowerResponse) isReadResponse_Response() {}
+
+func (*ReadResponse_GetEscrowResponse) isReadResponse_Response() {}
+
+func (*ReadResponse_ListEscrowsResponse) isReadResponse_Response() {}
+
 type UpdateRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Request:
@@ -233,6 +681,21 @@ type UpdateRequest struct {
 	//	*UpdateRequest_CancelTransactionRequest
 	//	*UpdateRequest_SettleTransactionRequest
 	//	*UpdateRequest_CreateAccountRequest
+	//	*UpdateRequest_OpenDisputeRequest
+	//	*UpdateRequest_ResolveDisputeRequest
+	//	*UpdateRequest_SweepPendingAvailabilityRequest
+	//	*UpdateRequest_CreateAlertRuleRequest
+	//	*UpdateRequest_DeleteAlertRuleRequest
+	//	*UpdateRequest_AckAlertRequest
+	//	*UpdateRequest_AckOutboxRequest
+	//	*UpdateRequest_ArchiveTransactionsRequest
+	//	*UpdateRequest_CreateCustomerRequest
+	//	*UpdateRequest_AddCustomerAccountRequest
+	//	*UpdateRequest_RebuildShardStatsRequest
+	//	*UpdateRequest_HoldEscrowRequest
+	//	*UpdateRequest_ReleaseEscrowRequest
+	//	*UpdateRequest_SweepEscrowsRequest
+	//	*UpdateRequest_RemoveCustomerAccountRequest
 	Request       isUpdateRequest_Request `protobuf_oneof:"request"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -311,10 +774,145 @@ func (x *UpdateRequest) GetCreateAccountRequest() *CreateAccountRequest {
 	return nil
 }
 
-type isUpdateRequest_Request interface {
-	isUpdateRequest_Request()
-}
-
+func (x *UpdateRequest) GetOpenDisputeRequest() *OpenDisputeRequest {
+	if x != nil {
+		if x, ok := x.Request.(*UpdateRequest_OpenDisputeRequest); ok {
+			return x.OpenDisputeRequest
+		}
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetResolveDisputeRequest() *ResolveDisputeRequest {
+	if x != nil {
+		if x, ok := x.Request.(*UpdateRequest_ResolveDisputeRequest); ok {
+			return x.ResolveDisputeRequest
+		}
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetSweepPendingAvailabilityRequest() *SweepPendingAvailabilityRequest {
+	if x != nil {
+		if x, ok := x.Request.(*UpdateRequest_SweepPendingAvailabilityRequest); ok {
+			return x.SweepPendingAvailabilityRequest
+		}
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetCreateAlertRuleRequest() *CreateAlertRuleRequest {
+	if x != nil {
+		if x, ok := x.Request.(*UpdateRequest_CreateAlertRuleRequest); ok {
+			return x.CreateAlertRuleRequest
+		}
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetDeleteAlertRuleRequest() *DeleteAlertRuleRequest {
+	if x != nil {
+		if x, ok := x.Request.(*UpdateRequest_DeleteAlertRuleRequest); ok {
+			return x.DeleteAlertRuleRequest
+		}
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetAckAlertRequest() *AckAlertRequest {
+	if x != nil {
+		if x, ok := x.Request.(*UpdateRequest_AckAlertRequest); ok {
+			return x.AckAlertRequest
+		}
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetAckOutboxRequest() *AckOutboxRequest {
+	if x != nil {
+		if x, ok := x.Request.(*UpdateRequest_AckOutboxRequest); ok {
+			return x.AckOutboxRequest
+		}
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetArchiveTransactionsRequest() *ArchiveTransactionsRequest {
+	if x != nil {
+		if x, ok := x.Request.(*UpdateRequest_ArchiveTransactionsRequest); ok {
+			return x.ArchiveTransactionsRequest
+		}
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetCreateCustomerRequest() *CreateCustomerRequest {
+	if x != nil {
+		if x, ok := x.Request.(*UpdateRequest_CreateCustomerRequest); ok {
+			return x.CreateCustomerRequest
+		}
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetAddCustomerAccountRequest() *AddCustomerAccountRequest {
+	if x != nil {
+		if x, ok := x.Request.(*UpdateRequest_AddCustomerAccountRequest); ok {
+			return x.AddCustomerAccountRequest
+		}
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetRebuildShardStatsRequest() *RebuildShardStatsRequest {
+	if x != nil {
+		if x, ok := x.Request.(*UpdateRequest_RebuildShardStatsRequest); ok {
+			return x.RebuildShardStatsRequest
+		}
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetHoldEscrowRequest() *HoldEscrowRequest {
+	if x != nil {
+		if x, ok := x.Request.(*UpdateRequest_HoldEscrowRequest); ok {
+			return x.HoldEscrowRequest
+		}
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetReleaseEscrowRequest() *ReleaseEscrowRequest {
+	if x != nil {
+		if x, ok := x.Request.(*UpdateRequest_ReleaseEscrowRequest); ok {
+			return x.ReleaseEscrowRequest
+		}
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetSweepEscrowsRequest() *SweepEscrowsRequest {
+	if x != nil {
+		if x, ok := x.Request.(*UpdateRequest_SweepEscrowsRequest); ok {
+			return x.SweepEscrowsRequest
+		}
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetRemoveCustomerAccountRequest() *RemoveCustomerAccountRequest {
+	if x != nil {
+		if x, ok := x.Request.(*UpdateRequest_RemoveCustomerAccountRequest); ok {
+			return x.RemoveCustomerAccountRequest
+		}
+	}
+	return nil
+}
+
+type isUpdateRequest_Request interface {
+	isUpdateRequest_Request()
+}
+
 type UpdateRequest_CreateTransactionRequest struct {
 	CreateTransactionRequest *CreateTransactionRequest `protobuf:"bytes,2,opt,name=create_transaction_request,json=createTransactionRequest,proto3,oneof"`
 }
@@ -331,6 +929,66 @@ type UpdateRequest_CreateAccountRequest struct {
 	CreateAccountRequest *CreateAccountRequest `protobuf:"bytes,5,opt,name=create_account_request,json=createAccountRequest,proto3,oneof"`
 }
 
+type UpdateRequest_OpenDisputeRequest struct {
+	OpenDisputeRequest *OpenDisputeRequest `protobuf:"bytes,6,opt,name=open_dispute_request,json=openDisputeRequest,proto3,oneof"`
+}
+
+type UpdateRequest_ResolveDisputeRequest struct {
+	ResolveDisputeRequest *ResolveDisputeRequest `protobuf:"bytes,7,opt,name=resolve_dispute_request,json=resolveDisputeRequest,proto3,oneof"`
+}
+
+type UpdateRequest_SweepPendingAvailabilityRequest struct {
+	SweepPendingAvailabilityRequest *SweepPendingAvailabilityRequest `protobuf:"bytes,8,opt,name=sweep_pending_availability_request,json=sweepPendingAvailabilityRequest,proto3,oneof"`
+}
+
+type UpdateRequest_CreateAlertRuleRequest struct {
+	CreateAlertRuleRequest *CreateAlertRuleRequest `protobuf:"bytes,9,opt,name=create_alert_rule_request,json=createAlertRuleRequest,proto3,oneof"`
+}
+
+type UpdateRequest_DeleteAlertRuleRequest struct {
+	DeleteAlertRuleRequest *DeleteAlertRuleRequest `protobuf:"bytes,10,opt,name=delete_alert_rule_request,json=deleteAlertRuleRequest,proto3,oneof"`
+}
+
+type UpdateRequest_AckAlertRequest struct {
+	AckAlertRequest *AckAlertRequest `protobuf:"bytes,11,opt,name=ack_alert_request,json=ackAlertRequest,proto3,oneof"`
+}
+
+type UpdateRequest_AckOutboxRequest struct {
+	AckOutboxRequest *AckOutboxRequest `protobuf:"bytes,12,opt,name=ack_outbox_request,json=ackOutboxRequest,proto3,oneof"`
+}
+
+type UpdateRequest_ArchiveTransactionsRequest struct {
+	ArchiveTransactionsRequest *ArchiveTransactionsRequest `protobuf:"bytes,13,opt,name=archive_transactions_request,json=archiveTransactionsRequest,proto3,oneof"`
+}
+
+type UpdateRequest_CreateCustomerRequest struct {
+	CreateCustomerRequest *CreateCustomerRequest `protobuf:"bytes,14,opt,name=create_customer_request,json=createCustomerRequest,proto3,oneof"`
+}
+
+type UpdateRequest_AddCustomerAccountRequest struct {
+	AddCustomerAccountRequest *AddCustomerAccountRequest `protobuf:"bytes,15,opt,name=add_customer_account_request,json=addCustomerAccountRequest,proto3,oneof"`
+}
+
+type UpdateRequest_RebuildShardStatsRequest struct {
+	RebuildShardStatsRequest *RebuildShardStatsRequest `protobuf:"bytes,16,opt,name=rebuild_shard_stats_request,json=rebuildShardStatsRequest,proto3,oneof"`
+}
+
+type UpdateRequest_HoldEscrowRequest struct {
+	HoldEscrowRequest *HoldEscrowRequest `protobuf:"bytes,17,opt,name=hold_escrow_request,json=holdEscrowRequest,proto3,oneof"`
+}
+
+type UpdateRequest_ReleaseEscrowRequest struct {
+	ReleaseEscrowRequest *ReleaseEscrowRequest `protobuf:"bytes,18,opt,name=release_escrow_request,json=releaseEscrowRequest,proto3,oneof"`
+}
+
+type UpdateRequest_SweepEscrowsRequest struct {
+	SweepEscrowsRequest *SweepEscrowsRequest `protobuf:"bytes,19,opt,name=sweep_escrows_request,json=sweepEscrowsRequest,proto3,oneof"`
+}
+
+type UpdateRequest_RemoveCustomerAccountRequest struct {
+	RemoveCustomerAccountRequest *RemoveCustomerAccountRequest `protobuf:"bytes,20,opt,name=remove_customer_account_request,json=removeCustomerAccountRequest,proto3,oneof"`
+}
+
 func (*UpdateRequest_CreateTransactionRequest) isUpdateRequest_Request() {}
 
 func (*UpdateRequest_CancelTransactionRequest) isUpdateRequest_Request() {}
@@ -339,6 +997,36 @@ func (*UpdateRequest_SettleTransactionRequest) isUpdateRequest_Request() {}
 
 func (*UpdateRequest_CreateAccountRequest) isUpdateRequest_Request() {}
 
+func (*UpdateRequest_OpenDisputeRequest) isUpdateRequest_Request() {}
+
+func (*UpdateRequest_ResolveDisputeRequest) isUpdateRequest_Request() {}
+
+func (*UpdateRequest_SweepPendingAvailabilityRequest) isUpdateRequest_Request() {}
+
+func (*UpdateRequest_CreateAlertRuleRequest) isUpdateRequest_Request() {}
+
+func (*UpdateRequest_DeleteAlertRuleRequest) isUpdateRequest_Request() {}
+
+func (*UpdateRequest_AckAlertRequest) isUpdateRequest_Request() {}
+
+func (*UpdateRequest_AckOutboxRequest) isUpdateRequest_Request() {}
+
+func (*UpdateRequest_ArchiveTransactionsRequest) isUpdateRequest_Request() {}
+
+func (*UpdateRequest_CreateCustomerRequest) isUpdateRequest_Request() {}
+
+func (*UpdateRequest_AddCustomerAccountRequest) isUpdateRequest_Request() {}
+
+func (*UpdateRequest_RebuildShardStatsRequest) isUpdateRequest_Request() {}
+
+func (*UpdateRequest_HoldEscrowRequest) isUpdateRequest_Request() {}
+
+func (*UpdateRequest_ReleaseEscrowRequest) isUpdateRequest_Request() {}
+
+func (*UpdateRequest_SweepEscrowsRequest) isUpdateRequest_Request() {}
+
+func (*UpdateRequest_RemoveCustomerAccountRequest) isUpdateRequest_Request() {}
+
 type UpdateResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Error *x.Error               `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
@@ -348,6 +1036,21 @@ type UpdateResponse struct {
 	//	*UpdateResponse_CancelTransactionResponse
 	//	*UpdateResponse_SettleTransactionResponse
 	//	*UpdateResponse_CreateAccountResponse
+	//	*UpdateResponse_OpenDisputeResponse
+	//	*UpdateResponse_ResolveDisputeResponse
+	//	*UpdateResponse_SweepPendingAvailabilityResponse
+	//	*UpdateResponse_CreateAlertRuleResponse
+	//	*UpdateResponse_DeleteAlertRuleResponse
+	//	*UpdateResponse_AckAlertResponse
+	//	*UpdateResponse_AckOutboxResponse
+	//	*UpdateResponse_ArchiveTransactionsResponse
+	//	*UpdateResponse_CreateCustomerResponse
+	//	*UpdateResponse_AddCustomerAccountResponse
+	//	*UpdateResponse_RebuildShardStatsResponse
+	//	*UpdateResponse_HoldEscrowResponse
+	//	*UpdateResponse_ReleaseEscrowResponse
+	//	*UpdateResponse_SweepEscrowsResponse
+	//	*UpdateResponse_RemoveCustomerAccountResponse
 	Response      isUpdateResponse_Response `protobuf_oneof:"response"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -433,6 +1136,141 @@ func (x *UpdateResponse) GetCreateAccountResponse() *CreateAccountResponse {
 	return nil
 }
 
+func (x *UpdateResponse) GetOpenDisputeResponse() *OpenDisputeResponse {
+	if x != nil {
+		if x, ok := x.Response.(*UpdateResponse_OpenDisputeResponse); ok {
+			return x.OpenDisputeResponse
+		}
+	}
+	return nil
+}
+
+func (x *UpdateResponse) GetResolveDisputeResponse() *ResolveDisputeResponse {
+	if x != nil {
+		if x, ok := x.Response.(*UpdateResponse_ResolveDisputeResponse); ok {
+			return x.ResolveDisputeResponse
+		}
+	}
+	return nil
+}
+
+func (x *UpdateResponse) GetSweepPendingAvailabilityResponse() *SweepPendingAvailabilityResponse {
+	if x != nil {
+		if x, ok := x.Response.(*UpdateResponse_SweepPendingAvailabilityResponse); ok {
+			return x.SweepPendingAvailabilityResponse
+		}
+	}
+	return nil
+}
+
+func (x *UpdateResponse) GetCreateAlertRuleResponse() *CreateAlertRuleResponse {
+	if x != nil {
+		if x, ok := x.Response.(*UpdateResponse_CreateAlertRuleResponse); ok {
+			return x.CreateAlertRuleResponse
+		}
+	}
+	return nil
+}
+
+func (x *UpdateResponse) GetDeleteAlertRuleResponse() *DeleteAlertRuleResponse {
+	if x != nil {
+		if x, ok := x.Response.(*UpdateResponse_DeleteAlertRuleResponse); ok {
+			return x.DeleteAlertRuleResponse
+		}
+	}
+	return nil
+}
+
+func (x *UpdateResponse) GetAckAlertResponse() *AckAlertResponse {
+	if x != nil {
+		if x, ok := x.Response.(*UpdateResponse_AckAlertResponse); ok {
+			return x.AckAlertResponse
+		}
+	}
+	return nil
+}
+
+func (x *UpdateResponse) GetAckOutboxResponse() *AckOutboxResponse {
+	if x != nil {
+		if x, ok := x.Response.(*UpdateResponse_AckOutboxResponse); ok {
+			return x.AckOutboxResponse
+		}
+	}
+	return nil
+}
+
+func (x *UpdateResponse) GetArchiveTransactionsResponse() *ArchiveTransactionsResponse {
+	if x != nil {
+		if x, ok := x.Response.(*UpdateResponse_ArchiveTransactionsResponse); ok {
+			return x.ArchiveTransactionsResponse
+		}
+	}
+	return nil
+}
+
+func (x *UpdateResponse) GetCreateCustomerResponse() *CreateCustomerResponse {
+	if x != nil {
+		if x, ok := x.Response.(*UpdateResponse_CreateCustomerResponse); ok {
+			return x.CreateCustomerResponse
+		}
+	}
+	return nil
+}
+
+func (x *UpdateResponse) GetAddCustomerAccountResponse() *AddCustomerAccountResponse {
+	if x != nil {
+		if x, ok := x.Response.(*UpdateResponse_AddCustomerAccountResponse); ok {
+			return x.AddCustomerAccountResponse
+		}
+	}
+	return nil
+}
+
+func (x *UpdateResponse) GetRebuildShardStatsResponse() *RebuildShardStatsResponse {
+	if x != nil {
+		if x, ok := x.Response.(*UpdateResponse_RebuildShardStatsResponse); ok {
+			return x.RebuildShardStatsResponse
+		}
+	}
+	return nil
+}
+
+func (x *UpdateResponse) GetHoldEscrowResponse() *HoldEscrowResponse {
+	if x != nil {
+		if x, ok := x.Response.(*UpdateResponse_HoldEscrowResponse); ok {
+			return x.HoldEscrowResponse
+		}
+	}
+	return nil
+}
+
+func (x *UpdateResponse) GetReleaseEscrowResponse() *ReleaseEscrowResponse {
+	if x != nil {
+		if x, ok := x.Response.(*UpdateResponse_ReleaseEscrowResponse); ok {
+			return x.ReleaseEscrowResponse
+		}
+	}
+	return nil
+}
+
+func (x *UpdateResponse) GetSweepEscrowsResponse() *SweepEscrowsResponse {
+	if x != nil {
+		if x, ok := x.Response.(*UpdateResponse_SweepEscrowsResponse); ok {
+			return x.SweepEscrowsResponse
+		}
+	}
+	return nil
+}
+
+func (x *UpdateResponse) GetRemoveCustomerAccountResponse() *RemoveCustomerAccountResponse {
+	if x != nil {
+		if x, ok := x.Response.(*UpdateResponse_RemoveCustomerAccountResponse); ok {
+			return x.RemoveCustomerAccountResponse
+		}
+	}
+	return nil
+}
+
 type isUpdateResponse_Response interface {
 	isUpdateResponse_Response()
 }
@@ -453,6 +1291,66 @@ type UpdateResponse_CreateAccountResponse struct {
 	CreateAccountResponse *CreateAccountResponse `protobuf:"bytes,5,opt,name=create_account_response,json=createAccountResponse,proto3,oneof"`
 }
 
+type UpdateResponse_OpenDisputeResponse struct {
+	OpenDisputeResponse *OpenDisputeResponse `protobuf:"bytes,6,opt,name=open_dispute_response,json=openDisputeResponse,proto3,oneof"`
+}
+
+type UpdateResponse_ResolveDisputeResponse struct {
+	ResolveDisputeResponse *ResolveDisputeResponse `protobuf:"bytes,7,opt,name=resolve_dispute_response,json=resolveDisputeResponse,proto3,oneof"`
+}
+
+type UpdateResponse_SweepPendingAvailabilityResponse struct {
+	SweepPendingAvailabilityResponse *SweepPendingAvailabilityResponse `protobuf:"bytes,8,opt,name=sweep_pending_availability_response,json=sweepPendingAvailabilityResponse,proto3,oneof"`
+}
+
+type UpdateResponse_CreateAlertRuleResponse struct {
+	CreateAlertRuleResponse *CreateAlertRuleResponse `protobuf:"bytes,9,opt,name=create_alert_rule_response,json=createAlertRuleResponse,proto3,oneof"`
+}
+
+type UpdateResponse_DeleteAlertRuleResponse struct {
+	DeleteAlertRuleResponse *DeleteAlertRuleResponse `protobuf:"bytes,10,opt,name=delete_alert_rule_response,json=deleteAlertRuleResponse,proto3,oneof"`
+}
+
+type UpdateResponse_AckAlertResponse struct {
+	AckAlertResponse *AckAlertResponse `protobuf:"bytes,11,opt,name=ack_alert_response,json=ackAlertResponse,proto3,oneof"`
+}
+
+type UpdateResponse_AckOutboxResponse struct {
+	AckOutboxResponse *AckOutboxResponse `protobuf:"bytes,12,opt,name=ack_outbox_response,json=ackOutboxResponse,proto3,oneof"`
+}
+
+type UpdateResponse_ArchiveTransactionsResponse struct {
+	ArchiveTransactionsResponse *ArchiveTransactionsResponse `protobuf:"bytes,13,opt,name=archive_transactions_response,json=archiveTransactionsResponse,proto3,oneof"`
+}
+
+type UpdateResponse_CreateCustomerResponse struct {
+	CreateCustomerResponse *CreateCustomerResponse `protobuf:"bytes,14,opt,name=create_customer_response,json=createCustomerResponse,proto3,oneof"`
+}
+
+type UpdateResponse_AddCustomerAccountResponse struct {
+	AddCustomerAccountResponse *AddCustomerAccountResponse `protobuf:"bytes,15,opt,name=add_customer_account_response,json=addCustomerAccountResponse,proto3,oneof"`
+}
+
+type UpdateResponse_RebuildShardStatsResponse struct {
+	RebuildShardStatsResponse *RebuildShardStatsResponse `protobuf:"bytes,16,opt,name=rebuild_shard_stats_response,json=rebuildShardStatsResponse,proto3,oneof"`
+}
+
+type UpdateResponse_HoldEscrowResponse struct {
+	HoldEscrowResponse *HoldEscrowResponse `protobuf:"bytes,17,opt,name=hold_escrow_response,json=holdEscrowResponse,proto3,oneof"`
+}
+
+type UpdateResponse_ReleaseEscrowResponse struct {
+	ReleaseEscrowResponse *ReleaseEscrowResponse `protobuf:"bytes,18,opt,name=release_escrow_response,json=releaseEscrowResponse,proto3,oneof"`
+}
+
+type UpdateResponse_SweepEscrowsResponse struct {
+	SweepEscrowsResponse *SweepEscrowsResponse `protobuf:"bytes,19,opt,name=sweep_escrows_response,json=sweepEscrowsResponse,proto3,oneof"`
+}
+
+type UpdateResponse_RemoveCustomerAccountResponse struct {
+	RemoveCustomerAccountResponse *RemoveCustomerAccountResponse `protobuf:"bytes,20,opt,name=remove_customer_account_response,json=removeCustomerAccountResponse,proto3,oneof"`
+}
+
 func (*UpdateResponse_CreateTransactionResponse) isUpdateResponse_Response() {}
 
 func (*UpdateResponse_CancelTransactionResponse) isUpdateResponse_Response() {}
@@ -461,6 +1359,36 @@ func (*UpdateResponse_SettleTransactionResponse) isUpdateResponse_Response() {}
 
 func (*UpdateResponse_CreateAccountResponse) isUpdateResponse_Response() {}
 
+func (*UpdateResponse_OpenDisputeResponse) isUpdateResponse_Response() {}
+
+func (*UpdateResponse_ResolveDisputeResponse) isUpdateResponse_Response() {}
+
+func (*UpdateResponse_SweepPendingAvailabilityResponse) isUpdateResponse_Response() {}
+
+func (*UpdateResponse_CreateAlertRuleResponse) isUpdateResponse_Response() {}
+
+func (*UpdateResponse_DeleteAlertRuleResponse) isUpdateResponse_Response() {}
+
+func (*UpdateResponse_AckAlertResponse) isUpdateResponse_Response() {}
+
+func (*UpdateResponse_AckOutboxResponse) isUpdateResponse_Response() {}
+
+func (*UpdateResponse_ArchiveTransactionsResponse) isUpdateResponse_Response() {}
+
+func (*UpdateResponse_CreateCustomerResponse) isUpdateResponse_Response() {}
+
+func (*UpdateResponse_AddCustomerAccountResponse) isUpdateResponse_Response() {}
+
+func (*UpdateResponse_RebuildShardStatsResponse) isUpdateResponse_Response() {}
+
+func (*UpdateResponse_HoldEscrowResponse) isUpdateResponse_Response() {}
+
+func (*UpdateResponse_ReleaseEscrowResponse) isUpdateResponse_Response() {}
+
+func (*UpdateResponse_SweepEscrowsResponse) isUpdateResponse_Response() {}
+
+func (*UpdateResponse_RemoveCustomerAccountResponse) isUpdateResponse_Response() {}
+
 var File_corepb_cloud_proto protoreflect.FileDescriptor
 
 var file_corepb_cloud_proto_rawDesc = []byte{
@@ -470,7 +1398,7 @@ var file_corepb_cloud_proto_rawDesc = []byte{
 	0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x1a,
 	0x10, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2f, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x0e, 0x78, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x8d, 0x03, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x6f, 0x22, 0xbc, 0x11, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x7a, 0x0a, 0x17, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
 	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x40, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e,
@@ -494,114 +1422,587 @@ var file_corepb_cloud_proto_rawDesc = []byte{
 	0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63,
 	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x11, 0x67,
 	0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x42, 0x09, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4a, 0x04, 0x08, 0x01, 0x10,
-	0x02, 0x22, 0xce, 0x03, 0x0a, 0x0c, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d,
-	0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61,
-	0x78, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x7d,
-	0x0a, 0x18, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x41, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f,
+	0x12, 0x6e, 0x0a, 0x13, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74,
+	0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73,
+	0x70, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x11, 0x67,
+	0x65, 0x74, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x74, 0x0a, 0x15, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65,
+	0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x3e, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x13, 0x6c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7b, 0x0a, 0x18, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x61,
+	0x6c, 0x65, 0x72, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65,
+	0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x15, 0x6c, 0x69,
+	0x73, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x8a, 0x01, 0x0a, 0x1d, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72,
+	0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x65, 0x64, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x1a, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x65, 0x64, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x6e, 0x0a, 0x13, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74,
+	0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x75,
+	0x74, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x11, 0x72,
+	0x65, 0x61, 0x64, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x87, 0x01, 0x0a, 0x1c, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76,
+	0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
+	0x19, 0x67, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x9f, 0x01, 0x0a, 0x24, 0x6c,
+	0x69, 0x73, 0x74, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4c, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x61, 0x62, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x21, 0x6c, 0x69, 0x73, 0x74, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x93, 0x01, 0x0a,
+	0x20, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x48, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76,
+	0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x1d, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x71, 0x0a, 0x14, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x3d, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f,
 	0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c,
 	0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x16, 0x67, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x83, 0x01,
-	0x0a, 0x1a, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x43, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x12, 0x67, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x78, 0x0a, 0x17, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x68, 0x61,
+	0x72, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72,
+	0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x14, 0x67, 0x65, 0x74, 0x53, 0x68,
+	0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x94, 0x01, 0x0a, 0x21, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x48, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72,
+	0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x1d, 0x67, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0xa6, 0x01, 0x0a, 0x27, 0x6c, 0x69, 0x73, 0x74, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x66, 0x72, 0x6f,
+	0x6d, 0x5f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4e, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65,
+	0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x23, 0x6c, 0x69, 0x73, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x72, 0x6f, 0x6d,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x6b, 0x0a, 0x12, 0x67, 0x65, 0x74, 0x5f, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72,
+	0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x73, 0x63, 0x72, 0x6f,
+	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x10, 0x67, 0x65, 0x74, 0x45,
+	0x73, 0x63, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x71, 0x0a, 0x14,
+	0x6c, 0x69, 0x73, 0x74, 0x5f, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x73, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61,
+	0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x73, 0x63, 0x72, 0x6f,
+	0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x12, 0x6c, 0x69, 0x73,
+	0x74, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42,
+	0x09, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02,
+	0x22, 0xa7, 0x12, 0x0a, 0x0c, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f,
+	0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x78,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x7d, 0x0a,
+	0x18, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x41, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x16, 0x67, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x83, 0x01, 0x0a,
+	0x1a, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x43, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d,
+	0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x18, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x71, 0x0a, 0x14, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x3d, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f,
+	0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
+	0x00, 0x52, 0x12, 0x67, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x14, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x69, 0x73,
+	0x70, 0x75, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x12, 0x67, 0x65, 0x74, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x16, 0x6c, 0x69, 0x73, 0x74,
+	0x5f, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65,
+	0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x14, 0x6c, 0x69, 0x73,
+	0x74, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x7e, 0x0a, 0x19, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x5f,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c,
+	0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x16, 0x6c, 0x69, 0x73, 0x74, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x8d, 0x01, 0x0a, 0x1e, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61,
+	0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x65, 0x64, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x1b, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x65, 0x64, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x71, 0x0a, 0x14, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x78,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x3d, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64,
+	0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00,
+	0x52, 0x12, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8a, 0x01, 0x0a, 0x1d, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65,
+	0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x1a, 0x67, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0xa2, 0x01, 0x0a, 0x25, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x4d, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d,
+	0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x00, 0x52, 0x22, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x61, 0x62,
+	0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x96, 0x01, 0x0a, 0x21, 0x6c, 0x69, 0x73, 0x74, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x6f, 0x6c, 0x6c,
+	0x75, 0x70, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x49, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e,
 	0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
 	0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x18, 0x6c, 0x69, 0x73, 0x74, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x14, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x3d, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d,
-	0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
-	0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x48, 0x00, 0x52, 0x12, 0x67, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0xab, 0x04, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x83, 0x01, 0x0a, 0x1a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x63, 0x6f, 0x6d, 0x2e,
-	0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f,
-	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
-	0x52, 0x18, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x83, 0x01, 0x0a, 0x1a, 0x63,
-	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x43, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f,
+	0x6c, 0x6c, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
+	0x1e, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x74, 0x0a, 0x15, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73,
+	0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00,
+	0x52, 0x13, 0x67, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b, 0x0a, 0x18, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x68, 0x61,
+	0x72, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76,
+	0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x15, 0x67, 0x65, 0x74,
+	0x53, 0x68, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x97, 0x01, 0x0a, 0x22, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x49, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e,
 	0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65,
-	0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x63,
-	0x65, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x18, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x83, 0x01, 0x0a, 0x1a, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62,
+	0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x1e, 0x67, 0x65,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa9, 0x01, 0x0a,
+	0x28, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x4f, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x72, 0x6f, 0x6d,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x00, 0x52, 0x24, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x13, 0x67, 0x65, 0x74, 0x5f,
+	0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62,
 	0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d,
 	0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70,
-	0x62, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x18, 0x73, 0x65,
-	0x74, 0x74, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x77, 0x0a, 0x16, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72,
-	0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42,
-	0x09, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02,
-	0x22, 0xef, 0x04, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e,
-	0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72,
-	0x61, 0x78, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
-	0x86, 0x01, 0x0a, 0x1b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x11, 0x67, 0x65, 0x74, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x15, 0x6c, 0x69, 0x73, 0x74,
+	0x5f, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76,
+	0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x13, 0x6c, 0x69, 0x73, 0x74, 0x45,
+	0x73, 0x63, 0x72, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x98, 0x13, 0x0a, 0x0d, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x83, 0x01, 0x0a,
+	0x1a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x43, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d,
+	0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x18, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x83, 0x01, 0x0a, 0x1a, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76,
+	0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x18,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x83, 0x01, 0x0a, 0x1a, 0x73, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74,
+	0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x18, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x77,
+	0x0a, 0x16, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73,
+	0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x71, 0x0a, 0x14, 0x6f, 0x70, 0x65, 0x6e, 0x5f,
+	0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62,
 	0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d,
 	0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70,
-	0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x19, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x1b, 0x63, 0x61, 0x6e,
-	0x63, 0x65, 0x6c, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x44,
+	0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x12, 0x6f, 0x70, 0x65, 0x6e, 0x44, 0x69, 0x73, 0x70,
+	0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7a, 0x0a, 0x17, 0x72, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72,
+	0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x44,
+	0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
+	0x15, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x99, 0x01, 0x0a, 0x22, 0x73, 0x77, 0x65, 0x65, 0x70,
+	0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x4a, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e,
+	0x53, 0x77, 0x65, 0x65, 0x70, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x1f, 0x73, 0x77, 0x65, 0x65, 0x70, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x7e, 0x0a, 0x19, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x6c, 0x65,
+	0x72, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62,
+	0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70,
+	0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x16, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x7e, 0x0a, 0x19, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x61, 0x6c, 0x65,
+	0x72, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62,
+	0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70,
+	0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x16, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x68, 0x0a, 0x11, 0x61, 0x63, 0x6b, 0x5f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74,
+	0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x6b, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x61, 0x63, 0x6b,
+	0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x6b, 0x0a, 0x12,
+	0x61, 0x63, 0x6b, 0x5f, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65,
+	0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x10, 0x61, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x62,
+	0x6f, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x89, 0x01, 0x0a, 0x1c, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x45, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f,
+	0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x1a, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7a, 0x0a, 0x17, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72,
+	0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x15, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x87, 0x01, 0x0a, 0x1c, 0x61, 0x64, 0x64, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65,
+	0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x19, 0x61, 0x64, 0x64, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x84, 0x01, 0x0a, 0x1b,
+	0x72, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x43, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d,
+	0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x68, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x18, 0x72, 0x65, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x53, 0x68, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x6e, 0x0a, 0x13, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x65, 0x73, 0x63, 0x72, 0x6f,
+	0x77, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x3c, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e,
+	0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x48, 0x6f, 0x6c, 0x64,
+	0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
+	0x11, 0x68, 0x6f, 0x6c, 0x64, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x77, 0x0a, 0x16, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x65, 0x73,
+	0x63, 0x72, 0x6f, 0x77, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e,
+	0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x14, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x73,
+	0x63, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x74, 0x0a, 0x15, 0x73,
+	0x77, 0x65, 0x65, 0x70, 0x5f, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x73, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61,
+	0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x53, 0x77, 0x65, 0x65, 0x70, 0x45, 0x73, 0x63, 0x72,
+	0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x13, 0x73, 0x77,
+	0x65, 0x65, 0x70, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x90, 0x01, 0x0a, 0x1f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72,
+	0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x1c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4a,
+	0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0x8b, 0x14, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76,
+	0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x2e, 0x6d, 0x6f,
+	0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x78, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x86, 0x01, 0x0a, 0x1b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61,
+	0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x00, 0x52, 0x19, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x86, 0x01,
+	0x0a, 0x1b, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x19, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x1b, 0x73, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65,
+	0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x19, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x7a, 0x0a, 0x17, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x40, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f,
+	0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x15, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x15, 0x6f,
+	0x70, 0x65, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61,
+	0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x44, 0x69, 0x73, 0x70, 0x75,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x13, 0x6f, 0x70,
+	0x65, 0x6e, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x7d, 0x0a, 0x18, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x64, 0x69, 0x73,
+	0x70, 0x75, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x16, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x9c, 0x01, 0x0a, 0x23, 0x73, 0x77, 0x65, 0x65, 0x70, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4b,
 	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73,
 	0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64,
-	0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65,
-	0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x19, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x86, 0x01, 0x0a, 0x1b, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76,
+	0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x53, 0x77, 0x65, 0x65, 0x70,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x20, 0x73,
+	0x77, 0x65, 0x65, 0x70, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x81, 0x01, 0x0a, 0x1a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x6c, 0x65, 0x72, 0x74,
+	0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c,
+	0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x17, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x1a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x61,
+	0x6c, 0x65, 0x72, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65,
+	0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x17,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x12, 0x61, 0x63, 0x6b, 0x5f, 0x61,
+	0x6c, 0x65, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e,
+	0x41, 0x63, 0x6b, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x00, 0x52, 0x10, 0x61, 0x63, 0x6b, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x13, 0x61, 0x63, 0x6b, 0x5f, 0x6f, 0x75, 0x74, 0x62,
+	0x6f, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x3c, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d,
+	0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x41, 0x63,
+	0x6b, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
+	0x00, 0x52, 0x11, 0x61, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8c, 0x01, 0x0a, 0x1d, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65,
+	0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x1b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x18, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62,
+	0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70,
+	0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x16, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x8a, 0x01, 0x0a, 0x1d, 0x61, 0x64, 0x64, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61,
+	0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x48, 0x00, 0x52, 0x1a, 0x61, 0x64, 0x64, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x87, 0x01, 0x0a, 0x1c, 0x72, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x73, 0x68, 0x61, 0x72,
+	0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72,
+	0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x68, 0x61, 0x72, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x19,
+	0x72, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x68, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x14, 0x68, 0x6f, 0x6c,
+	0x64, 0x5f, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76,
 	0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65, 0x78,
 	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
-	0x19, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x17, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72,
-	0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
-	0x15, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2f, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61,
-	0x2d, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2f,
-	0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x70, 0x62, 0x2e, 0x48, 0x6f, 0x6c, 0x64, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x12, 0x68, 0x6f, 0x6c, 0x64, 0x45, 0x73,
+	0x63, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x17,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74,
+	0x65, 0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
+	0x00, 0x52, 0x15, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x16, 0x73, 0x77, 0x65, 0x65,
+	0x70, 0x5f, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x65,
+	0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x61, 0x5f, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x70, 0x62, 0x2e, 0x53, 0x77, 0x65, 0x65, 0x70, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x14, 0x73, 0x77, 0x65,
+	0x65, 0x70, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x93, 0x01, 0x0a, 0x20, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x48, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2e, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65,
+	0x72, 0x61, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x1d, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x65, 0x76, 0x72, 0x62, 0x6c, 0x6b, 0x2f, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72,
+	0x61, 0x2d, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x2f, 0x63, 0x6f, 0x72, 0x65, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -618,48 +2019,164 @@ func file_corepb_cloud_proto_rawDescGZIP() []byte {
 
 var file_corepb_cloud_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_corepb_cloud_proto_goTypes = []any{
-	(*ReadRequest)(nil),               // 0: com.evrblk.monstera_example.ledger.corepb.ReadRequest
-	(*ReadResponse)(nil),              // 1: com.evrblk.monstera_example.ledger.corepb.ReadResponse
-	(*UpdateRequest)(nil),             // 2: com.evrblk.monstera_example.ledger.corepb.UpdateRequest
-	(*UpdateResponse)(nil),            // 3: com.evrblk.monstera_example.ledger.corepb.UpdateResponse
-	(*GetTransactionRequest)(nil),     // 4: com.evrblk.monstera_example.ledger.corepb.GetTransactionRequest
-	(*ListTransactionsRequest)(nil),   // 5: com.evrblk.monstera_example.ledger.corepb.ListTransactionsRequest
-	(*GetAccountRequest)(nil),         // 6: com.evrblk.monstera_example.ledger.corepb.GetAccountRequest
-	(*x.Error)(nil),                   // 7: com.evrblk.monstera.monsterax.Error
-	(*GetTransactionResponse)(nil),    // 8: com.evrblk.monstera_example.ledger.corepb.GetTransactionResponse
-	(*ListTransactionsResponse)(nil),  // 9: com.evrblk.monstera_example.ledger.corepb.ListTransactionsResponse
-	(*GetAccountResponse)(nil),        // 10: com.evrblk.monstera_example.ledger.corepb.GetAccountResponse
-	(*CreateTransactionRequest)(nil),  // 11: com.evrblk.monstera_example.ledger.corepb.CreateTransactionRequest
-	(*CancelTransactionRequest)(nil),  // 12: com.evrblk.monstera_example.ledger.corepb.CancelTransactionRequest
-	(*SettleTransactionRequest)(nil),  // 13: com.evrblk.monstera_example.ledger.corepb.SettleTransactionRequest
-	(*CreateAccountRequest)(nil),      // 14: com.evrblk.monstera_example.ledger.corepb.CreateAccountRequest
-	(*CreateTransactionResponse)(nil), // 15: com.evrblk.monstera_example.ledger.corepb.CreateTransactionResponse
-	(*CancelTransactionResponse)(nil), // 16: com.evrblk.monstera_example.ledger.corepb.CancelTransactionResponse
-	(*SettleTransactionResponse)(nil), // 17: com.evrblk.monstera_example.ledger.corepb.SettleTransactionResponse
-	(*CreateAccountResponse)(nil),     // 18: com.evrblk.monstera_example.ledger.corepb.CreateAccountResponse
+	(*ReadRequest)(nil),                          // 0: com.evrblk.monstera_example.ledger.corepb.ReadRequest
+	(*ReadResponse)(nil),                         // 1: com.evrblk.monstera_example.ledger.corepb.ReadResponse
+	(*UpdateRequest)(nil),                        // 2: com.evrblk.monstera_example.ledger.corepb.UpdateRequest
+	(*UpdateResponse)(nil),                       // 3: com.evrblk.monstera_example.ledger.corepb.UpdateResponse
+	(*GetTransactionRequest)(nil),                // 4: com.evrblk.monstera_example.ledger.corepb.GetTransactionRequest
+	(*ListTransactionsRequest)(nil),              // 5: com.evrblk.monstera_example.ledger.corepb.ListTransactionsRequest
+	(*GetAccountRequest)(nil),                    // 6: com.evrblk.monstera_example.ledger.corepb.GetAccountRequest
+	(*GetDisputeRequest)(nil),                    // 7: com.evrblk.monstera_example.ledger.corepb.GetDisputeRequest
+	(*ListDisputesRequest)(nil),                  // 8: com.evrblk.monstera_example.ledger.corepb.ListDisputesRequest
+	(*ListAlertRulesRequest)(nil),                // 9: com.evrblk.monstera_example.ledger.corepb.ListAlertRulesRequest
+	(*ListTriggeredAlertsRequest)(nil),           // 10: com.evrblk.monstera_example.ledger.corepb.ListTriggeredAlertsRequest
+	(*ReadOutboxRequest)(nil),                    // 11: com.evrblk.monstera_example.ledger.corepb.ReadOutboxRequest
+	(*GetSpendingSummaryRequest)(nil),            // 12: com.evrblk.monstera_example.ledger.corepb.GetSpendingSummaryRequest
+	(*ListArchivableTransactionsRequest)(nil),    // 13: com.evrblk.monstera_example.ledger.corepb.ListArchivableTransactionsRequest
+	(*ListTransactionRollupsRequest)(nil),        // 14: com.evrblk.monstera_example.ledger.corepb.ListTransactionRollupsRequest
+	(*GetCustomerRequest)(nil),                   // 15: com.evrblk.monstera_example.ledger.corepb.GetCustomerRequest
+	(*GetShardStatsRequest)(nil),                 // 16: com.evrblk.monstera_example.ledger.corepb.GetShardStatsRequest
+	(*GetAccountFromFollowerRequest)(nil),        // 17: com.evrblk.monstera_example.ledger.corepb.GetAccountFromFollowerRequest
+	(*ListTransactionsFromFollowerRequest)(nil),  // 18: com.evrblk.monstera_example.ledger.corepb.ListTransactionsFromFollowerRequest
+	(*GetEscrowRequest)(nil),                     // 19: com.evrblk.monstera_example.ledger.corepb.GetEscrowRequest
+	(*ListEscrowsRequest)(nil),                   // 20: com.evrblk.monstera_example.ledger.corepb.ListEscrowsRequest
+	(*x.Error)(nil),                              // 21: com.evrblk.monstera.monsterax.Error
+	(*GetTransactionResponse)(nil),               // 22: com.evrblk.monstera_example.ledger.corepb.GetTransactionResponse
+	(*ListTransactionsResponse)(nil),             // 23: com.evrblk.monstera_example.ledger.corepb.ListTransactionsResponse
+	(*GetAccountResponse)(nil),                   // 24: com.evrblk.monstera_example.ledger.corepb.GetAccountResponse
+	(*GetDisputeResponse)(nil),                   // 25: com.evrblk.monstera_example.ledger.corepb.GetDisputeResponse
+	(*ListDisputesResponse)(nil),                 // 26: com.evrblk.monstera_example.ledger.corepb.ListDisputesResponse
+	(*ListAlertRulesResponse)(nil),               // 27: com.evrblk.monstera_example.ledger.corepb.ListAlertRulesResponse
+	(*ListTriggeredAlertsResponse)(nil),          // 28: com.evrblk.monstera_example.ledger.corepb.ListTriggeredAlertsResponse
+	(*ReadOutboxResponse)(nil),                   // 29: com.evrblk.monstera_example.ledger.corepb.ReadOutboxResponse
+	(*GetSpendingSummaryResponse)(nil),           // 30: com.evrblk.monstera_example.ledger.corepb.GetSpendingSummaryResponse
+	(*ListArchivableTransactionsResponse)(nil),   // 31: com.evrblk.monstera_example.ledger.corepb.ListArchivableTransactionsResponse
+	(*ListTransactionRollupsResponse)(nil),       // 32: com.evrblk.monstera_example.ledger.corepb.ListTransactionRollupsResponse
+	(*GetCustomerResponse)(nil),                  // 33: com.evrblk.monstera_example.ledger.corepb.GetCustomerResponse
+	(*GetShardStatsResponse)(nil),                // 34: com.evrblk.monstera_example.ledger.corepb.GetShardStatsResponse
+	(*GetAccountFromFollowerResponse)(nil),       // 35: com.evrblk.monstera_example.ledger.corepb.GetAccountFromFollowerResponse
+	(*ListTransactionsFromFollowerResponse)(nil), // 36: com.evrblk.monstera_example.ledger.corepb.ListTransactionsFromFollowerResponse
+	(*GetEscrowResponse)(nil),                    // 37: com.evrblk.monstera_example.ledger.corepb.GetEscrowResponse
+	(*ListEscrowsResponse)(nil),                  // 38: com.evrblk.monstera_example.ledger.corepb.ListEscrowsResponse
+	(*CreateTransactionRequest)(nil),             // 39: com.evrblk.monstera_example.ledger.corepb.CreateTransactionRequest
+	(*CancelTransactionRequest)(nil),             // 40: com.evrblk.monstera_example.ledger.corepb.CancelTransactionRequest
+	(*SettleTransactionRequest)(nil),             // 41: com.evrblk.monstera_example.ledger.corepb.SettleTransactionRequest
+	(*CreateAccountRequest)(nil),                 // 42: com.evrblk.monstera_example.ledger.corepb.CreateAccountRequest
+	(*OpenDisputeRequest)(nil),                   // 43: com.evrblk.monstera_example.ledger.corepb.OpenDisputeRequest
+	(*ResolveDisputeRequest)(nil),                // 44: com.evrblk.monstera_example.ledger.corepb.ResolveDisputeRequest
+	(*SweepPendingAvailabilityRequest)(nil),      // 45: com.evrblk.monstera_example.ledger.corepb.SweepPendingAvailabilityRequest
+	(*CreateAlertRuleRequest)(nil),               // 46: com.evrblk.monstera_example.ledger.corepb.CreateAlertRuleRequest
+	(*DeleteAlertRuleRequest)(nil),               // 47: com.evrblk.monstera_example.ledger.corepb.DeleteAlertRuleRequest
+	(*AckAlertRequest)(nil),                      // 48: com.evrblk.monstera_example.ledger.corepb.AckAlertRequest
+	(*AckOutboxRequest)(nil),                     // 49: com.evrblk.monstera_example.ledger.corepb.AckOutboxRequest
+	(*ArchiveTransactionsRequest)(nil),           // 50: com.evrblk.monstera_example.ledger.corepb.ArchiveTransactionsRequest
+	(*CreateCustomerRequest)(nil),                // 51: com.evrblk.monstera_example.ledger.corepb.CreateCustomerRequest
+	(*AddCustomerAccountRequest)(nil),            // 52: com.evrblk.monstera_example.ledger.corepb.AddCustomerAccountRequest
+	(*RebuildShardStatsRequest)(nil),             // 53: com.evrblk.monstera_example.ledger.corepb.RebuildShardStatsRequest
+	(*HoldEscrowRequest)(nil),                    // 54: com.evrblk.monstera_example.ledger.corepb.HoldEscrowRequest
+	(*ReleaseEscrowRequest)(nil),                 // 55: com.evrblk.monstera_example.ledger.corepb.ReleaseEscrowRequest
+	(*SweepEscrowsRequest)(nil),                  // 56: com.evrblk.monstera_example.ledger.corepb.SweepEscrowsRequest
+	(*RemoveCustomerAccountRequest)(nil),         // 57: com.evrblk.monstera_example.ledger.corepb.RemoveCustomerAccountRequest
+	(*CreateTransactionResponse)(nil),            // 58: com.evrblk.monstera_example.ledger.corepb.CreateTransactionResponse
+	(*CancelTransactionResponse)(nil),            // 59: com.evrblk.monstera_example.ledger.corepb.CancelTransactionResponse
+	(*SettleTransactionResponse)(nil),            // 60: com.evrblk.monstera_example.ledger.corepb.SettleTransactionResponse
+	(*CreateAccountResponse)(nil),                // 61: com.evrblk.monstera_example.ledger.corepb.CreateAccountResponse
+	(*OpenDisputeResponse)(nil),                  // 62: com.evrblk.monstera_example.ledger.corepb.OpenDisputeResponse
+	(*ResolveDisputeResponse)(nil),               // 63: com.evrblk.monstera_example.ledger.corepb.ResolveDisputeResponse
+	(*SweepPendingAvailabilityResponse)(nil),     // 64: com.evrblk.monstera_example.ledger.corepb.SweepPendingAvailabilityResponse
+	(*CreateAlertRuleResponse)(nil),              // 65: com.evrblk.monstera_example.ledger.corepb.CreateAlertRuleResponse
+	(*DeleteAlertRuleResponse)(nil),              // 66: com.evrblk.monstera_example.ledger.corepb.DeleteAlertRuleResponse
+	(*AckAlertResponse)(nil),                     // 67: com.evrblk.monstera_example.ledger.corepb.AckAlertResponse
+	(*AckOutboxResponse)(nil),                    // 68: com.evrblk.monstera_example.ledger.corepb.AckOutboxResponse
+	(*ArchiveTransactionsResponse)(nil),          // 69: com.evrblk.monstera_example.ledger.corepb.ArchiveTransactionsResponse
+	(*CreateCustomerResponse)(nil),               // 70: com.evrblk.monstera_example.ledger.corepb.CreateCustomerResponse
+	(*AddCustomerAccountResponse)(nil),           // 71: com.evrblk.monstera_example.ledger.corepb.AddCustomerAccountResponse
+	(*RebuildShardStatsResponse)(nil),            // 72: com.evrblk.monstera_example.ledger.corepb.RebuildShardStatsResponse
+	(*HoldEscrowResponse)(nil),                   // 73: com.evrblk.monstera_example.ledger.corepb.HoldEscrowResponse
+	(*ReleaseEscrowResponse)(nil),                // 74: com.evrblk.monstera_example.ledger.corepb.ReleaseEscrowResponse
+	(*SweepEscrowsResponse)(nil),                 // 75: com.evrblk.monstera_example.ledger.corepb.SweepEscrowsResponse
+	(*RemoveCustomerAccountResponse)(nil),        // 76: com.evrblk.monstera_example.ledger.corepb.RemoveCustomerAccountResponse
 }
 var file_corepb_cloud_proto_depIdxs = []int32{
 	4,  // 0: com.evrblk.monstera_example.ledger.corepb.ReadRequest.get_transaction_request:type_name -> com.evrblk.monstera_example.ledger.corepb.GetTransactionRequest
 	5,  // 1: com.evrblk.monstera_example.ledger.corepb.ReadRequest.list_transactions_request:type_name -> com.evrblk.monstera_example.ledger.corepb.ListTransactionsRequest
 	6,  // 2: com.evrblk.monstera_example.ledger.corepb.ReadRequest.get_account_request:type_name -> com.evrblk.monstera_example.ledger.corepb.GetAccountRequest
-	7,  // 3: com.evrblk.monstera_example.ledger.corepb.ReadResponse.error:type_name -> com.evrblk.monstera.monsterax.Error
-	8,  // 4: com.evrblk.monstera_example.ledger.corepb.ReadResponse.get_transaction_response:type_name -> com.evrblk.monstera_example.ledger.corepb.GetTransactionResponse
-	9,  // 5: com.evrblk.monstera_example.ledger.corepb.ReadResponse.list_transactions_response:type_name -> com.evrblk.monstera_example.ledger.corepb.ListTransactionsResponse
-	10, // 6: com.evrblk.monstera_example.ledger.corepb.ReadResponse.get_account_response:type_name -> com.evrblk.monstera_example.ledger.corepb.GetAccountResponse
-	11, // 7: com.evrblk.monstera_example.ledger.corepb.UpdateRequest.create_transaction_request:type_name -> com.evrblk.monstera_example.ledger.corepb.CreateTransactionRequest
-	12, // 8: com.evrblk.monstera_example.ledger.corepb.UpdateRequest.cancel_transaction_request:type_name -> com.evrblk.monstera_example.ledger.corepb.CancelTransactionRequest
-	13, // 9: com.evrblk.monstera_example.ledger.corepb.UpdateRequest.settle_transaction_request:type_name -> com.evrblk.monstera_example.ledger.corepb.SettleTransactionRequest
-	14, // 10: com.evrblk.monstera_example.ledger.corepb.UpdateRequest.create_account_request:type_name -> com.evrblk.monstera_example.ledger.corepb.CreateAccountRequest
-	7,  // 11: com.evrblk.monstera_example.ledger.corepb.UpdateResponse.error:type_name -> com.evrblk.monstera.monsterax.Error
-	15, // 12: com.evrblk.monstera_example.ledger.corepb.UpdateResponse.create_transaction_response:type_name -> com.evrblk.monstera_example.ledger.corepb.CreateTransactionResponse
-	16, // 13: com.evrblk.monstera_example.ledger.corepb.UpdateResponse.cancel_transaction_response:type_name -> com.evrblk.monstera_example.ledger.corepb.CancelTransactionResponse
-	17, // 14: com.evrblk.monstera_example.ledger.corepb.UpdateResponse.settle_transaction_response:type_name -> com.evrblk.monstera_example.ledger.corepb.SettleTransactionResponse
-	18, // 15: com.evrblk.monstera_example.ledger.corepb.UpdateResponse.create_account_response:type_name -> com.evrblk.monstera_example.ledger.corepb.CreateAccountResponse
-	16, // [16:16] is the sub-list for method output_type
-	16, // [16:16] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	7,  // 3: com.evrblk.monstera_example.ledger.corepb.ReadRequest.get_dispute_request:type_name -> com.evrblk.monstera_example.ledger.corepb.GetDisputeRequest
+	8,  // 4: com.evrblk.monstera_example.ledger.corepb.ReadRequest.list_disputes_request:type_name -> com.evrblk.monstera_example.ledger.corepb.ListDisputesRequest
+	9,  // 5: com.evrblk.monstera_example.ledger.corepb.ReadRequest.list_alert_rules_request:type_name -> com.evrblk.monstera_example.ledger.corepb.ListAlertRulesRequest
+	10, // 6: com.evrblk.monstera_example.ledger.corepb.ReadRequest.list_triggered_alerts_request:type_name -> com.evrblk.monstera_example.ledger.corepb.ListTriggeredAlertsRequest
+	11, // 7: com.evrblk.monstera_example.ledger.corepb.ReadRequest.read_outbox_request:type_name -> com.evrblk.monstera_example.ledger.corepb.ReadOutboxRequest
+	12, // 8: com.evrblk.monstera_example.ledger.corepb.ReadRequest.get_spending_summary_request:type_name -> com.evrblk.monstera_example.ledger.corepb.GetSpendingSummaryRequest
+	13, // 9: com.evrblk.monstera_example.ledger.corepb.ReadRequest.list_archivable_transactions_request:type_name -> com.evrblk.monstera_example.ledger.corepb.ListArchivableTransactionsRequest
+	14, // 10: com.evrblk.monstera_example.ledger.corepb.ReadRequest.list_transaction_rollups_request:type_name -> com.evrblk.monstera_example.ledger.corepb.ListTransactionRollupsRequest
+	15, // 11: com.evrblk.monstera_example.ledger.corepb.ReadRequest.get_customer_request:type_name -> com.evrblk.monstera_example.ledger.corepb.GetCustomerRequest
+	16, // 12: com.evrblk.monstera_example.ledger.corepb.ReadRequest.get_shard_stats_request:type_name -> com.evrblk.monstera_example.ledger.corepb.GetShardStatsRequest
+	17, // 13: com.evrblk.monstera_example.ledger.corepb.ReadRequest.get_account_from_follower_request:type_name -> com.evrblk.monstera_example.ledger.corepb.GetAccountFromFollowerRequest
+	18, // 14: com.evrblk.monstera_example.ledger.corepb.ReadRequest.list_transactions_from_follower_request:type_name -> com.evrblk.monstera_example.ledger.corepb.ListTransactionsFromFollowerRequest
+	19, // 15: com.evrblk.monstera_example.ledger.corepb.ReadRequest.get_escrow_request:type_name -> com.evrblk.monstera_example.ledger.corepb.GetEscrowRequest
+	20, // 16: com.evrblk.monstera_example.ledger.corepb.ReadRequest.list_escrows_request:type_name -> com.evrblk.monstera_example.ledger.corepb.ListEscrowsRequest
+	21, // 17: com.evrblk.monstera_example.ledger.corepb.ReadResponse.error:type_name -> com.evrblk.monstera.monsterax.Error
+	22, // 18: com.evrblk.monstera_example.ledger.corepb.ReadResponse.get_transaction_response:type_name -> com.evrblk.monstera_example.ledger.corepb.GetTransactionResponse
+	23, // 19: com.evrblk.monstera_example.ledger.corepb.ReadResponse.list_transactions_response:type_name -> com.evrblk.monstera_example.ledger.corepb.ListTransactionsResponse
+	24, // 20: com.evrblk.monstera_example.ledger.corepb.ReadResponse.get_account_response:type_name -> com.evrblk.monstera_example.ledger.corepb.GetAccountResponse
+	25, // 21: com.evrblk.monstera_example.ledger.corepb.ReadResponse.get_dispute_response:type_name -> com.evrblk.monstera_example.ledger.corepb.GetDisputeResponse
+	26, // 22: com.evrblk.monstera_example.ledger.corepb.ReadResponse.list_disputes_response:type_name -> com.evrblk.monstera_example.ledger.corepb.ListDisputesResponse
+	27, // 23: com.evrblk.monstera_example.ledger.corepb.ReadResponse.list_alert_rules_response:type_name -> com.evrblk.monstera_example.ledger.corepb.ListAlertRulesResponse
+	28, // 24: com.evrblk.monstera_example.ledger.corepb.ReadResponse.list_triggered_alerts_response:type_name -> com.evrblk.monstera_example.ledger.corepb.ListTriggeredAlertsResponse
+	29, // 25: com.evrblk.monstera_example.ledger.corepb.ReadResponse.read_outbox_response:type_name -> com.evrblk.monstera_example.ledger.corepb.ReadOutboxResponse
+	30, // 26: com.evrblk.monstera_example.ledger.corepb.ReadResponse.get_spending_summary_response:type_name -> com.evrblk.monstera_example.ledger.corepb.GetSpendingSummaryResponse
+	31, // 27: com.evrblk.monstera_example.ledger.corepb.ReadResponse.list_archivable_transactions_response:type_name -> com.evrblk.monstera_example.ledger.corepb.ListArchivableTransactionsResponse
+	32, // 28: com.evrblk.monstera_example.ledger.corepb.ReadResponse.list_transaction_rollups_response:type_name -> com.evrblk.monstera_example.ledger.corepb.ListTransactionRollupsResponse
+	33, // 29: com.evrblk.monstera_example.ledger.corepb.ReadResponse.get_customer_response:type_name -> com.evrblk.monstera_example.ledger.corepb.GetCustomerResponse
+	34, // 30: com.evrblk.monstera_example.ledger.corepb.ReadResponse.get_shard_stats_response:type_name -> com.evrblk.monstera_example.ledger.corepb.GetShardStatsResponse
+	35, // 31: com.evrblk.monstera_example.ledger.corepb.ReadResponse.get_account_from_follower_response:type_name -> com.evrblk.monstera_example.ledger.corepb.GetAccountFromFollowerResponse
+	36, // 32: com.evrblk.monstera_example.ledger.corepb.ReadResponse.list_transactions_from_follower_response:type_name -> com.evrblk.monstera_example.ledger.corepb.ListTransactionsFromFollowerResponse
+	37, // 33: com.evrblk.monstera_example.ledger.corepb.ReadResponse.get_escrow_response:type_name -> com.evrblk.monstera_example.ledger.corepb.GetEscrowResponse
+	38, // 34: com.evrblk.monstera_example.ledger.corepb.ReadResponse.list_escrows_response:type_name -> com.evrblk.monstera_example.ledger.corepb.ListEscrowsResponse
+	39, // 35: com.evrblk.monstera_example.ledger.corepb.UpdateRequest.create_transaction_request:type_name -> com.evrblk.monstera_example.ledger.corepb.CreateTransactionRequest
+	40, // 36: com.evrblk.monstera_example.ledger.corepb.UpdateRequest.cancel_transaction_request:type_name -> com.evrblk.monstera_example.ledger.corepb.CancelTransactionRequest
+	41, // 37: com.evrblk.monstera_example.ledger.corepb.UpdateRequest.settle_transaction_request:type_name -> com.evrblk.monstera_example.ledger.corepb.SettleTransactionRequest
+	42, // 38: com.evrblk.monstera_example.ledger.corepb.UpdateRequest.create_account_request:type_name -> com.evrblk.monstera_example.ledger.corepb.CreateAccountRequest
+	43, // 39: com.evrblk.monstera_example.ledger.corepb.UpdateRequest.open_dispute_request:type_name -> com.evrblk.monstera_example.ledger.corepb.OpenDisputeRequest
+	44, // 40: com.evrblk.monstera_example.ledger.corepb.UpdateRequest.resolve_dispute_request:type_name -> com.evrblk.monstera_example.ledger.corepb.ResolveDisputeRequest
+	45, // 41: com.evrblk.monstera_example.ledger.corepb.UpdateRequest.sweep_pending_availability_request:type_name -> com.evrblk.monstera_example.ledger.corepb.SweepPendingAvailabilityRequest
+	46, // 42: com.evrblk.monstera_example.ledger.corepb.UpdateRequest.create_alert_rule_request:type_name -> com.evrblk.monstera_example.ledger.corepb.CreateAlertRuleRequest
+	47, // 43: com.evrblk.monstera_example.ledger.corepb.UpdateRequest.delete_alert_rule_request:type_name -> com.evrblk.monstera_example.ledger.corepb.DeleteAlertRuleRequest
+	48, // 44: com.evrblk.monstera_example.ledger.corepb.UpdateRequest.ack_alert_request:type_name -> com.evrblk.monstera_example.ledger.corepb.AckAlertRequest
+	49, // 45: com.evrblk.monstera_example.ledger.corepb.UpdateRequest.ack_outbox_request:type_name -> com.evrblk.monstera_example.ledger.corepb.AckOutboxRequest
+	50, // 46: com.evrblk.monstera_example.ledger.corepb.UpdateRequest.archive_transactions_request:type_name -> com.evrblk.monstera_example.ledger.corepb.ArchiveTransactionsRequest
+	51, // 47: com.evrblk.monstera_example.ledger.corepb.UpdateRequest.create_customer_request:type_name -> com.evrblk.monstera_example.ledger.corepb.CreateCustomerRequest
+	52, // 48: com.evrblk.monstera_example.ledger.corepb.UpdateRequest.add_customer_account_request:type_name -> com.evrblk.monstera_example.ledger.corepb.AddCustomerAccountRequest
+	53, // 49: com.evrblk.monstera_example.ledger.corepb.UpdateRequest.rebuild_shard_stats_request:type_name -> com.evrblk.monstera_example.ledger.corepb.RebuildShardStatsRequest
+	54, // 50: com.evrblk.monstera_example.ledger.corepb.UpdateRequest.hold_escrow_request:type_name -> com.evrblk.monstera_example.ledger.corepb.HoldEscrowRequest
+	55, // 51: com.evrblk.monstera_example.ledger.corepb.UpdateRequest.release_escrow_request:type_name -> com.evrblk.monstera_example.ledger.corepb.ReleaseEscrowRequest
+	56, // 52: com.evrblk.monstera_example.ledger.corepb.UpdateRequest.sweep_escrows_request:type_name -> com.evrblk.monstera_example.ledger.corepb.SweepEscrowsRequest
+	57, // 53: com.evrblk.monstera_example.ledger.corepb.UpdateRequest.remove_customer_account_request:type_name -> com.evrblk.monstera_example.ledger.corepb.RemoveCustomerAccountRequest
+	21, // 54: com.evrblk.monstera_example.ledger.corepb.UpdateResponse.error:type_name -> com.evrblk.monstera.monsterax.Error
+	58, // 55: com.evrblk.monstera_example.ledger.corepb.UpdateResponse.create_transaction_response:type_name -> com.evrblk.monstera_example.ledger.corepb.CreateTransactionResponse
+	59, // 56: com.evrblk.monstera_example.ledger.corepb.UpdateResponse.cancel_transaction_response:type_name -> com.evrblk.monstera_example.ledger.corepb.CancelTransactionResponse
+	60, // 57: com.evrblk.monstera_example.ledger.corepb.UpdateResponse.settle_transaction_response:type_name -> com.evrblk.monstera_example.ledger.corepb.SettleTransactionResponse
+	61, // 58: com.evrblk.monstera_example.ledger.corepb.UpdateResponse.create_account_response:type_name -> com.evrblk.monstera_example.ledger.corepb.CreateAccountResponse
+	62, // 59: com.evrblk.monstera_example.ledger.corepb.UpdateResponse.open_dispute_response:type_name -> com.evrblk.monstera_example.ledger.corepb.OpenDisputeResponse
+	63, // 60: com.evrblk.monstera_example.ledger.corepb.UpdateResponse.resolve_dispute_response:type_name -> com.evrblk.monstera_example.ledger.corepb.ResolveDisputeResponse
+	64, // 61: com.evrblk.monstera_example.ledger.corepb.UpdateResponse.sweep_pending_availability_response:type_name -> com.evrblk.monstera_example.ledger.corepb.SweepPendingAvailabilityResponse
+	65, // 62: com.evrblk.monstera_example.ledger.corepb.UpdateResponse.create_alert_rule_response:type_name -> com.evrblk.monstera_example.ledger.corepb.CreateAlertRuleResponse
+	66, // 63: com.evrblk.monstera_example.ledger.corepb.UpdateResponse.delete_alert_rule_response:type_name -> com.evrblk.monstera_example.ledger.corepb.DeleteAlertRuleResponse
+	67, // 64: com.evrblk.monstera_example.ledger.corepb.UpdateResponse.ack_alert_response:type_name -> com.evrblk.monstera_example.ledger.corepb.AckAlertResponse
+	68, // 65: com.evrblk.monstera_example.ledger.corepb.UpdateResponse.ack_outbox_response:type_name -> com.evrblk.monstera_example.ledger.corepb.AckOutboxResponse
+	69, // 66: com.evrblk.monstera_example.ledger.corepb.UpdateResponse.archive_transactions_response:type_name -> com.evrblk.monstera_example.ledger.corepb.ArchiveTransactionsResponse
+	70, // 67: com.evrblk.monstera_example.ledger.corepb.UpdateResponse.create_customer_response:type_name -> com.evrblk.monstera_example.ledger.corepb.CreateCustomerResponse
+	71, // 68: com.evrblk.monstera_example.ledger.corepb.UpdateResponse.add_customer_account_response:type_name -> com.evrblk.monstera_example.ledger.corepb.AddCustomerAccountResponse
+	72, // 69: com.evrblk.monstera_example.ledger.corepb.UpdateResponse.rebuild_shard_stats_response:type_name -> com.evrblk.monstera_example.ledger.corepb.RebuildShardStatsResponse
+	73, // 70: com.evrblk.monstera_example.ledger.corepb.UpdateResponse.hold_escrow_response:type_name -> com.evrblk.monstera_example.ledger.corepb.HoldEscrowResponse
+	74, // 71: com.evrblk.monstera_example.ledger.corepb.UpdateResponse.release_escrow_response:type_name -> com.evrblk.monstera_example.ledger.corepb.ReleaseEscrowResponse
+	75, // 72: com.evrblk.monstera_example.ledger.corepb.UpdateResponse.sweep_escrows_response:type_name -> com.evrblk.monstera_example.ledger.corepb.SweepEscrowsResponse
+	76, // 73: com.evrblk.monstera_example.ledger.corepb.UpdateResponse.remove_customer_account_response:type_name -> com.evrblk.monstera_example.ledger.corepb.RemoveCustomerAccountResponse
+	74, // [74:74] is the sub-list for method output_type
+	74, // [74:74] is the sub-list for method input_type
+	74, // [74:74] is the sub-list for extension type_name
+	74, // [74:74] is the sub-list for extension extendee
+	0,  // [0:74] is the sub-list for field type_name
 }
 
 func init() { file_corepb_cloud_proto_init() }
@@ -672,23 +2189,81 @@ func file_corepb_cloud_proto_init() {
 		(*ReadRequest_GetTransactionRequest)(nil),
 		(*ReadRequest_ListTransactionsRequest)(nil),
 		(*ReadRequest_GetAccountRequest)(nil),
+		(*ReadRequest_GetDisputeRequest)(nil),
+		(*ReadRequest_ListDisputesRequest)(nil),
+		(*ReadRequest_ListAlertRulesRequest)(nil),
+		(*ReadRequest_ListTriggeredAlertsRequest)(nil),
+		(*ReadRequest_ReadOutboxRequest)(nil),
+		(*ReadRequest_GetSpendingSummaryRequest)(nil),
+		(*ReadRequest_ListArchivableTransactionsRequest)(nil),
+		(*ReadRequest_ListTransactionRollupsRequest)(nil),
+		(*ReadRequest_GetCustomerRequest)(nil),
+		(*ReadRequest_GetShardStatsRequest)(nil),
+		(*ReadRequest_GetAccountFromFollowerRequest)(nil),
+		(*ReadRequest_ListTransactionsFromFollowerRequest)(nil),
+		(*ReadRequest_GetEscrowRequest)(nil),
+		(*ReadRequest_ListEscrowsRequest)(nil),
 	}
 	file_corepb_cloud_proto_msgTypes[1].OneofWrappers = []any{
 		(*ReadResponse_GetTransactionResponse)(nil),
 		(*ReadResponse_ListTransactionsResponse)(nil),
 		(*ReadResponse_GetAccountResponse)(nil),
+		(*ReadResponse_GetDisputeResponse)(nil),
+		(*ReadResponse_ListDisputesResponse)(nil),
+		(*ReadResponse_ListAlertRulesResponse)(nil),
+		(*ReadResponse_ListTriggeredAlertsResponse)(nil),
+		(*ReadResponse_ReadOutboxResponse)(nil),
+		(*ReadResponse_GetSpendingSummaryResponse)(nil),
+		(*ReadResponse_ListArchivableTransactionsResponse)(nil),
+		(*ReadResponse_ListTransactionRollupsResponse)(nil),
+		(*ReadResponse_GetCustomerResponse)(nil),
+		(*ReadResponse_GetShardStatsResponse)(nil),
+		(*ReadResponse_GetAccountFromFollowerResponse)(nil),
+		(*ReadResponse_ListTransactionsFromFollowerResponse)(nil),
+		(*ReadResponse_GetEscrowResponse)(nil),
+		(*ReadResponse_ListEscrowsResponse)(nil),
 	}
 	file_corepb_cloud_proto_msgTypes[2].OneofWrappers = []any{
 		(*UpdateRequest_CreateTransactionRequest)(nil),
 		(*UpdateRequest_CancelTransactionRequest)(nil),
 		(*UpdateRequest_SettleTransactionRequest)(nil),
 		(*UpdateRequest_CreateAccountRequest)(nil),
+		(*UpdateRequest_OpenDisputeRequest)(nil),
+		(*UpdateRequest_ResolveDisputeRequest)(nil),
+		(*UpdateRequest_SweepPendingAvailabilityRequest)(nil),
+		(*UpdateRequest_CreateAlertRuleRequest)(nil),
+		(*UpdateRequest_DeleteAlertRuleRequest)(nil),
+		(*UpdateRequest_AckAlertRequest)(nil),
+		(*UpdateRequest_AckOutboxRequest)(nil),
+		(*UpdateRequest_ArchiveTransactionsRequest)(nil),
+		(*UpdateRequest_CreateCustomerRequest)(nil),
+		(*UpdateRequest_AddCustomerAccountRequest)(nil),
+		(*UpdateRequest_RebuildShardStatsRequest)(nil),
+		(*UpdateRequest_HoldEscrowRequest)(nil),
+		(*UpdateRequest_ReleaseEscrowRequest)(nil),
+		(*UpdateRequest_SweepEscrowsRequest)(nil),
+		(*UpdateRequest_RemoveCustomerAccountRequest)(nil),
 	}
 	file_corepb_cloud_proto_msgTypes[3].OneofWrappers = []any{
 		(*UpdateResponse_CreateTransactionResponse)(nil),
 		(*UpdateResponse_CancelTransactionResponse)(nil),
 		(*UpdateResponse_SettleTransactionResponse)(nil),
 		(*UpdateResponse_CreateAccountResponse)(nil),
+		(*UpdateResponse_OpenDisputeResponse)(nil),
+		(*UpdateResponse_ResolveDisputeResponse)(nil),
+		(*UpdateResponse_SweepPendingAvailabilityResponse)(nil),
+		(*UpdateResponse_CreateAlertRuleResponse)(nil),
+		(*UpdateResponse_DeleteAlertRuleResponse)(nil),
+		(*UpdateResponse_AckAlertResponse)(nil),
+		(*UpdateResponse_AckOutboxResponse)(nil),
+		(*UpdateResponse_ArchiveTransactionsResponse)(nil),
+		(*UpdateResponse_CreateCustomerResponse)(nil),
+		(*UpdateResponse_AddCustomerAccountResponse)(nil),
+		(*UpdateResponse_RebuildShardStatsResponse)(nil),
+		(*UpdateResponse_HoldEscrowResponse)(nil),
+		(*UpdateResponse_ReleaseEscrowResponse)(nil),
+		(*UpdateResponse_SweepEscrowsResponse)(nil),
+		(*UpdateResponse_RemoveCustomerAccountResponse)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -0,0 +1,192 @@
+package ledger
+
+import (
+	"fmt"
+	"io"
+	"slices"
+
+	"errors"
+
+	"github.com/evrblk/monstera"
+	"github.com/evrblk/monstera-example/ledger/corepb"
+	monsterax "github.com/evrblk/monstera/x"
+)
+
+// maxAccountsPerCustomer limits the size of a customer record and of fan-out reads over customer accounts
+const maxAccountsPerCustomer = 100
+
+type CustomersCore struct {
+	badgerStore    *monstera.BadgerStore
+	customersTable *monsterax.SimpleKeyTable[*corepb.Customer, corepb.Customer]
+}
+
+var _ CustomersCoreApi = &CustomersCore{}
+
+func NewCustomersCore(badgerStore *monstera.BadgerStore, shardLowerBound []byte, shardUpperBound []byte) *CustomersCore {
+	return &CustomersCore{
+		badgerStore:    badgerStore,
+		customersTable: monsterax.NewSimpleKeyTable[*corepb.Customer, corepb.Customer](customersTableId, shardLowerBound, shardUpperBound),
+	}
+}
+
+func (c *CustomersCore) ranges() []monstera.KeyRange {
+	return []monstera.KeyRange{
+		c.customersTable.GetTableKeyRange(),
+	}
+}
+
+func (c *CustomersCore) Snapshot() monstera.ApplicationCoreSnapshot {
+	return monsterax.Snapshot(c.badgerStore, c.ranges())
+}
+
+func (c *CustomersCore) Restore(reader io.ReadCloser) error {
+	return monsterax.Restore(c.badgerStore, c.ranges(), reader)
+}
+
+func (c *CustomersCore) Close() {
+
+}
+
+func (c *CustomersCore) GetCustomer(request *corepb.GetCustomerRequest) (*corepb.GetCustomerResponse, error) {
+	txn := c.badgerStore.View()
+	defer txn.Discard()
+
+	customer, err := c.getCustomer(txn, request.CustomerId)
+	if err != nil {
+		if errors.Is(err, monstera.ErrNotFound) {
+			return nil, monsterax.NewErrorWithContext(
+				monsterax.NotFound,
+				"customer not found",
+				map[string]string{"customer_id": EncodeCustomerId(request.CustomerId)})
+		} else {
+			panic(err)
+		}
+	}
+
+	return &corepb.GetCustomerResponse{
+		Customer: customer,
+	}, nil
+}
+
+func (c *CustomersCore) CreateCustomer(request *corepb.CreateCustomerRequest) (*corepb.CreateCustomerResponse, error) {
+	txn := c.badgerStore.Update()
+	defer txn.Discard()
+
+	customer := &corepb.Customer{
+		Id:         request.CustomerId,
+		Name:       request.Name,
+		Email:      request.Email,
+		AccountIds: []uint64{},
+		CreatedAt:  request.Now,
+		UpdatedAt:  request.Now,
+	}
+
+	err := c.updateCustomer(txn, customer)
+	panicIfNotNil(err)
+
+	err = txn.Commit()
+	panicIfNotNil(err)
+
+	return &corepb.CreateCustomerResponse{
+		Customer: customer,
+	}, nil
+}
+
+// AddCustomerAccount links an account to a customer. Accounts live in Accounts core on other shards, so their
+// existence is not checked here. Adding the same account twice is a no-op.
+func (c *CustomersCore) AddCustomerAccount(request *corepb.AddCustomerAccountRequest) (*corepb.AddCustomerAccountResponse, error) {
+	txn := c.badgerStore.Update()
+	defer txn.Discard()
+
+	customer, err := c.getCustomer(txn, request.CustomerId)
+	if err != nil {
+		if errors.Is(err, monstera.ErrNotFound) {
+			return nil, monsterax.NewErrorWithContext(
+				monsterax.NotFound,
+				"customer not found",
+				map[string]string{"customer_id": EncodeCustomerId(request.CustomerId)})
+		} else {
+			panic(err)
+		}
+	}
+
+	if slices.Contains(customer.AccountIds, request.AccountId) {
+		return &corepb.AddCustomerAccountResponse{
+			Customer: customer,
+		}, nil
+	}
+
+	if len(customer.AccountIds) >= maxAccountsPerCustomer {
+		return nil, monsterax.NewErrorWithContext(
+			monsterax.ResourceExhausted,
+			"max number of accounts per customer reached",
+			map[string]string{"limit": fmt.Sprintf("%d", maxAccountsPerCustomer)})
+	}
+
+	customer.AccountIds = append(customer.AccountIds, request.AccountId)
+	customer.UpdatedAt = request.Now
+
+	err = c.updateCustomer(txn, customer)
+	panicIfNotNil(err)
+
+	err = txn.Commit()
+	panicIfNotNil(err)
+
+	return &corepb.AddCustomerAccountResponse{
+		Customer: customer,
+	}, nil
+}
+
+// RemoveCustomerAccount unlinks an account from a customer. It compensates AddCustomerAccount when the account could
+// not be created. Removing an account which is not linked is a no-op.
+func (c *CustomersCore) RemoveCustomerAccount(request *corepb.RemoveCustomerAccountRequest) (*corepb.RemoveCustomerAccountResponse, error) {
+	txn := c.badgerStore.Update()
+	defer txn.Discard()
+
+	customer, err := c.getCustomer(txn, request.CustomerId)
+	if err != nil {
+		if errors.Is(err, monstera.ErrNotFound) {
+			return nil, monsterax.NewErrorWithContext(
+				monsterax.NotFound,
+				"customer not found",
+				map[string]string{"customer_id": EncodeCustomerId(request.CustomerId)})
+		} else {
+			panic(err)
+		}
+	}
+
+	if !slices.Contains(customer.AccountIds, request.AccountId) {
+		return &corepb.RemoveCustomerAccountResponse{
+			Customer: customer,
+		}, nil
+	}
+
+	customer.AccountIds = slices.DeleteFunc(customer.AccountIds, func(accountId uint64) bool {
+		return accountId == request.AccountId
+	})
+	customer.UpdatedAt = request.Now
+
+	err = c.updateCustomer(txn, customer)
+	panicIfNotNil(err)
+
+	err = txn.Commit()
+	panicIfNotNil(err)
+
+	return &corepb.RemoveCustomerAccountResponse{
+		Customer: customer,
+	}, nil
+}
+
+func (c *CustomersCore) getCustomer(txn *monstera.Txn, customerId uint64) (*corepb.Customer, error) {
+	return c.customersTable.Get(txn, customersTablePK(customerId))
+}
+
+func (c *CustomersCore) updateCustomer(txn *monstera.Txn, customer *corepb.Customer) error {
+	return c.customersTable.Set(txn, customersTablePK(customer.Id), customer)
+}
+
+// 1. shard key (by customer id)
+// 2. customer id
+func customersTablePK(customerId uint64) []byte {
+	return monstera.ConcatBytes(shardByCustomer(customerId), customerId)
+}
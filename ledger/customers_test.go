@@ -0,0 +1,255 @@
+package ledger
+
+import (
+	"context"
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/evrblk/monstera"
+	"github.com/evrblk/monstera-example/ledger/corepb"
+	"github.com/evrblk/monstera-example/ledger/gatewaypb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCustomerAndAddAccounts(t *testing.T) {
+	require := require.New(t)
+
+	customersCore := newCustomersCore()
+
+	now := time.Now()
+
+	customerId := rand.Uint64()
+
+	// create customer
+	response1, err := customersCore.CreateCustomer(&corepb.CreateCustomerRequest{
+		CustomerId: customerId,
+		Name:       "John Doe",
+		Email:      "john@example.com",
+		Now:        now.UnixNano(),
+	})
+	require.NoError(err)
+	require.Equal("John Doe", response1.Customer.Name)
+	require.Empty(response1.Customer.AccountIds)
+
+	// add two accounts, the second one twice
+	accountId1 := rand.Uint64()
+	accountId2 := rand.Uint64()
+
+	for _, accountId := range []uint64{accountId1, accountId2, accountId2} {
+		_, err = customersCore.AddCustomerAccount(&corepb.AddCustomerAccountRequest{
+			CustomerId: customerId,
+			AccountId:  accountId,
+			Now:        now.UnixNano(),
+		})
+		require.NoError(err)
+	}
+
+	response2, err := customersCore.GetCustomer(&corepb.GetCustomerRequest{
+		CustomerId: customerId,
+	})
+	require.NoError(err)
+	require.Equal([]uint64{accountId1, accountId2}, response2.Customer.AccountIds)
+
+	// non-existent customer
+	_, err = customersCore.GetCustomer(&corepb.GetCustomerRequest{
+		CustomerId: rand.Uint64(),
+	})
+	require.Error(err)
+
+	_, err = customersCore.AddCustomerAccount(&corepb.AddCustomerAccountRequest{
+		CustomerId: rand.Uint64(),
+		AccountId:  accountId1,
+		Now:        now.UnixNano(),
+	})
+	require.Error(err)
+}
+
+func TestMaxAccountsPerCustomer(t *testing.T) {
+	require := require.New(t)
+
+	customersCore := newCustomersCore()
+
+	now := time.Now()
+
+	customerId := rand.Uint64()
+
+	_, err := customersCore.CreateCustomer(&corepb.CreateCustomerRequest{
+		CustomerId: customerId,
+		Now:        now.UnixNano(),
+	})
+	require.NoError(err)
+
+	for i := 0; i < maxAccountsPerCustomer; i++ {
+		_, err = customersCore.AddCustomerAccount(&corepb.AddCustomerAccountRequest{
+			CustomerId: customerId,
+			AccountId:  rand.Uint64(),
+			Now:        now.UnixNano(),
+		})
+		require.NoError(err)
+	}
+
+	_, err = customersCore.AddCustomerAccount(&corepb.AddCustomerAccountRequest{
+		CustomerId: customerId,
+		AccountId:  rand.Uint64(),
+		Now:        now.UnixNano(),
+	})
+	require.Error(err)
+}
+
+func TestGetCustomerBalances(t *testing.T) {
+	require := require.New(t)
+
+	server := NewLedgerServiceApiServer(NewLedgerServiceCoreApiStandaloneStub(newAccountsCore(), newCustomersCore()), []string{"standalone"})
+
+	ctx := context.Background()
+
+	// create customer with two accounts
+	response1, err := server.CreateCustomer(ctx, &gatewaypb.CreateCustomerRequest{
+		Name: "John Doe",
+	})
+	require.NoError(err)
+
+	response2, err := server.CreateAccount(ctx, &gatewaypb.CreateAccountRequest{
+		CustomerId: response1.Customer.Id,
+	})
+	require.NoError(err)
+	require.Equal(response1.Customer.Id, response2.Account.CustomerId)
+
+	response3, err := server.CreateAccount(ctx, &gatewaypb.CreateAccountRequest{
+		CustomerId: response1.Customer.Id,
+	})
+	require.NoError(err)
+
+	// account of a non-existent customer is not created
+	_, err = server.CreateAccount(ctx, &gatewaypb.CreateAccountRequest{
+		CustomerId: EncodeCustomerId(rand.Uint64()),
+	})
+	require.Error(err)
+
+	// topup both accounts, the second one with a pending topup
+	_, err = server.CreateTransaction(ctx, &gatewaypb.CreateTransactionRequest{
+		AccountId: response2.Account.Id,
+		Amount:    100,
+		Settled:   true,
+	})
+	require.NoError(err)
+
+	_, err = server.CreateTransaction(ctx, &gatewaypb.CreateTransactionRequest{
+		AccountId: response3.Account.Id,
+		Amount:    50,
+		Settled:   true,
+	})
+	require.NoError(err)
+
+	_, err = server.CreateTransaction(ctx, &gatewaypb.CreateTransactionRequest{
+		AccountId: response3.Account.Id,
+		Amount:    -20,
+		Settled:   false,
+	})
+	require.NoError(err)
+
+	// list accounts
+	response4, err := server.ListCustomerAccounts(ctx, &gatewaypb.ListCustomerAccountsRequest{
+		CustomerId: response1.Customer.Id,
+	})
+	require.NoError(err)
+	require.Equal([]string{response2.Account.Id, response3.Account.Id}, response4.AccountIds)
+
+	// balances are summed over accounts
+	response5, err := server.GetCustomerBalances(ctx, &gatewaypb.GetCustomerBalancesRequest{
+		CustomerId: response1.Customer.Id,
+	})
+	require.NoError(err)
+	require.Len(response5.Accounts, 2)
+	require.Equal(response2.Account.Id, response5.Accounts[0].Id)
+	require.EqualValues(130, response5.TotalAvailableBalance)
+	require.EqualValues(150, response5.TotalSettledBalance)
+	require.EqualValues(0, response5.TotalPendingAvailabilityBalance)
+}
+
+func TestRemoveCustomerAccount(t *testing.T) {
+	require := require.New(t)
+
+	customersCore := newCustomersCore()
+
+	now := time.Now()
+
+	customerId := rand.Uint64()
+
+	_, err := customersCore.CreateCustomer(&corepb.CreateCustomerRequest{
+		CustomerId: customerId,
+		Now:        now.UnixNano(),
+	})
+	require.NoError(err)
+
+	// fill the customer up to the limit
+	accountIds := make([]uint64, maxAccountsPerCustomer)
+	for i := range accountIds {
+		accountIds[i] = rand.Uint64()
+		_, err = customersCore.AddCustomerAccount(&corepb.AddCustomerAccountRequest{
+			CustomerId: customerId,
+			AccountId:  accountIds[i],
+			Now:        now.UnixNano(),
+		})
+		require.NoError(err)
+	}
+
+	// removing an account frees a place, removing it twice is a no-op
+	for i := 0; i < 2; i++ {
+		response1, err := customersCore.RemoveCustomerAccount(&corepb.RemoveCustomerAccountRequest{
+			CustomerId: customerId,
+			AccountId:  accountIds[0],
+			Now:        now.UnixNano(),
+		})
+		require.NoError(err)
+		require.Equal(accountIds[1:], response1.Customer.AccountIds)
+	}
+
+	_, err = customersCore.AddCustomerAccount(&corepb.AddCustomerAccountRequest{
+		CustomerId: customerId,
+		AccountId:  rand.Uint64(),
+		Now:        now.UnixNano(),
+	})
+	require.NoError(err)
+}
+
+func TestGetCustomerBalancesSkipsAccountsNotCreatedYet(t *testing.T) {
+	require := require.New(t)
+
+	customersCore := newCustomersCore()
+	server := NewLedgerServiceApiServer(NewLedgerServiceCoreApiStandaloneStub(newAccountsCore(), customersCore), []string{"standalone"})
+
+	ctx := context.Background()
+
+	response1, err := server.CreateCustomer(ctx, &gatewaypb.CreateCustomerRequest{
+		Name: "John Doe",
+	})
+	require.NoError(err)
+
+	response2, err := server.CreateAccount(ctx, &gatewaypb.CreateAccountRequest{
+		CustomerId: response1.Customer.Id,
+	})
+	require.NoError(err)
+
+	// an account id is added to the customer, but the account is not created yet
+	customerId, err := DecodeCustomerId(response1.Customer.Id)
+	require.NoError(err)
+	_, err = customersCore.AddCustomerAccount(&corepb.AddCustomerAccountRequest{
+		CustomerId: customerId,
+		AccountId:  rand.Uint64(),
+		Now:        time.Now().UnixNano(),
+	})
+	require.NoError(err)
+
+	response3, err := server.GetCustomerBalances(ctx, &gatewaypb.GetCustomerBalancesRequest{
+		CustomerId: response1.Customer.Id,
+	})
+	require.NoError(err)
+	require.Len(response3.Accounts, 1)
+	require.Equal(response2.Account.Id, response3.Accounts[0].Id)
+}
+
+func newCustomersCore() *CustomersCore {
+	return NewCustomersCore(monstera.NewBadgerInMemoryStore(), []byte{0x00, 0x00}, []byte{0xff, 0xff})
+}
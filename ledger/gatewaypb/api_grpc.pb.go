@@ -19,13 +19,31 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LedgerServiceApi_GetAccount_FullMethodName        = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/GetAccount"
-	LedgerServiceApi_CreateAccount_FullMethodName     = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/CreateAccount"
-	LedgerServiceApi_GetTransaction_FullMethodName    = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/GetTransaction"
-	LedgerServiceApi_CreateTransaction_FullMethodName = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/CreateTransaction"
-	LedgerServiceApi_SettleTransaction_FullMethodName = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/SettleTransaction"
-	LedgerServiceApi_CancelTransaction_FullMethodName = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/CancelTransaction"
-	LedgerServiceApi_ListTransactions_FullMethodName  = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/ListTransactions"
+	LedgerServiceApi_GetAccount_FullMethodName           = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/GetAccount"
+	LedgerServiceApi_CreateAccount_FullMethodName        = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/CreateAccount"
+	LedgerServiceApi_CreateCustomer_FullMethodName       = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/CreateCustomer"
+	LedgerServiceApi_ListCustomerAccounts_FullMethodName = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/ListCustomerAccounts"
+	LedgerServiceApi_GetCustomerBalances_FullMethodName  = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/GetCustomerBalances"
+	LedgerServiceApi_GetTransaction_FullMethodName       = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/GetTransaction"
+	LedgerServiceApi_CreateTransaction_FullMethodName    = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/CreateTransaction"
+	LedgerServiceApi_SettleTransaction_FullMethodName    = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/SettleTransaction"
+	LedgerServiceApi_CancelTransaction_FullMethodName    = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/CancelTransaction"
+	LedgerServiceApi_ListTransactions_FullMethodName     = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/ListTransactions"
+	LedgerServiceApi_GetSpendingSummary_FullMethodName   = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/GetSpendingSummary"
+	LedgerServiceApi_GetDispute_FullMethodName           = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/GetDispute"
+	LedgerServiceApi_OpenDispute_FullMethodName          = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/OpenDispute"
+	LedgerServiceApi_ResolveDispute_FullMethodName       = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/ResolveDispute"
+	LedgerServiceApi_ListDisputes_FullMethodName         = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/ListDisputes"
+	LedgerServiceApi_HoldEscrow_FullMethodName           = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/HoldEscrow"
+	LedgerServiceApi_ReleaseEscrow_FullMethodName        = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/ReleaseEscrow"
+	LedgerServiceApi_GetEscrow_FullMethodName            = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/GetEscrow"
+	LedgerServiceApi_ListEscrows_FullMethodName          = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/ListEscrows"
+	LedgerServiceApi_CreateAlertRule_FullMethodName      = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/CreateAlertRule"
+	LedgerServiceApi_DeleteAlertRule_FullMethodName      = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/DeleteAlertRule"
+	LedgerServiceApi_ListAlertRules_FullMethodName       = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/ListAlertRules"
+	LedgerServiceApi_ListTriggeredAlerts_FullMethodName  = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/ListTriggeredAlerts"
+	LedgerServiceApi_AckAlert_FullMethodName             = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/AckAlert"
+	LedgerServiceApi_GetLedgerStats_FullMethodName       = "/com.evrblk.monstera_example.ledger.gatewaypb.LedgerServiceApi/GetLedgerStats"
 )
 
 // LedgerServiceApiClient is the client API for LedgerServiceApi service.
@@ -34,11 +52,30 @@ const (
 type LedgerServiceApiClient interface {
 	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error)
 	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error)
+	CreateCustomer(ctx context.Context, in *CreateCustomerRequest, opts ...grpc.CallOption) (*CreateCustomerResponse, error)
+	ListCustomerAccounts(ctx context.Context, in *ListCustomerAccountsRequest, opts ...grpc.CallOption) (*ListCustomerAccountsResponse, error)
+	GetCustomerBalances(ctx context.Context, in *GetCustomerBalancesRequest, opts ...grpc.CallOption) (*GetCustomerBalancesResponse, error)
 	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error)
 	CreateTransaction(ctx context.Context, in *CreateTransactionRequest, opts ...grpc.CallOption) (*CreateTransactionResponse, error)
 	SettleTransaction(ctx context.Context, in *SettleTransactionRequest, opts ...grpc.CallOption) (*SettleTransactionResponse, error)
 	CancelTransaction(ctx context.Context, in *CancelTransactionRequest, opts ...grpc.CallOption) (*CancelTransactionResponse, error)
 	ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error)
+	GetSpendingSummary(ctx context.Context, in *GetSpendingSummaryRequest, opts ...grpc.CallOption) (*GetSpendingSummaryResponse, error)
+	GetDispute(ctx context.Context, in *GetDisputeRequest, opts ...grpc.CallOption) (*GetDisputeResponse, error)
+	OpenDispute(ctx context.Context, in *OpenDisputeRequest, opts ...grpc.CallOption) (*OpenDisputeResponse, error)
+	ResolveDispute(ctx context.Context, in *ResolveDisputeRequest, opts ...grpc.CallOption) (*ResolveDisputeResponse, error)
+	ListDisputes(ctx context.Context, in *ListDisputesRequest, opts ...grpc.CallOption) (*ListDisputesResponse, error)
+	HoldEscrow(ctx context.Context, in *HoldEscrowRequest, opts ...grpc.CallOption) (*HoldEscrowResponse, error)
+	ReleaseEscrow(ctx context.Context, in *ReleaseEscrowRequest, opts ...grpc.CallOption) (*ReleaseEscrowResponse, error)
+	GetEscrow(ctx context.Context, in *GetEscrowRequest, opts ...grpc.CallOption) (*GetEscrowResponse, error)
+	ListEscrows(ctx context.Context, in *ListEscrowsRequest, opts ...grpc.CallOption) (*ListEscrowsResponse, error)
+	CreateAlertRule(ctx context.Context, in *CreateAlertRuleRequest, opts ...grpc.CallOption) (*CreateAlertRuleResponse, error)
+	DeleteAlertRule(ctx context.Context, in *DeleteAlertRuleRequest, opts ...grpc.CallOption) (*DeleteAlertRuleResponse, error)
+	ListAlertRules(ctx context.Context, in *ListAlertRulesRequest, opts ...grpc.CallOption) (*ListAlertRulesResponse, error)
+	ListTriggeredAlerts(ctx context.Context, in *ListTriggeredAlertsRequest, opts ...grpc.CallOption) (*ListTriggeredAlertsResponse, error)
+	AckAlert(ctx context.Context, in *AckAlertRequest, opts ...grpc.CallOption) (*AckAlertResponse, error)
+	// Admin
+	GetLedgerStats(ctx context.Context, in *GetLedgerStatsRequest, opts ...grpc.CallOption) (*GetLedgerStatsResponse, error)
 }
 
 type ledgerServiceApiClient struct {
@@ -69,6 +106,36 @@ func (c *ledgerServiceApiClient) CreateAccount(ctx context.Context, in *CreateAc
 	return out, nil
 }
 
+func (c *ledgerServiceApiClient) CreateCustomer(ctx context.Context, in *CreateCustomerRequest, opts ...grpc.CallOption) (*CreateCustomerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCustomerResponse)
+	err := c.cc.Invoke(ctx, LedgerServiceApi_CreateCustomer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceApiClient) ListCustomerAccounts(ctx context.Context, in *ListCustomerAccountsRequest, opts ...grpc.CallOption) (*ListCustomerAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCustomerAccountsResponse)
+	err := c.cc.Invoke(ctx, LedgerServiceApi_ListCustomerAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceApiClient) GetCustomerBalances(ctx context.Context, in *GetCustomerBalancesRequest, opts ...grpc.CallOption) (*GetCustomerBalancesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCustomerBalancesResponse)
+	err := c.cc.Invoke(ctx, LedgerServiceApi_GetCustomerBalances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ledgerServiceApiClient) GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetTransactionResponse)
@@ -119,17 +186,186 @@ func (c *ledgerServiceApiClient) ListTransactions(ctx context.Context, in *ListT
 	return out, nil
 }
 
+func (c *ledgerServiceApiClient) GetSpendingSummary(ctx context.Context, in *GetSpendingSummaryRequest, opts ...grpc.CallOption) (*GetSpendingSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSpendingSummaryResponse)
+	err := c.cc.Invoke(ctx, LedgerServiceApi_GetSpendingSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceApiClient) GetDispute(ctx context.Context, in *GetDisputeRequest, opts ...grpc.CallOption) (*GetDisputeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDisputeResponse)
+	err := c.cc.Invoke(ctx, LedgerServiceApi_GetDispute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceApiClient) OpenDispute(ctx context.Context, in *OpenDisputeRequest, opts ...grpc.CallOption) (*OpenDisputeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OpenDisputeResponse)
+	err := c.cc.Invoke(ctx, LedgerServiceApi_OpenDispute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceApiClient) ResolveDispute(ctx context.Context, in *ResolveDisputeRequest, opts ...grpc.CallOption) (*ResolveDisputeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveDisputeResponse)
+	err := c.cc.Invoke(ctx, LedgerServiceApi_ResolveDispute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceApiClient) ListDisputes(ctx context.Context, in *ListDisputesRequest, opts ...grpc.CallOption) (*ListDisputesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDisputesResponse)
+	err := c.cc.Invoke(ctx, LedgerServiceApi_ListDisputes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceApiClient) HoldEscrow(ctx context.Context, in *HoldEscrowRequest, opts ...grpc.CallOption) (*HoldEscrowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HoldEscrowResponse)
+	err := c.cc.Invoke(ctx, LedgerServiceApi_HoldEscrow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceApiClient) ReleaseEscrow(ctx context.Context, in *ReleaseEscrowRequest, opts ...grpc.CallOption) (*ReleaseEscrowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseEscrowResponse)
+	err := c.cc.Invoke(ctx, LedgerServiceApi_ReleaseEscrow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceApiClient) GetEscrow(ctx context.Context, in *GetEscrowRequest, opts ...grpc.CallOption) (*GetEscrowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEscrowResponse)
+	err := c.cc.Invoke(ctx, LedgerServiceApi_GetEscrow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceApiClient) ListEscrows(ctx context.Context, in *ListEscrowsRequest, opts ...grpc.CallOption) (*ListEscrowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEscrowsResponse)
+	err := c.cc.Invoke(ctx, LedgerServiceApi_ListEscrows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceApiClient) CreateAlertRule(ctx context.Context, in *CreateAlertRuleRequest, opts ...grpc.CallOption) (*CreateAlertRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAlertRuleResponse)
+	err := c.cc.Invoke(ctx, LedgerServiceApi_CreateAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceApiClient) DeleteAlertRule(ctx context.Context, in *DeleteAlertRuleRequest, opts ...grpc.CallOption) (*DeleteAlertRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAlertRuleResponse)
+	err := c.cc.Invoke(ctx, LedgerServiceApi_DeleteAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceApiClient) ListAlertRules(ctx context.Context, in *ListAlertRulesRequest, opts ...grpc.CallOption) (*ListAlertRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlertRulesResponse)
+	err := c.cc.Invoke(ctx, LedgerServiceApi_ListAlertRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceApiClient) ListTriggeredAlerts(ctx context.Context, in *ListTriggeredAlertsRequest, opts ...grpc.CallOption) (*ListTriggeredAlertsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTriggeredAlertsResponse)
+	err := c.cc.Invoke(ctx, LedgerServiceApi_ListTriggeredAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceApiClient) AckAlert(ctx context.Context, in *AckAlertRequest, opts ...grpc.CallOption) (*AckAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AckAlertResponse)
+	err := c.cc.Invoke(ctx, LedgerServiceApi_AckAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceApiClient) GetLedgerStats(ctx context.Context, in *GetLedgerStatsRequest, opts ...grpc.CallOption) (*GetLedgerStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLedgerStatsResponse)
+	err := c.cc.Invoke(ctx, LedgerServiceApi_GetLedgerStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LedgerServiceApiServer is the server API for LedgerServiceApi service.
 // All implementations must embed UnimplementedLedgerServiceApiServer
 // for forward compatibility.
 type LedgerServiceApiServer interface {
 	GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error)
 	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
+	CreateCustomer(context.Context, *CreateCustomerRequest) (*CreateCustomerResponse, error)
+	ListCustomerAccounts(context.Context, *ListCustomerAccountsRequest) (*ListCustomerAccountsResponse, error)
+	GetCustomerBalances(context.Context, *GetCustomerBalancesRequest) (*GetCustomerBalancesResponse, error)
 	GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error)
 	CreateTransaction(context.Context, *CreateTransactionRequest) (*CreateTransactionResponse, error)
 	SettleTransaction(context.Context, *SettleTransactionRequest) (*SettleTransactionResponse, error)
 	CancelTransaction(context.Context, *CancelTransactionRequest) (*CancelTransactionResponse, error)
 	ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error)
+	GetSpendingSummary(context.Context, *GetSpendingSummaryRequest) (*GetSpendingSummaryResponse, error)
+	GetDispute(context.Context, *GetDisputeRequest) (*GetDisputeResponse, error)
+	OpenDispute(context.Context, *OpenDisputeRequest) (*OpenDisputeResponse, error)
+	ResolveDispute(context.Context, *ResolveDisputeRequest) (*ResolveDisputeResponse, error)
+	ListDisputes(context.Context, *ListDisputesRequest) (*ListDisputesResponse, error)
+	HoldEscrow(context.Context, *HoldEscrowRequest) (*HoldEscrowResponse, error)
+	ReleaseEscrow(context.Context, *ReleaseEscrowRequest) (*ReleaseEscrowResponse, error)
+	GetEscrow(context.Context, *GetEscrowRequest) (*GetEscrowResponse, error)
+	ListEscrows(context.Context, *ListEscrowsRequest) (*ListEscrowsResponse, error)
+	CreateAlertRule(context.Context, *CreateAlertRuleRequest) (*CreateAlertRuleResponse, error)
+	DeleteAlertRule(context.Context, *DeleteAlertRuleRequest) (*DeleteAlertRuleResponse, error)
+	ListAlertRules(context.Context, *ListAlertRulesRequest) (*ListAlertRulesResponse, error)
+	ListTriggeredAlerts(context.Context, *ListTriggeredAlertsRequest) (*ListTriggeredAlertsResponse, error)
+	AckAlert(context.Context, *AckAlertRequest) (*AckAlertResponse, error)
+	// Admin
+	GetLedgerStats(context.Context, *GetLedgerStatsRequest) (*GetLedgerStatsResponse, error)
 	mustEmbedUnimplementedLedgerServiceApiServer()
 }
 
@@ -146,6 +382,15 @@ func (UnimplementedLedgerServiceApiServer) GetAccount(context.Context, *GetAccou
 func (UnimplementedLedgerServiceApiServer) CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
 }
+func (UnimplementedLedgerServiceApiServer) CreateCustomer(context.Context, *CreateCustomerRequest) (*CreateCustomerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCustomer not implemented")
+}
+func (UnimplementedLedgerServiceApiServer) ListCustomerAccounts(context.Context, *ListCustomerAccountsRequest) (*ListCustomerAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCustomerAccounts not implemented")
+}
+func (UnimplementedLedgerServiceApiServer) GetCustomerBalances(context.Context, *GetCustomerBalancesRequest) (*GetCustomerBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCustomerBalances not implemented")
+}
 func (UnimplementedLedgerServiceApiServer) GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
 }
@@ -161,6 +406,51 @@ func (UnimplementedLedgerServiceApiServer) CancelTransaction(context.Context, *C
 func (UnimplementedLedgerServiceApiServer) ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTransactions not implemented")
 }
+func (UnimplementedLedgerServiceApiServer) GetSpendingSummary(context.Context, *GetSpendingSummaryRequest) (*GetSpendingSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpendingSummary not implemented")
+}
+func (UnimplementedLedgerServiceApiServer) GetDispute(context.Context, *GetDisputeRequest) (*GetDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDispute not implemented")
+}
+func (UnimplementedLedgerServiceApiServer) OpenDispute(context.Context, *OpenDisputeRequest) (*OpenDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenDispute not implemented")
+}
+func (UnimplementedLedgerServiceApiServer) ResolveDispute(context.Context, *ResolveDisputeRequest) (*ResolveDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveDispute not implemented")
+}
+func (UnimplementedLedgerServiceApiServer) ListDisputes(context.Context, *ListDisputesRequest) (*ListDisputesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDisputes not implemented")
+}
+func (UnimplementedLedgerServiceApiServer) HoldEscrow(context.Context, *HoldEscrowRequest) (*HoldEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HoldEscrow not implemented")
+}
+func (UnimplementedLedgerServiceApiServer) ReleaseEscrow(context.Context, *ReleaseEscrowRequest) (*ReleaseEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseEscrow not implemented")
+}
+func (UnimplementedLedgerServiceApiServer) GetEscrow(context.Context, *GetEscrowRequest) (*GetEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEscrow not implemented")
+}
+func (UnimplementedLedgerServiceApiServer) ListEscrows(context.Context, *ListEscrowsRequest) (*ListEscrowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEscrows not implemented")
+}
+func (UnimplementedLedgerServiceApiServer) CreateAlertRule(context.Context, *CreateAlertRuleRequest) (*CreateAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAlertRule not implemented")
+}
+func (UnimplementedLedgerServiceApiServer) DeleteAlertRule(context.Context, *DeleteAlertRuleRequest) (*DeleteAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlertRule not implemented")
+}
+func (UnimplementedLedgerServiceApiServer) ListAlertRules(context.Context, *ListAlertRulesRequest) (*ListAlertRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlertRules not implemented")
+}
+func (UnimplementedLedgerServiceApiServer) ListTriggeredAlerts(context.Context, *ListTriggeredAlertsRequest) (*ListTriggeredAlertsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTriggeredAlerts not implemented")
+}
+func (UnimplementedLedgerServiceApiServer) AckAlert(context.Context, *AckAlertRequest) (*AckAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AckAlert not implemented")
+}
+func (UnimplementedLedgerServiceApiServer) GetLedgerStats(context.Context, *GetLedgerStatsRequest) (*GetLedgerStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLedgerStats not implemented")
+}
 func (UnimplementedLedgerServiceApiServer) mustEmbedUnimplementedLedgerServiceApiServer() {}
 func (UnimplementedLedgerServiceApiServer) testEmbeddedByValue()                          {}
 
@@ -218,6 +508,60 @@ func _LedgerServiceApi_CreateAccount_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LedgerServiceApi_CreateCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceApiServer).CreateCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerServiceApi_CreateCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceApiServer).CreateCustomer(ctx, req.(*CreateCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerServiceApi_ListCustomerAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCustomerAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceApiServer).ListCustomerAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerServiceApi_ListCustomerAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceApiServer).ListCustomerAccounts(ctx, req.(*ListCustomerAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerServiceApi_GetCustomerBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCustomerBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceApiServer).GetCustomerBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerServiceApi_GetCustomerBalances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceApiServer).GetCustomerBalances(ctx, req.(*GetCustomerBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LedgerServiceApi_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTransactionRequest)
 	if err := dec(in); err != nil {
@@ -308,6 +652,276 @@ func _LedgerServiceApi_ListTransactions_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LedgerServiceApi_GetSpendingSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSpendingSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceApiServer).GetSpendingSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerServiceApi_GetSpendingSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceApiServer).GetSpendingSummary(ctx, req.(*GetSpendingSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerServiceApi_GetDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceApiServer).GetDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerServiceApi_GetDispute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceApiServer).GetDispute(ctx, req.(*GetDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerServiceApi_OpenDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceApiServer).OpenDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerServiceApi_OpenDispute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceApiServer).OpenDispute(ctx, req.(*OpenDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerServiceApi_ResolveDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceApiServer).ResolveDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerServiceApi_ResolveDispute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceApiServer).ResolveDispute(ctx, req.(*ResolveDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerServiceApi_ListDisputes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDisputesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceApiServer).ListDisputes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerServiceApi_ListDisputes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceApiServer).ListDisputes(ctx, req.(*ListDisputesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerServiceApi_HoldEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HoldEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceApiServer).HoldEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerServiceApi_HoldEscrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceApiServer).HoldEscrow(ctx, req.(*HoldEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerServiceApi_ReleaseEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceApiServer).ReleaseEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerServiceApi_ReleaseEscrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceApiServer).ReleaseEscrow(ctx, req.(*ReleaseEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerServiceApi_GetEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceApiServer).GetEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerServiceApi_GetEscrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceApiServer).GetEscrow(ctx, req.(*GetEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerServiceApi_ListEscrows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEscrowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceApiServer).ListEscrows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerServiceApi_ListEscrows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceApiServer).ListEscrows(ctx, req.(*ListEscrowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerServiceApi_CreateAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceApiServer).CreateAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerServiceApi_CreateAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceApiServer).CreateAlertRule(ctx, req.(*CreateAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerServiceApi_DeleteAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceApiServer).DeleteAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerServiceApi_DeleteAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceApiServer).DeleteAlertRule(ctx, req.(*DeleteAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerServiceApi_ListAlertRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceApiServer).ListAlertRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerServiceApi_ListAlertRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceApiServer).ListAlertRules(ctx, req.(*ListAlertRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerServiceApi_ListTriggeredAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTriggeredAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceApiServer).ListTriggeredAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerServiceApi_ListTriggeredAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceApiServer).ListTriggeredAlerts(ctx, req.(*ListTriggeredAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerServiceApi_AckAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceApiServer).AckAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerServiceApi_AckAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceApiServer).AckAlert(ctx, req.(*AckAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerServiceApi_GetLedgerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLedgerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceApiServer).GetLedgerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerServiceApi_GetLedgerStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceApiServer).GetLedgerStats(ctx, req.(*GetLedgerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LedgerServiceApi_ServiceDesc is the grpc.ServiceDesc for LedgerServiceApi service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -323,6 +937,18 @@ var LedgerServiceApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateAccount",
 			Handler:    _LedgerServiceApi_CreateAccount_Handler,
 		},
+		{
+			MethodName: "CreateCustomer",
+			Handler:    _LedgerServiceApi_CreateCustomer_Handler,
+		},
+		{
+			MethodName: "ListCustomerAccounts",
+			Handler:    _LedgerServiceApi_ListCustomerAccounts_Handler,
+		},
+		{
+			MethodName: "GetCustomerBalances",
+			Handler:    _LedgerServiceApi_GetCustomerBalances_Handler,
+		},
 		{
 			MethodName: "GetTransaction",
 			Handler:    _LedgerServiceApi_GetTransaction_Handler,
@@ -343,6 +969,66 @@ var LedgerServiceApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTransactions",
 			Handler:    _LedgerServiceApi_ListTransactions_Handler,
 		},
+		{
+			MethodName: "GetSpendingSummary",
+			Handler:    _LedgerServiceApi_GetSpendingSummary_Handler,
+		},
+		{
+			MethodName: "GetDispute",
+			Handler:    _LedgerServiceApi_GetDispute_Handler,
+		},
+		{
+			MethodName: "OpenDispute",
+			Handler:    _LedgerServiceApi_OpenDispute_Handler,
+		},
+		{
+			MethodName: "ResolveDispute",
+			Handler:    _LedgerServiceApi_ResolveDispute_Handler,
+		},
+		{
+			MethodName: "ListDisputes",
+			Handler:    _LedgerServiceApi_ListDisputes_Handler,
+		},
+		{
+			MethodName: "HoldEscrow",
+			Handler:    _LedgerServiceApi_HoldEscrow_Handler,
+		},
+		{
+			MethodName: "ReleaseEscrow",
+			Handler:    _LedgerServiceApi_ReleaseEscrow_Handler,
+		},
+		{
+			MethodName: "GetEscrow",
+			Handler:    _LedgerServiceApi_GetEscrow_Handler,
+		},
+		{
+			MethodName: "ListEscrows",
+			Handler:    _LedgerServiceApi_ListEscrows_Handler,
+		},
+		{
+			MethodName: "CreateAlertRule",
+			Handler:    _LedgerServiceApi_CreateAlertRule_Handler,
+		},
+		{
+			MethodName: "DeleteAlertRule",
+			Handler:    _LedgerServiceApi_DeleteAlertRule_Handler,
+		},
+		{
+			MethodName: "ListAlertRules",
+			Handler:    _LedgerServiceApi_ListAlertRules_Handler,
+		},
+		{
+			MethodName: "ListTriggeredAlerts",
+			Handler:    _LedgerServiceApi_ListTriggeredAlerts_Handler,
+		},
+		{
+			MethodName: "AckAlert",
+			Handler:    _LedgerServiceApi_AckAlert_Handler,
+		},
+		{
+			MethodName: "GetLedgerStats",
+			Handler:    _LedgerServiceApi_GetLedgerStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gatewaypb/api.proto",
@@ -14,6 +14,11 @@ var (
 
 	accountIdRegex     = regexp.MustCompile("[0-9a-f]{16}")
 	transactionIdRegex = regexp.MustCompile("[0-9a-f]{32}")
+	disputeIdRegex     = regexp.MustCompile("[0-9a-f]{32}")
+	alertRuleIdRegex   = regexp.MustCompile("[0-9a-f]{32}")
+	alertIdRegex       = regexp.MustCompile("[0-9a-f]{32}")
+	customerIdRegex    = regexp.MustCompile("[0-9a-f]{16}")
+	escrowIdRegex      = regexp.MustCompile("[0-9a-f]{32}")
 )
 
 func DecodeAccountId(s string) (uint64, error) {
@@ -62,3 +67,134 @@ func EncodeTransactionId(id *corepb.TransactionId) string {
 	binary.BigEndian.PutUint64(src[8:], id.TransactionId)
 	return fmt.Sprintf("%x", src)
 }
+
+func DecodeDisputeId(s string) (*corepb.DisputeId, error) {
+	if !disputeIdRegex.MatchString(s) {
+		return nil, ErrInvalidId
+	}
+
+	accountId, err := strconv.ParseUint(s[0:16], 16, 64)
+	if err != nil {
+		return nil, ErrInvalidId
+	}
+
+	disputeId, err := strconv.ParseUint(s[16:32], 16, 64)
+	if err != nil {
+		return nil, ErrInvalidId
+	}
+
+	return &corepb.DisputeId{
+		AccountId: accountId,
+		DisputeId: disputeId,
+	}, nil
+}
+
+func EncodeDisputeId(id *corepb.DisputeId) string {
+	src := make([]byte, 8+8)
+	binary.BigEndian.PutUint64(src[0:], id.AccountId)
+	binary.BigEndian.PutUint64(src[8:], id.DisputeId)
+	return fmt.Sprintf("%x", src)
+}
+
+func DecodeAlertRuleId(s string) (*corepb.AlertRuleId, error) {
+	if !alertRuleIdRegex.MatchString(s) {
+		return nil, ErrInvalidId
+	}
+
+	accountId, err := strconv.ParseUint(s[0:16], 16, 64)
+	if err != nil {
+		return nil, ErrInvalidId
+	}
+
+	alertRuleId, err := strconv.ParseUint(s[16:32], 16, 64)
+	if err != nil {
+		return nil, ErrInvalidId
+	}
+
+	return &corepb.AlertRuleId{
+		AccountId:   accountId,
+		AlertRuleId: alertRuleId,
+	}, nil
+}
+
+func EncodeAlertRuleId(id *corepb.AlertRuleId) string {
+	src := make([]byte, 8+8)
+	binary.BigEndian.PutUint64(src[0:], id.AccountId)
+	binary.BigEndian.PutUint64(src[8:], id.AlertRuleId)
+	return fmt.Sprintf("%x", src)
+}
+
+func DecodeTriggeredAlertId(s string) (*corepb.TriggeredAlertId, error) {
+	if !alertIdRegex.MatchString(s) {
+		return nil, ErrInvalidId
+	}
+
+	accountId, err := strconv.ParseUint(s[0:16], 16, 64)
+	if err != nil {
+		return nil, ErrInvalidId
+	}
+
+	alertId, err := strconv.ParseUint(s[16:32], 16, 64)
+	if err != nil {
+		return nil, ErrInvalidId
+	}
+
+	return &corepb.TriggeredAlertId{
+		AccountId:        accountId,
+		TriggeredAlertId: alertId,
+	}, nil
+}
+
+func EncodeTriggeredAlertId(id *corepb.TriggeredAlertId) string {
+	src := make([]byte, 8+8)
+	binary.BigEndian.PutUint64(src[0:], id.AccountId)
+	binary.BigEndian.PutUint64(src[8:], id.TriggeredAlertId)
+	return fmt.Sprintf("%x", src)
+}
+
+func DecodeCustomerId(s string) (uint64, error) {
+	if !customerIdRegex.MatchString(s) {
+		return 0, ErrInvalidId
+	}
+
+	id, err := strconv.ParseUint(s, 16, 64)
+	if err != nil {
+		return 0, ErrInvalidId
+	}
+
+	return id, nil
+}
+
+func EncodeCustomerId(id uint64) string {
+	src := make([]byte, 8)
+	binary.BigEndian.PutUint64(src[0:], id)
+	return fmt.Sprintf("%x", src)
+}
+
+func DecodeEscrowId(s string) (*corepb.EscrowId, error) {
+	if !escrowIdRegex.MatchString(s) {
+		return nil, ErrInvalidId
+	}
+
+	accountId, err := strconv.ParseUint(s[0:16], 16, 64)
+	if err != nil {
+		return nil, ErrInvalidId
+	}
+
+	escrowId, err := strconv.ParseUint(s[16:32], 16, 64)
+	if err != nil {
+		return nil, ErrInvalidId
+	}
+
+	return &corepb.EscrowId{
+		AccountId: accountId,
+		EscrowId:  escrowId,
+	}, nil
+}
+
+func EncodeEscrowId(id *corepb.EscrowId) string {
+	src := make([]byte, 8+8)
+	binary.BigEndian.PutUint64(src[0:], id.AccountId)
+	binary.BigEndian.PutUint64(src[8:], id.EscrowId)
+	return fmt.Sprintf("%x", src)
+}
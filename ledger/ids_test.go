@@ -54,3 +54,75 @@ func TestTransactionIdDecode(t *testing.T) {
 	_, err = DecodeTransactionId("206af3de774e2dc751d11665f8af8d41")
 	require.NoError(err)
 }
+
+func TestDisputeIdEncodeDecode(t *testing.T) {
+	require := require.New(t)
+
+	for i := 0; i < 1000; i++ {
+		id := &corepb.DisputeId{
+			AccountId: rand.Uint64(),
+			DisputeId: rand.Uint64(),
+		}
+
+		actual, err := DecodeDisputeId(EncodeDisputeId(id))
+		require.NoError(err)
+		require.Equal(id, actual)
+	}
+}
+
+func TestAlertRuleIdEncodeDecode(t *testing.T) {
+	require := require.New(t)
+
+	for i := 0; i < 1000; i++ {
+		id := &corepb.AlertRuleId{
+			AccountId:   rand.Uint64(),
+			AlertRuleId: rand.Uint64(),
+		}
+
+		actual, err := DecodeAlertRuleId(EncodeAlertRuleId(id))
+		require.NoError(err)
+		require.Equal(id, actual)
+	}
+}
+
+func TestTriggeredAlertIdEncodeDecode(t *testing.T) {
+	require := require.New(t)
+
+	for i := 0; i < 1000; i++ {
+		id := &corepb.TriggeredAlertId{
+			AccountId:        rand.Uint64(),
+			TriggeredAlertId: rand.Uint64(),
+		}
+
+		actual, err := DecodeTriggeredAlertId(EncodeTriggeredAlertId(id))
+		require.NoError(err)
+		require.Equal(id, actual)
+	}
+}
+
+func TestCustomerIdEncodeDecode(t *testing.T) {
+	require := require.New(t)
+
+	for i := 0; i < 1000; i++ {
+		id := rand.Uint64()
+
+		actual, err := DecodeCustomerId(EncodeCustomerId(id))
+		require.NoError(err)
+		require.Equal(id, actual)
+	}
+}
+
+func TestEscrowIdEncodeDecode(t *testing.T) {
+	require := require.New(t)
+
+	for i := 0; i < 1000; i++ {
+		id := &corepb.EscrowId{
+			AccountId: rand.Uint64(),
+			EscrowId:  rand.Uint64(),
+		}
+
+		actual, err := DecodeEscrowId(EncodeEscrowId(id))
+		require.NoError(err)
+		require.Equal(id, actual)
+	}
+}
@@ -1,6 +1,8 @@
 package ledger
 
 import (
+	"fmt"
+
 	"github.com/evrblk/monstera-example/ledger/corepb"
 	"github.com/evrblk/monstera-example/ledger/gatewaypb"
 )
@@ -11,12 +13,54 @@ func accountToFront(account *corepb.Account) *gatewaypb.Account {
 	}
 
 	return &gatewaypb.Account{
-		Id:               EncodeAccountId(account.Id),
-		AvailableBalance: account.AvailableBalance,
-		SettledBalance:   account.SettledBalance,
-		CreatedAt:        account.CreatedAt,
-		UpdatedAt:        account.UpdatedAt,
+		Id:                         EncodeAccountId(account.Id),
+		AvailableBalance:           account.AvailableBalance,
+		SettledBalance:             account.SettledBalance,
+		CreatedAt:                  account.CreatedAt,
+		UpdatedAt:                  account.UpdatedAt,
+		PendingAvailabilityBalance: account.PendingAvailabilityBalance,
+		EscrowBalance:              account.EscrowBalance,
+		CustomerId:                 customerIdToFront(account.CustomerId),
+	}
+}
+
+func accountsToFront(accounts []*corepb.Account) []*gatewaypb.Account {
+	frontAccounts := make([]*gatewaypb.Account, len(accounts))
+	for i, account := range accounts {
+		frontAccounts[i] = accountToFront(account)
+	}
+	return frontAccounts
+}
+
+func customerToFront(customer *corepb.Customer) *gatewaypb.Customer {
+	if customer == nil {
+		return nil
+	}
+
+	return &gatewaypb.Customer{
+		Id:         EncodeCustomerId(customer.Id),
+		Name:       customer.Name,
+		Email:      customer.Email,
+		AccountIds: accountIdsToFront(customer.AccountIds),
+		CreatedAt:  customer.CreatedAt,
+		UpdatedAt:  customer.UpdatedAt,
+	}
+}
+
+func accountIdsToFront(accountIds []uint64) []string {
+	frontAccountIds := make([]string, len(accountIds))
+	for i, accountId := range accountIds {
+		frontAccountIds[i] = EncodeAccountId(accountId)
 	}
+	return frontAccountIds
+}
+
+// customerIdToFront encodes an optional customer id, 0 is encoded as an empty string
+func customerIdToFront(id uint64) string {
+	if id == 0 {
+		return ""
+	}
+	return EncodeCustomerId(id)
 }
 
 func transactionToFront(transaction *corepb.Transaction) *gatewaypb.Transaction {
@@ -31,9 +75,20 @@ func transactionToFront(transaction *corepb.Transaction) *gatewaypb.Transaction
 		Status:      transactionStatusToFront(transaction.Status),
 		CreatedAt:   transaction.CreatedAt,
 		UpdatedAt:   transaction.UpdatedAt,
+		DisputeId:   disputeIdToFront(transaction.DisputeId),
+		AvailableAt: transaction.AvailableAt,
+		Category:    transaction.Category,
 	}
 }
 
+func transactionsToFront(transactions []*corepb.Transaction) []*gatewaypb.Transaction {
+	frontTransactions := make([]*gatewaypb.Transaction, len(transactions))
+	for i, transaction := range transactions {
+		frontTransactions[i] = transactionToFront(transaction)
+	}
+	return frontTransactions
+}
+
 func transactionStatusToFront(status corepb.TransactionStatus) gatewaypb.TransactionStatus {
 	switch status {
 	case corepb.TransactionStatus_TRANSACTION_STATUS_SETTLED:
@@ -48,3 +103,262 @@ func transactionStatusToFront(status corepb.TransactionStatus) gatewaypb.Transac
 		return gatewaypb.TransactionStatus_TRANSACTION_STATUS_INVALID
 	}
 }
+
+func spendingAggregateToFront(aggregate *corepb.SpendingAggregate) *gatewaypb.SpendingSummary {
+	if aggregate == nil {
+		return nil
+	}
+
+	return &gatewaypb.SpendingSummary{
+		Month:         aggregate.Month,
+		Category:      aggregate.Category,
+		SettledAmount: aggregate.SettledAmount,
+		SettledCount:  aggregate.SettledCount,
+		PendingAmount: aggregate.PendingAmount,
+		PendingCount:  aggregate.PendingCount,
+	}
+}
+
+func spendingAggregatesToFront(aggregates []*corepb.SpendingAggregate) []*gatewaypb.SpendingSummary {
+	frontSummaries := make([]*gatewaypb.SpendingSummary, len(aggregates))
+	for i, aggregate := range aggregates {
+		frontSummaries[i] = spendingAggregateToFront(aggregate)
+	}
+	return frontSummaries
+}
+
+func disputeToFront(dispute *corepb.Dispute) *gatewaypb.Dispute {
+	if dispute == nil {
+		return nil
+	}
+
+	return &gatewaypb.Dispute{
+		Id:                       EncodeDisputeId(dispute.Id),
+		TransactionId:            EncodeTransactionId(dispute.TransactionId),
+		Amount:                   dispute.Amount,
+		Description:              dispute.Description,
+		Status:                   disputeStatusToFront(dispute.Status),
+		ProvisionalTransactionId: transactionIdToFront(dispute.ProvisionalTransactionId),
+		FinalTransactionId:       transactionIdToFront(dispute.FinalTransactionId),
+		CreatedAt:                dispute.CreatedAt,
+		UpdatedAt:                dispute.UpdatedAt,
+	}
+}
+
+func disputesToFront(disputes []*corepb.Dispute) []*gatewaypb.Dispute {
+	frontDisputes := make([]*gatewaypb.Dispute, len(disputes))
+	for i, dispute := range disputes {
+		frontDisputes[i] = disputeToFront(dispute)
+	}
+	return frontDisputes
+}
+
+func disputeStatusToFront(status corepb.DisputeStatus) gatewaypb.DisputeStatus {
+	switch status {
+	case corepb.DisputeStatus_DISPUTE_STATUS_OPENED:
+		return gatewaypb.DisputeStatus_DISPUTE_STATUS_OPENED
+	case corepb.DisputeStatus_DISPUTE_STATUS_WON:
+		return gatewaypb.DisputeStatus_DISPUTE_STATUS_WON
+	case corepb.DisputeStatus_DISPUTE_STATUS_LOST:
+		return gatewaypb.DisputeStatus_DISPUTE_STATUS_LOST
+	default:
+		return gatewaypb.DisputeStatus_DISPUTE_STATUS_INVALID
+	}
+}
+
+func escrowToFront(escrow *corepb.Escrow) *gatewaypb.Escrow {
+	if escrow == nil {
+		return nil
+	}
+
+	// release transaction id is reserved when the escrow is held, but the transaction exists only if something
+	// was released
+	var releaseTransactionId string
+	if escrow.ReleasedAmount > 0 {
+		releaseTransactionId = transactionIdToFront(escrow.ReleaseTransactionId)
+	}
+
+	return &gatewaypb.Escrow{
+		Id:                   EncodeEscrowId(escrow.Id),
+		Name:                 escrow.Name,
+		Amount:               escrow.Amount,
+		Status:               escrowStatusToFront(escrow.Status),
+		ReleasedAmount:       escrow.ReleasedAmount,
+		RefundedAmount:       escrow.RefundedAmount,
+		AutoReleaseAt:        escrow.AutoReleaseAt,
+		ReleaseTransactionId: releaseTransactionId,
+		AutoReleased:         escrow.AutoReleased,
+		CreatedAt:            escrow.CreatedAt,
+		UpdatedAt:            escrow.UpdatedAt,
+	}
+}
+
+func escrowsToFront(escrows []*corepb.Escrow) []*gatewaypb.Escrow {
+	frontEscrows := make([]*gatewaypb.Escrow, len(escrows))
+	for i, escrow := range escrows {
+		frontEscrows[i] = escrowToFront(escrow)
+	}
+	return frontEscrows
+}
+
+func escrowStatusToFront(status corepb.EscrowStatus) gatewaypb.EscrowStatus {
+	switch status {
+	case corepb.EscrowStatus_ESCROW_STATUS_HELD:
+		return gatewaypb.EscrowStatus_ESCROW_STATUS_HELD
+	case corepb.EscrowStatus_ESCROW_STATUS_RELEASED:
+		return gatewaypb.EscrowStatus_ESCROW_STATUS_RELEASED
+	case corepb.EscrowStatus_ESCROW_STATUS_REFUNDED:
+		return gatewaypb.EscrowStatus_ESCROW_STATUS_REFUNDED
+	case corepb.EscrowStatus_ESCROW_STATUS_SPLIT:
+		return gatewaypb.EscrowStatus_ESCROW_STATUS_SPLIT
+	default:
+		return gatewaypb.EscrowStatus_ESCROW_STATUS_INVALID
+	}
+}
+
+// transactionIdToFront encodes an optional transaction id, nil is encoded as an empty string
+func transactionIdToFront(id *corepb.TransactionId) string {
+	if id == nil {
+		return ""
+	}
+	return EncodeTransactionId(id)
+}
+
+// disputeIdToFront encodes an optional dispute id, nil is encoded as an empty string
+func disputeIdToFront(id *corepb.DisputeId) string {
+	if id == nil {
+		return ""
+	}
+	return EncodeDisputeId(id)
+}
+
+func alertRuleToFront(alertRule *corepb.AlertRule) *gatewaypb.AlertRule {
+	if alertRule == nil {
+		return nil
+	}
+
+	return &gatewaypb.AlertRule{
+		Id:        EncodeAlertRuleId(alertRule.Id),
+		Threshold: alertRule.Threshold,
+		Direction: alertDirectionToFront(alertRule.Direction),
+		CreatedAt: alertRule.CreatedAt,
+	}
+}
+
+func alertRulesToFront(alertRules []*corepb.AlertRule) []*gatewaypb.AlertRule {
+	frontAlertRules := make([]*gatewaypb.AlertRule, len(alertRules))
+	for i, alertRule := range alertRules {
+		frontAlertRules[i] = alertRuleToFront(alertRule)
+	}
+	return frontAlertRules
+}
+
+func triggeredAlertToFront(alert *corepb.TriggeredAlert) *gatewaypb.TriggeredAlert {
+	if alert == nil {
+		return nil
+	}
+
+	return &gatewaypb.TriggeredAlert{
+		Id:               EncodeTriggeredAlertId(alert.Id),
+		AlertRuleId:      EncodeAlertRuleId(alert.AlertRuleId),
+		Threshold:        alert.Threshold,
+		Direction:        alertDirectionToFront(alert.Direction),
+		AvailableBalance: alert.AvailableBalance,
+		TransactionId:    transactionIdToFront(alert.TransactionId),
+		TriggeredAt:      alert.TriggeredAt,
+		Acknowledged:     alert.Acknowledged,
+		AcknowledgedAt:   alert.AcknowledgedAt,
+	}
+}
+
+func triggeredAlertsToFront(alerts []*corepb.TriggeredAlert) []*gatewaypb.TriggeredAlert {
+	frontAlerts := make([]*gatewaypb.TriggeredAlert, len(alerts))
+	for i, alert := range alerts {
+		frontAlerts[i] = triggeredAlertToFront(alert)
+	}
+	return frontAlerts
+}
+
+func alertDirectionToFront(direction corepb.AlertDirection) gatewaypb.AlertDirection {
+	switch direction {
+	case corepb.AlertDirection_ALERT_DIRECTION_BELOW:
+		return gatewaypb.AlertDirection_ALERT_DIRECTION_BELOW
+	case corepb.AlertDirection_ALERT_DIRECTION_ABOVE:
+		return gatewaypb.AlertDirection_ALERT_DIRECTION_ABOVE
+	default:
+		return gatewaypb.AlertDirection_ALERT_DIRECTION_INVALID
+	}
+}
+
+func alertDirectionToCore(direction gatewaypb.AlertDirection) corepb.AlertDirection {
+	switch direction {
+	case gatewaypb.AlertDirection_ALERT_DIRECTION_BELOW:
+		return corepb.AlertDirection_ALERT_DIRECTION_BELOW
+	case gatewaypb.AlertDirection_ALERT_DIRECTION_ABOVE:
+		return corepb.AlertDirection_ALERT_DIRECTION_ABOVE
+	default:
+		return corepb.AlertDirection_ALERT_DIRECTION_INVALID
+	}
+}
+
+// alertRuleIdToFront encodes an optional alert rule id, nil is encoded as an empty string
+func alertRuleIdToFront(id *corepb.AlertRuleId) string {
+	if id == nil {
+		return ""
+	}
+	return EncodeAlertRuleId(id)
+}
+
+// outboxRecordToWebhookEvent converts an outbox record of a shard to a webhook payload. Record ids are unique
+// only within a shard, so event ids are prefixed with shard id.
+func outboxRecordToWebhookEvent(shardId string, record *corepb.OutboxRecord) *gatewaypb.WebhookEvent {
+	return &gatewaypb.WebhookEvent{
+		Id:             fmt.Sprintf("%s-%d", shardId, record.Id),
+		Type:           outboxEventTypeToFront(record.Type),
+		CreatedAt:      record.CreatedAt,
+		Account:        accountToFront(record.Account),
+		Transaction:    transactionToFront(record.Transaction),
+		Dispute:        disputeToFront(record.Dispute),
+		AlertRule:      alertRuleToFront(record.AlertRule),
+		AlertRuleId:    alertRuleIdToFront(record.AlertRuleId),
+		TriggeredAlert: triggeredAlertToFront(record.TriggeredAlert),
+		Escrow:         escrowToFront(record.Escrow),
+
+		ArchivedTransactions: transactionsToFront(record.ArchivedTransactions),
+	}
+}
+
+func outboxEventTypeToFront(eventType corepb.OutboxEventType) string {
+	switch eventType {
+	case corepb.OutboxEventType_OUTBOX_EVENT_TYPE_ACCOUNT_CREATED:
+		return "account.created"
+	case corepb.OutboxEventType_OUTBOX_EVENT_TYPE_TRANSACTION_CREATED:
+		return "transaction.created"
+	case corepb.OutboxEventType_OUTBOX_EVENT_TYPE_TRANSACTION_SETTLED:
+		return "transaction.settled"
+	case corepb.OutboxEventType_OUTBOX_EVENT_TYPE_TRANSACTION_CANCELLED:
+		return "transaction.cancelled"
+	case corepb.OutboxEventType_OUTBOX_EVENT_TYPE_DISPUTE_OPENED:
+		return "dispute.opened"
+	case corepb.OutboxEventType_OUTBOX_EVENT_TYPE_DISPUTE_RESOLVED:
+		return "dispute.resolved"
+	case corepb.OutboxEventType_OUTBOX_EVENT_TYPE_FUNDS_RELEASED:
+		return "funds.released"
+	case corepb.OutboxEventType_OUTBOX_EVENT_TYPE_ALERT_RULE_CREATED:
+		return "alert_rule.created"
+	case corepb.OutboxEventType_OUTBOX_EVENT_TYPE_ALERT_RULE_DELETED:
+		return "alert_rule.deleted"
+	case corepb.OutboxEventType_OUTBOX_EVENT_TYPE_ALERT_TRIGGERED:
+		return "alert.triggered"
+	case corepb.OutboxEventType_OUTBOX_EVENT_TYPE_ALERT_ACKNOWLEDGED:
+		return "alert.acknowledged"
+	case corepb.OutboxEventType_OUTBOX_EVENT_TYPE_ESCROW_HELD:
+		return "escrow.held"
+	case corepb.OutboxEventType_OUTBOX_EVENT_TYPE_ESCROW_RELEASED:
+		return "escrow.released"
+	case corepb.OutboxEventType_OUTBOX_EVENT_TYPE_TRANSACTIONS_ARCHIVED:
+		return "transactions.archived"
+	default:
+		return "invalid"
+	}
+}
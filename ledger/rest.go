@@ -0,0 +1,336 @@
+package ledger
+
+import (
+	_ "embed"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/evrblk/monstera-example/ledger/gatewaypb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const maxRestBodySize = 1 << 20
+
+//go:embed openapi.json
+var openApiDocument []byte
+
+// NewRestHandler exposes LedgerServiceApi as HTTP/JSON resources described by openapi.json. Requests are served by
+// the same server as gRPC, so validation and errors are the same: gRPC status codes are mapped to HTTP status codes.
+// Request and response bodies are protobuf JSON of gateway messages with original (snake_case) field names, ids
+// from the path override ids in the body.
+func NewRestHandler(server gatewaypb.LedgerServiceApiServer) http.Handler {
+	mux := http.NewServeMux()
+
+	route := func(pattern string, successStatus int, fn func(r *http.Request) (proto.Message, error)) {
+		mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+			response, err := fn(r)
+			if err != nil {
+				writeRestError(w, err)
+				return
+			}
+			writeRestResponse(w, successStatus, response)
+		})
+	}
+
+	mux.HandleFunc("GET /openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(openApiDocument)
+	})
+
+	// Accounts
+	route("POST /accounts", http.StatusCreated, func(r *http.Request) (proto.Message, error) {
+		request := &gatewaypb.CreateAccountRequest{}
+		if err := decodeRestBody(r, request); err != nil {
+			return nil, err
+		}
+		return server.CreateAccount(r.Context(), request)
+	})
+	route("GET /accounts/{account_id}", http.StatusOK, func(r *http.Request) (proto.Message, error) {
+		followerRead, err := parseFollowerRead(r)
+		if err != nil {
+			return nil, err
+		}
+		return server.GetAccount(r.Context(), &gatewaypb.GetAccountRequest{
+			AccountId:    r.PathValue("account_id"),
+			FollowerRead: followerRead,
+		})
+	})
+	route("GET /accounts/{account_id}/spending", http.StatusOK, func(r *http.Request) (proto.Message, error) {
+		return server.GetSpendingSummary(r.Context(), &gatewaypb.GetSpendingSummaryRequest{
+			AccountId: r.PathValue("account_id"),
+			FromMonth: r.URL.Query().Get("from_month"),
+			ToMonth:   r.URL.Query().Get("to_month"),
+		})
+	})
+
+	// Customers
+	route("POST /customers", http.StatusCreated, func(r *http.Request) (proto.Message, error) {
+		request := &gatewaypb.CreateCustomerRequest{}
+		if err := decodeRestBody(r, request); err != nil {
+			return nil, err
+		}
+		return server.CreateCustomer(r.Context(), request)
+	})
+	route("GET /customers/{customer_id}/accounts", http.StatusOK, func(r *http.Request) (proto.Message, error) {
+		return server.ListCustomerAccounts(r.Context(), &gatewaypb.ListCustomerAccountsRequest{
+			CustomerId: r.PathValue("customer_id"),
+		})
+	})
+	route("GET /customers/{customer_id}/balances", http.StatusOK, func(r *http.Request) (proto.Message, error) {
+		return server.GetCustomerBalances(r.Context(), &gatewaypb.GetCustomerBalancesRequest{
+			CustomerId: r.PathValue("customer_id"),
+		})
+	})
+
+	// Transactions
+	route("GET /accounts/{account_id}/transactions", http.StatusOK, func(r *http.Request) (proto.Message, error) {
+		followerRead, err := parseFollowerRead(r)
+		if err != nil {
+			return nil, err
+		}
+		return server.ListTransactions(r.Context(), &gatewaypb.ListTransactionsRequest{
+			AccountId:    r.PathValue("account_id"),
+			FollowerRead: followerRead,
+		})
+	})
+	route("POST /accounts/{account_id}/transactions", http.StatusCreated, func(r *http.Request) (proto.Message, error) {
+		request := &gatewaypb.CreateTransactionRequest{}
+		if err := decodeRestBody(r, request); err != nil {
+			return nil, err
+		}
+		request.AccountId = r.PathValue("account_id")
+		return server.CreateTransaction(r.Context(), request)
+	})
+	route("GET /transactions/{transaction_id}", http.StatusOK, func(r *http.Request) (proto.Message, error) {
+		return server.GetTransaction(r.Context(), &gatewaypb.GetTransactionRequest{
+			TransactionId: r.PathValue("transaction_id"),
+		})
+	})
+	route("POST /transactions/{transaction_id}/settle", http.StatusOK, func(r *http.Request) (proto.Message, error) {
+		return server.SettleTransaction(r.Context(), &gatewaypb.SettleTransactionRequest{
+			TransactionId: r.PathValue("transaction_id"),
+		})
+	})
+	route("POST /transactions/{transaction_id}/cancel", http.StatusOK, func(r *http.Request) (proto.Message, error) {
+		return server.CancelTransaction(r.Context(), &gatewaypb.CancelTransactionRequest{
+			TransactionId: r.PathValue("transaction_id"),
+		})
+	})
+
+	// Disputes
+	route("GET /accounts/{account_id}/disputes", http.StatusOK, func(r *http.Request) (proto.Message, error) {
+		return server.ListDisputes(r.Context(), &gatewaypb.ListDisputesRequest{
+			AccountId: r.PathValue("account_id"),
+		})
+	})
+	route("POST /transactions/{transaction_id}/disputes", http.StatusCreated, func(r *http.Request) (proto.Message, error) {
+		request := &gatewaypb.OpenDisputeRequest{}
+		if err := decodeRestBody(r, request); err != nil {
+			return nil, err
+		}
+		request.TransactionId = r.PathValue("transaction_id")
+		return server.OpenDispute(r.Context(), request)
+	})
+	route("GET /disputes/{dispute_id}", http.StatusOK, func(r *http.Request) (proto.Message, error) {
+		return server.GetDispute(r.Context(), &gatewaypb.GetDisputeRequest{
+			DisputeId: r.PathValue("dispute_id"),
+		})
+	})
+	route("POST /disputes/{dispute_id}/resolve", http.StatusOK, func(r *http.Request) (proto.Message, error) {
+		request := &gatewaypb.ResolveDisputeRequest{}
+		if err := decodeRestBody(r, request); err != nil {
+			return nil, err
+		}
+		request.DisputeId = r.PathValue("dispute_id")
+		return server.ResolveDispute(r.Context(), request)
+	})
+
+	// Escrows
+	route("GET /accounts/{account_id}/escrows", http.StatusOK, func(r *http.Request) (proto.Message, error) {
+		return server.ListEscrows(r.Context(), &gatewaypb.ListEscrowsRequest{
+			AccountId: r.PathValue("account_id"),
+		})
+	})
+	route("POST /accounts/{account_id}/escrows", http.StatusCreated, func(r *http.Request) (proto.Message, error) {
+		request := &gatewaypb.HoldEscrowRequest{}
+		if err := decodeRestBody(r, request); err != nil {
+			return nil, err
+		}
+		request.AccountId = r.PathValue("account_id")
+		return server.HoldEscrow(r.Context(), request)
+	})
+	route("GET /escrows/{escrow_id}", http.StatusOK, func(r *http.Request) (proto.Message, error) {
+		return server.GetEscrow(r.Context(), &gatewaypb.GetEscrowRequest{
+			EscrowId: r.PathValue("escrow_id"),
+		})
+	})
+	route("POST /escrows/{escrow_id}/release", http.StatusOK, func(r *http.Request) (proto.Message, error) {
+		request := &gatewaypb.ReleaseEscrowRequest{}
+		if err := decodeRestBody(r, request); err != nil {
+			return nil, err
+		}
+		request.EscrowId = r.PathValue("escrow_id")
+		return server.ReleaseEscrow(r.Context(), request)
+	})
+
+	// Alerts
+	route("GET /accounts/{account_id}/alert-rules", http.StatusOK, func(r *http.Request) (proto.Message, error) {
+		return server.ListAlertRules(r.Context(), &gatewaypb.ListAlertRulesRequest{
+			AccountId: r.PathValue("account_id"),
+		})
+	})
+	route("POST /accounts/{account_id}/alert-rules", http.StatusCreated, func(r *http.Request) (proto.Message, error) {
+		request := &gatewaypb.CreateAlertRuleRequest{}
+		if err := decodeRestBody(r, request); err != nil {
+			return nil, err
+		}
+		request.AccountId = r.PathValue("account_id")
+		return server.CreateAlertRule(r.Context(), request)
+	})
+	route("DELETE /alert-rules/{alert_rule_id}", http.StatusOK, func(r *http.Request) (proto.Message, error) {
+		return server.DeleteAlertRule(r.Context(), &gatewaypb.DeleteAlertRuleRequest{
+			AlertRuleId: r.PathValue("alert_rule_id"),
+		})
+	})
+	route("GET /accounts/{account_id}/alerts", http.StatusOK, func(r *http.Request) (proto.Message, error) {
+		unacknowledgedOnly := false
+		if value := r.URL.Query().Get("unacknowledged_only"); value != "" {
+			var err error
+			unacknowledgedOnly, err = strconv.ParseBool(value)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid unacknowledged_only")
+			}
+		}
+		return server.ListTriggeredAlerts(r.Context(), &gatewaypb.ListTriggeredAlertsRequest{
+			AccountId:          r.PathValue("account_id"),
+			UnacknowledgedOnly: unacknowledgedOnly,
+		})
+	})
+	route("POST /alerts/{triggered_alert_id}/ack", http.StatusOK, func(r *http.Request) (proto.Message, error) {
+		return server.AckAlert(r.Context(), &gatewaypb.AckAlertRequest{
+			TriggeredAlertId: r.PathValue("triggered_alert_id"),
+		})
+	})
+
+	// Admin
+	route("GET /stats", http.StatusOK, func(r *http.Request) (proto.Message, error) {
+		return server.GetLedgerStats(r.Context(), &gatewaypb.GetLedgerStatsRequest{})
+	})
+
+	return mux
+}
+
+// parseFollowerRead reads max_staleness_ms and min_updated_at query parameters, a read is served by the leader
+// (nil is returned) if both are absent
+func parseFollowerRead(r *http.Request) (*gatewaypb.FollowerRead, error) {
+	query := r.URL.Query()
+	if !query.Has("max_staleness_ms") && !query.Has("min_updated_at") {
+		return nil, nil
+	}
+
+	followerRead := &gatewaypb.FollowerRead{}
+	for name, value := range map[string]*int64{
+		"max_staleness_ms": &followerRead.MaxStalenessMs,
+		"min_updated_at":   &followerRead.MinUpdatedAt,
+	} {
+		if !query.Has(name) {
+			continue
+		}
+		n, err := strconv.ParseInt(query.Get(name), 10, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid %s", name)
+		}
+		*value = n
+	}
+
+	return followerRead, nil
+}
+
+// decodeRestBody reads a JSON request body into a gateway message, an empty body leaves the message empty
+func decodeRestBody(r *http.Request, message proto.Message) error {
+	body, err := io.ReadAll(http.MaxBytesReader(nil, r.Body, maxRestBodySize))
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "could not read request body: %v", err)
+	}
+
+	if len(body) == 0 {
+		return nil
+	}
+
+	err = protojson.Unmarshal(body, message)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid request body: %v", err)
+	}
+
+	return nil
+}
+
+func writeRestResponse(w http.ResponseWriter, statusCode int, message proto.Message) {
+	body, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(message)
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	w.Write(body)
+}
+
+// restError is a body of error responses
+type restError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// writeRestError writes an error as {"code": "NotFound", "message": "..."} with HTTP status mapped from gRPC status
+// code. Errors without gRPC status are internal.
+func writeRestError(w http.ResponseWriter, err error) {
+	st, ok := status.FromError(err)
+	if !ok {
+		st = status.New(codes.Internal, err.Error())
+	}
+
+	body, _ := json.Marshal(restError{
+		Code:    st.Code().String(),
+		Message: st.Message(),
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(grpcCodeToHttpStatus(st.Code()))
+	w.Write(body)
+}
+
+func grpcCodeToHttpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Canceled:
+		return 499
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
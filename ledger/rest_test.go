@@ -0,0 +1,124 @@
+package ledger
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/evrblk/monstera"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestApi(t *testing.T) {
+	require := require.New(t)
+
+	server := httptest.NewServer(NewRestHandler(NewStandaloneApiServer(monstera.NewBadgerInMemoryStore())))
+	defer server.Close()
+
+	// create account
+	status, body := restCall(t, server, "POST", "/accounts", "")
+	require.Equal(http.StatusCreated, status)
+	accountId := body["account"].(map[string]any)["id"].(string)
+
+	// settled topup +100
+	status, _ = restCall(t, server, "POST", "/accounts/"+accountId+"/transactions", `{"amount": 100, "settled": true}`)
+	require.Equal(http.StatusCreated, status)
+
+	// pending purchase -30, account id in the body is overridden by the path
+	status, body = restCall(t, server, "POST", "/accounts/"+accountId+"/transactions", `{"account_id": "x", "amount": "-30"}`)
+	require.Equal(http.StatusCreated, status)
+	transaction := body["transaction"].(map[string]any)
+	require.Equal("TRANSACTION_STATUS_PENDING", transaction["status"])
+	transactionId := transaction["id"].(string)
+
+	// settle purchase
+	status, body = restCall(t, server, "POST", "/transactions/"+transactionId+"/settle", "")
+	require.Equal(http.StatusOK, status)
+	require.Equal("TRANSACTION_STATUS_SETTLED", body["transaction"].(map[string]any)["status"])
+
+	// list transactions
+	status, body = restCall(t, server, "GET", "/accounts/"+accountId+"/transactions", "")
+	require.Equal(http.StatusOK, status)
+	require.Len(body["transactions"], 2)
+
+	// get account, int64 fields are strings in protobuf JSON
+	status, body = restCall(t, server, "GET", "/accounts/"+accountId, "")
+	require.Equal(http.StatusOK, status)
+	require.Equal("70", body["account"].(map[string]any)["available_balance"])
+
+	// follower read, standalone server has a single replica which is always fresh
+	status, body = restCall(t, server, "GET", "/accounts/"+accountId+"?max_staleness_ms=60000", "")
+	require.Equal(http.StatusOK, status)
+	require.Equal("70", body["account"].(map[string]any)["available_balance"])
+
+	status, _ = restCall(t, server, "GET", "/accounts/"+accountId+"/transactions?max_staleness_ms=x", "")
+	require.Equal(http.StatusBadRequest, status)
+
+	// hold 50 in escrow and release it in full
+	status, body = restCall(t, server, "POST", "/accounts/"+accountId+"/escrows", `{"name": "order-1", "amount": 50}`)
+	require.Equal(http.StatusCreated, status)
+	escrowId := body["escrow"].(map[string]any)["id"].(string)
+
+	status, body = restCall(t, server, "POST", "/escrows/"+escrowId+"/release", `{"resolution": "ESCROW_RESOLUTION_RELEASE"}`)
+	require.Equal(http.StatusOK, status)
+	require.Equal("ESCROW_STATUS_RELEASED", body["escrow"].(map[string]any)["status"])
+
+	status, body = restCall(t, server, "GET", "/accounts/"+accountId, "")
+	require.Equal(http.StatusOK, status)
+	require.Equal("20", body["account"].(map[string]any)["available_balance"])
+	require.Equal("20", body["account"].(map[string]any)["settled_balance"])
+
+	status, _ = restCall(t, server, "POST", "/escrows/"+escrowId+"/release", `{"resolution": "ESCROW_RESOLUTION_REFUND"}`)
+	require.Equal(http.StatusBadRequest, status)
+
+	// errors are mapped to HTTP status codes
+	status, body = restCall(t, server, "GET", "/accounts/0000000000000001", "")
+	require.Equal(http.StatusNotFound, status)
+	require.Equal("NotFound", body["code"])
+
+	status, _ = restCall(t, server, "GET", "/accounts/invalid", "")
+	require.Equal(http.StatusBadRequest, status)
+
+	status, _ = restCall(t, server, "POST", "/accounts/"+accountId+"/transactions", `{"amount": `)
+	require.Equal(http.StatusBadRequest, status)
+
+	status, _ = restCall(t, server, "POST", "/transactions/"+transactionId+"/settle", "")
+	require.Equal(http.StatusNotFound, status)
+}
+
+func TestRestApiMatchesOpenApi(t *testing.T) {
+	require := require.New(t)
+
+	handler := NewRestHandler(NewStandaloneApiServer(monstera.NewBadgerInMemoryStore())).(*http.ServeMux)
+
+	document := struct {
+		Paths map[string]map[string]any `json:"paths"`
+	}{}
+	require.NoError(json.Unmarshal(openApiDocument, &document))
+	require.NotEmpty(document.Paths)
+
+	// every documented operation is routed
+	for path, operations := range document.Paths {
+		for method := range operations {
+			request := httptest.NewRequest(strings.ToUpper(method), path, nil)
+			_, pattern := handler.Handler(request)
+			require.Equal(strings.ToUpper(method)+" "+path, pattern)
+		}
+	}
+}
+
+func restCall(t *testing.T, server *httptest.Server, method string, path string, body string) (int, map[string]any) {
+	request, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+	require.NoError(t, err)
+
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	defer response.Body.Close()
+
+	result := make(map[string]any)
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&result))
+
+	return response.StatusCode, result
+}
@@ -2,10 +2,14 @@ package ledger
 
 import (
 	"context"
+	"errors"
 	"log"
 	"math/rand/v2"
+	"slices"
+	"sync"
 	"time"
 
+	"github.com/evrblk/monstera"
 	"github.com/evrblk/monstera-example/ledger/corepb"
 	"github.com/evrblk/monstera-example/ledger/gatewaypb"
 	monsterax "github.com/evrblk/monstera/x"
@@ -13,10 +17,13 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+const maxCategoryLength = 64
+
 type LedgerServiceApiServer struct {
 	gatewaypb.UnimplementedLedgerServiceApiServer
 
-	coreApiClient LedgerServiceCoreApi
+	coreApiClient    LedgerServiceCoreApi
+	accountsShardIds []string
 }
 
 func (s *LedgerServiceApiServer) Close() {
@@ -24,13 +31,49 @@ func (s *LedgerServiceApiServer) Close() {
 }
 
 func (s *LedgerServiceApiServer) CreateAccount(ctx context.Context, request *gatewaypb.CreateAccountRequest) (*gatewaypb.CreateAccountResponse, error) {
+	var customerId uint64
+	if request.CustomerId != "" {
+		var err error
+		customerId, err = DecodeCustomerId(request.CustomerId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid customer id")
+		}
+	}
+
 	now := time.Now()
+	accountId := rand.Uint64()
+
+	// The account id is added to the customer first. Customers core checks the customer and the max number of its
+	// accounts within the same update, so concurrent creates can not exceed the limit, and an account is never
+	// created without its customer.
+	if customerId != 0 {
+		_, err := s.coreApiClient.AddCustomerAccount(ctx, &corepb.AddCustomerAccountRequest{
+			CustomerId: customerId,
+			AccountId:  accountId,
+			Now:        now.UnixNano(),
+		})
+		if err != nil {
+			return nil, monsterax.ErrorToGRPC(err)
+		}
+	}
 
 	res1, err := s.coreApiClient.CreateAccount(ctx, &corepb.CreateAccountRequest{
-		AccountId: rand.Uint64(),
-		Now:       now.UnixNano(),
+		AccountId:  accountId,
+		Now:        now.UnixNano(),
+		CustomerId: customerId,
 	})
 	if err != nil {
+		if customerId != 0 {
+			// give the reserved place back to the customer
+			_, err2 := s.coreApiClient.RemoveCustomerAccount(ctx, &corepb.RemoveCustomerAccountRequest{
+				CustomerId: customerId,
+				AccountId:  accountId,
+				Now:        now.UnixNano(),
+			})
+			if err2 != nil {
+				log.Printf("could not remove account %s from customer %s: %v", EncodeAccountId(accountId), EncodeCustomerId(customerId), err2)
+			}
+		}
 		return nil, monsterax.ErrorToGRPC(err)
 	}
 
@@ -45,18 +88,173 @@ func (s *LedgerServiceApiServer) GetAccount(ctx context.Context, request *gatewa
 		return nil, status.Errorf(codes.InvalidArgument, "invalid account id")
 	}
 
-	resp1, err := s.coreApiClient.GetAccount(ctx, &corepb.GetAccountRequest{
+	if !isValidFollowerRead(request.FollowerRead) {
+		return nil, status.Errorf(codes.InvalidArgument, "follower read requires max staleness or min updated at")
+	}
+
+	now := time.Now()
+
+	if request.FollowerRead != nil {
+		resp1, err := s.coreApiClient.GetAccountFromFollower(ctx, &corepb.GetAccountFromFollowerRequest{
+			AccountId: accountId,
+			Now:       now.UnixNano(),
+		})
+		// any error (e.g. an account which is not replicated yet) falls back to the leader
+		if err == nil && isFreshEnough(request.FollowerRead, now, resp1.AppliedAt, resp1.Account.UpdatedAt) {
+			return &gatewaypb.GetAccountResponse{
+				Account: accountToFront(resp1.Account),
+			}, nil
+		}
+	}
+
+	resp2, err := s.coreApiClient.GetAccount(ctx, &corepb.GetAccountRequest{
 		AccountId: accountId,
+		Now:       now.UnixNano(),
 	})
 	if err != nil {
 		return nil, monsterax.ErrorToGRPC(err)
 	}
 
 	return &gatewaypb.GetAccountResponse{
-		Account: accountToFront(resp1.Account),
+		Account: accountToFront(resp2.Account),
+	}, nil
+}
+
+func (s *LedgerServiceApiServer) CreateCustomer(ctx context.Context, request *gatewaypb.CreateCustomerRequest) (*gatewaypb.CreateCustomerResponse, error) {
+	now := time.Now()
+
+	resp1, err := s.coreApiClient.CreateCustomer(ctx, &corepb.CreateCustomerRequest{
+		CustomerId: rand.Uint64(),
+		Name:       request.Name,
+		Email:      request.Email,
+		Now:        now.UnixNano(),
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+
+	return &gatewaypb.CreateCustomerResponse{
+		Customer: customerToFront(resp1.Customer),
 	}, nil
 }
 
+func (s *LedgerServiceApiServer) ListCustomerAccounts(ctx context.Context, request *gatewaypb.ListCustomerAccountsRequest) (*gatewaypb.ListCustomerAccountsResponse, error) {
+	customerId, err := DecodeCustomerId(request.CustomerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid customer id")
+	}
+
+	resp1, err := s.coreApiClient.GetCustomer(ctx, &corepb.GetCustomerRequest{
+		CustomerId: customerId,
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+
+	return &gatewaypb.ListCustomerAccountsResponse{
+		AccountIds: accountIdsToFront(resp1.Customer.AccountIds),
+	}, nil
+}
+
+func (s *LedgerServiceApiServer) GetCustomerBalances(ctx context.Context, request *gatewaypb.GetCustomerBalancesRequest) (*gatewaypb.GetCustomerBalancesResponse, error) {
+	customerId, err := DecodeCustomerId(request.CustomerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid customer id")
+	}
+
+	resp1, err := s.coreApiClient.GetCustomer(ctx, &corepb.GetCustomerRequest{
+		CustomerId: customerId,
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+
+	now := time.Now()
+
+	// accounts of a customer are on different shards, read them in parallel
+	accounts := make([]*corepb.Account, len(resp1.Customer.AccountIds))
+	errs := make([]error, len(resp1.Customer.AccountIds))
+	wg := sync.WaitGroup{}
+	for i, accountId := range resp1.Customer.AccountIds {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			resp2, err := s.coreApiClient.GetAccount(ctx, &corepb.GetAccountRequest{
+				AccountId: accountId,
+				Now:       now.UnixNano(),
+			})
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			accounts[i] = resp2.Account
+		}()
+	}
+	wg.Wait()
+
+	response := &gatewaypb.GetCustomerBalancesResponse{}
+	for i := range accounts {
+		// an account id is added to the customer before the account is created, skip accounts which are not
+		// created yet (or failed to be created)
+		if isNotFound(errs[i]) {
+			continue
+		}
+		if errs[i] != nil {
+			return nil, monsterax.ErrorToGRPC(errs[i])
+		}
+	}
+	accounts = slices.DeleteFunc(accounts, func(account *corepb.Account) bool {
+		return account == nil
+	})
+
+	for _, account := range accounts {
+
+		response.TotalAvailableBalance += account.AvailableBalance
+		response.TotalSettledBalance += account.SettledBalance
+		response.TotalPendingAvailabilityBalance += account.PendingAvailabilityBalance
+	}
+	response.Accounts = accountsToFront(accounts)
+
+	return response, nil
+}
+
+func (s *LedgerServiceApiServer) GetLedgerStats(ctx context.Context, request *gatewaypb.GetLedgerStatsRequest) (*gatewaypb.GetLedgerStatsResponse, error) {
+	// every shard of Accounts core keeps running aggregates of its accounts, read them in parallel and sum
+	stats := make([]*corepb.ShardStats, len(s.accountsShardIds))
+	errs := make([]error, len(s.accountsShardIds))
+	wg := sync.WaitGroup{}
+	for i, shardId := range s.accountsShardIds {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			resp1, err := s.coreApiClient.GetShardStats(ctx, &corepb.GetShardStatsRequest{}, shardId)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			stats[i] = resp1.Stats
+		}()
+	}
+	wg.Wait()
+
+	response := &gatewaypb.GetLedgerStatsResponse{}
+	for i, shardStats := range stats {
+		if errs[i] != nil {
+			return nil, monsterax.ErrorToGRPC(errs[i])
+		}
+
+		response.AccountsCount += shardStats.AccountsCount
+		response.TotalAvailableBalance += shardStats.TotalAvailableBalance
+		response.TotalSettledBalance += shardStats.TotalSettledBalance
+		response.TotalPendingAvailabilityBalance += shardStats.TotalPendingAvailabilityBalance
+		response.PendingTransactionsCount += shardStats.PendingTransactionsCount
+	}
+
+	return response, nil
+}
+
 func (s *LedgerServiceApiServer) GetTransaction(ctx context.Context, request *gatewaypb.GetTransactionRequest) (*gatewaypb.GetTransactionResponse, error) {
 	transactionId, err := DecodeTransactionId(request.TransactionId)
 	if err != nil {
@@ -102,6 +300,14 @@ func (s *LedgerServiceApiServer) CreateTransaction(ctx context.Context, request
 		return nil, status.Errorf(codes.InvalidArgument, "invalid account id")
 	}
 
+	if request.AvailableAt != 0 && request.Amount < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "value date is allowed for topups only")
+	}
+
+	if len(request.Category) > maxCategoryLength {
+		return nil, status.Errorf(codes.InvalidArgument, "category is too long")
+	}
+
 	now := time.Now()
 
 	resp1, err := s.coreApiClient.CreateTransaction(ctx, &corepb.CreateTransactionRequest{
@@ -113,6 +319,8 @@ func (s *LedgerServiceApiServer) CreateTransaction(ctx context.Context, request
 		Description: request.Description,
 		Settled:     request.Settled,
 		Now:         now.UnixNano(),
+		AvailableAt: request.AvailableAt,
+		Category:    request.Category,
 	})
 	if err != nil {
 		return nil, monsterax.ErrorToGRPC(err)
@@ -144,8 +352,429 @@ func (s *LedgerServiceApiServer) SettleTransaction(ctx context.Context, request
 	}, nil
 }
 
-func NewLedgerServiceApiServer(coreApiClient LedgerServiceCoreApi) *LedgerServiceApiServer {
+func (s *LedgerServiceApiServer) ListTransactions(ctx context.Context, request *gatewaypb.ListTransactionsRequest) (*gatewaypb.ListTransactionsResponse, error) {
+	accountId, err := DecodeAccountId(request.AccountId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account id")
+	}
+
+	if !isValidFollowerRead(request.FollowerRead) {
+		return nil, status.Errorf(codes.InvalidArgument, "follower read requires max staleness or min updated at")
+	}
+
+	if request.FollowerRead != nil {
+		now := time.Now()
+		resp1, err := s.coreApiClient.ListTransactionsFromFollower(ctx, &corepb.ListTransactionsFromFollowerRequest{
+			AccountId: accountId,
+			Now:       now.UnixNano(),
+		})
+		// any error falls back to the leader
+		if err == nil && isFreshEnough(request.FollowerRead, now, resp1.AppliedAt, resp1.AccountUpdatedAt) {
+			return &gatewaypb.ListTransactionsResponse{
+				Transactions: transactionsToFront(resp1.Transactions),
+			}, nil
+		}
+	}
+
+	resp2, err := s.coreApiClient.ListTransactions(ctx, &corepb.ListTransactionsRequest{
+		AccountId: accountId,
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+
+	return &gatewaypb.ListTransactionsResponse{
+		Transactions: transactionsToFront(resp2.Transactions),
+	}, nil
+}
+
+func (s *LedgerServiceApiServer) GetSpendingSummary(ctx context.Context, request *gatewaypb.GetSpendingSummaryRequest) (*gatewaypb.GetSpendingSummaryResponse, error) {
+	accountId, err := DecodeAccountId(request.AccountId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account id")
+	}
+
+	if !isValidMonth(request.FromMonth) || !isValidMonth(request.ToMonth) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid month, expected YYYY-MM")
+	}
+
+	resp1, err := s.coreApiClient.GetSpendingSummary(ctx, &corepb.GetSpendingSummaryRequest{
+		AccountId: accountId,
+		FromMonth: request.FromMonth,
+		ToMonth:   request.ToMonth,
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+
+	return &gatewaypb.GetSpendingSummaryResponse{
+		Summaries: spendingAggregatesToFront(resp1.Aggregates),
+	}, nil
+}
+
+func (s *LedgerServiceApiServer) GetDispute(ctx context.Context, request *gatewaypb.GetDisputeRequest) (*gatewaypb.GetDisputeResponse, error) {
+	disputeId, err := DecodeDisputeId(request.DisputeId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid dispute id")
+	}
+
+	resp1, err := s.coreApiClient.GetDispute(ctx, &corepb.GetDisputeRequest{
+		DisputeId: disputeId,
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+
+	return &gatewaypb.GetDisputeResponse{
+		Dispute: disputeToFront(resp1.Dispute),
+	}, nil
+}
+
+func (s *LedgerServiceApiServer) ListDisputes(ctx context.Context, request *gatewaypb.ListDisputesRequest) (*gatewaypb.ListDisputesResponse, error) {
+	accountId, err := DecodeAccountId(request.AccountId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account id")
+	}
+
+	resp1, err := s.coreApiClient.ListDisputes(ctx, &corepb.ListDisputesRequest{
+		AccountId: accountId,
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+
+	return &gatewaypb.ListDisputesResponse{
+		Disputes: disputesToFront(resp1.Disputes),
+	}, nil
+}
+
+func (s *LedgerServiceApiServer) OpenDispute(ctx context.Context, request *gatewaypb.OpenDisputeRequest) (*gatewaypb.OpenDisputeResponse, error) {
+	transactionId, err := DecodeTransactionId(request.TransactionId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid transaction id")
+	}
+
+	if request.Amount < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount")
+	}
+
+	now := time.Now()
+
+	resp1, err := s.coreApiClient.OpenDispute(ctx, &corepb.OpenDisputeRequest{
+		DisputeId: &corepb.DisputeId{
+			AccountId: transactionId.AccountId,
+			DisputeId: rand.Uint64(),
+		},
+		TransactionId:            transactionId,
+		Amount:                   request.Amount,
+		Description:              request.Description,
+		ProvisionalTransactionId: rand.Uint64(),
+		Now:                      now.UnixNano(),
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+
+	return &gatewaypb.OpenDisputeResponse{
+		Dispute: disputeToFront(resp1.Dispute),
+	}, nil
+}
+
+func (s *LedgerServiceApiServer) ResolveDispute(ctx context.Context, request *gatewaypb.ResolveDisputeRequest) (*gatewaypb.ResolveDisputeResponse, error) {
+	disputeId, err := DecodeDisputeId(request.DisputeId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid dispute id")
+	}
+
+	now := time.Now()
+
+	resp1, err := s.coreApiClient.ResolveDispute(ctx, &corepb.ResolveDisputeRequest{
+		DisputeId:          disputeId,
+		Won:                request.Won,
+		FinalTransactionId: rand.Uint64(),
+		Now:                now.UnixNano(),
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+
+	return &gatewaypb.ResolveDisputeResponse{
+		Dispute: disputeToFront(resp1.Dispute),
+	}, nil
+}
+
+func (s *LedgerServiceApiServer) HoldEscrow(ctx context.Context, request *gatewaypb.HoldEscrowRequest) (*gatewaypb.HoldEscrowResponse, error) {
+	accountId, err := DecodeAccountId(request.AccountId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account id")
+	}
+
+	if request.Amount <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount")
+	}
+
+	now := time.Now()
+
+	resp1, err := s.coreApiClient.HoldEscrow(ctx, &corepb.HoldEscrowRequest{
+		EscrowId: &corepb.EscrowId{
+			AccountId: accountId,
+			EscrowId:  rand.Uint64(),
+		},
+		Name:                 request.Name,
+		Amount:               request.Amount,
+		AutoReleaseAt:        request.AutoReleaseAt,
+		ReleaseTransactionId: rand.Uint64(),
+		Now:                  now.UnixNano(),
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+
+	return &gatewaypb.HoldEscrowResponse{
+		Escrow: escrowToFront(resp1.Escrow),
+	}, nil
+}
+
+func (s *LedgerServiceApiServer) ReleaseEscrow(ctx context.Context, request *gatewaypb.ReleaseEscrowRequest) (*gatewaypb.ReleaseEscrowResponse, error) {
+	escrowId, err := DecodeEscrowId(request.EscrowId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid escrow id")
+	}
+
+	now := time.Now()
+
+	// amounts are checked against the escrow by the core
+	var releasedAmount int64
+	switch request.Resolution {
+	case gatewaypb.EscrowResolution_ESCROW_RESOLUTION_RELEASE:
+		resp1, err := s.coreApiClient.GetEscrow(ctx, &corepb.GetEscrowRequest{
+			EscrowId: escrowId,
+		})
+		if err != nil {
+			return nil, monsterax.ErrorToGRPC(err)
+		}
+		releasedAmount = resp1.Escrow.Amount
+	case gatewaypb.EscrowResolution_ESCROW_RESOLUTION_REFUND:
+		releasedAmount = 0
+	case gatewaypb.EscrowResolution_ESCROW_RESOLUTION_SPLIT:
+		if request.ReleasedAmount <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid released amount")
+		}
+		releasedAmount = request.ReleasedAmount
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid resolution")
+	}
+
+	resp2, err := s.coreApiClient.ReleaseEscrow(ctx, &corepb.ReleaseEscrowRequest{
+		EscrowId:       escrowId,
+		ReleasedAmount: releasedAmount,
+		Now:            now.UnixNano(),
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+
+	return &gatewaypb.ReleaseEscrowResponse{
+		Escrow: escrowToFront(resp2.Escrow),
+	}, nil
+}
+
+func (s *LedgerServiceApiServer) GetEscrow(ctx context.Context, request *gatewaypb.GetEscrowRequest) (*gatewaypb.GetEscrowResponse, error) {
+	escrowId, err := DecodeEscrowId(request.EscrowId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid escrow id")
+	}
+
+	resp1, err := s.coreApiClient.GetEscrow(ctx, &corepb.GetEscrowRequest{
+		EscrowId: escrowId,
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+
+	return &gatewaypb.GetEscrowResponse{
+		Escrow: escrowToFront(resp1.Escrow),
+	}, nil
+}
+
+func (s *LedgerServiceApiServer) ListEscrows(ctx context.Context, request *gatewaypb.ListEscrowsRequest) (*gatewaypb.ListEscrowsResponse, error) {
+	accountId, err := DecodeAccountId(request.AccountId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account id")
+	}
+
+	resp1, err := s.coreApiClient.ListEscrows(ctx, &corepb.ListEscrowsRequest{
+		AccountId: accountId,
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+
+	return &gatewaypb.ListEscrowsResponse{
+		Escrows: escrowsToFront(resp1.Escrows),
+	}, nil
+}
+
+func (s *LedgerServiceApiServer) CreateAlertRule(ctx context.Context, request *gatewaypb.CreateAlertRuleRequest) (*gatewaypb.CreateAlertRuleResponse, error) {
+	accountId, err := DecodeAccountId(request.AccountId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account id")
+	}
+
+	direction := alertDirectionToCore(request.Direction)
+	if direction == corepb.AlertDirection_ALERT_DIRECTION_INVALID {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid alert direction")
+	}
+
+	now := time.Now()
+
+	resp1, err := s.coreApiClient.CreateAlertRule(ctx, &corepb.CreateAlertRuleRequest{
+		AlertRuleId: &corepb.AlertRuleId{
+			AccountId:   accountId,
+			AlertRuleId: rand.Uint64(),
+		},
+		Threshold: request.Threshold,
+		Direction: direction,
+		Now:       now.UnixNano(),
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+
+	return &gatewaypb.CreateAlertRuleResponse{
+		AlertRule: alertRuleToFront(resp1.AlertRule),
+	}, nil
+}
+
+func (s *LedgerServiceApiServer) DeleteAlertRule(ctx context.Context, request *gatewaypb.DeleteAlertRuleRequest) (*gatewaypb.DeleteAlertRuleResponse, error) {
+	alertRuleId, err := DecodeAlertRuleId(request.AlertRuleId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid alert rule id")
+	}
+
+	now := time.Now()
+
+	_, err = s.coreApiClient.DeleteAlertRule(ctx, &corepb.DeleteAlertRuleRequest{
+		AlertRuleId: alertRuleId,
+		Now:         now.UnixNano(),
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+
+	return &gatewaypb.DeleteAlertRuleResponse{}, nil
+}
+
+func (s *LedgerServiceApiServer) ListAlertRules(ctx context.Context, request *gatewaypb.ListAlertRulesRequest) (*gatewaypb.ListAlertRulesResponse, error) {
+	accountId, err := DecodeAccountId(request.AccountId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account id")
+	}
+
+	resp1, err := s.coreApiClient.ListAlertRules(ctx, &corepb.ListAlertRulesRequest{
+		AccountId: accountId,
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+
+	return &gatewaypb.ListAlertRulesResponse{
+		AlertRules: alertRulesToFront(resp1.AlertRules),
+	}, nil
+}
+
+func (s *LedgerServiceApiServer) ListTriggeredAlerts(ctx context.Context, request *gatewaypb.ListTriggeredAlertsRequest) (*gatewaypb.ListTriggeredAlertsResponse, error) {
+	accountId, err := DecodeAccountId(request.AccountId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account id")
+	}
+
+	resp1, err := s.coreApiClient.ListTriggeredAlerts(ctx, &corepb.ListTriggeredAlertsRequest{
+		AccountId:          accountId,
+		UnacknowledgedOnly: request.UnacknowledgedOnly,
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+
+	return &gatewaypb.ListTriggeredAlertsResponse{
+		TriggeredAlerts: triggeredAlertsToFront(resp1.TriggeredAlerts),
+	}, nil
+}
+
+func (s *LedgerServiceApiServer) AckAlert(ctx context.Context, request *gatewaypb.AckAlertRequest) (*gatewaypb.AckAlertResponse, error) {
+	alertId, err := DecodeTriggeredAlertId(request.TriggeredAlertId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid triggered alert id")
+	}
+
+	now := time.Now()
+
+	resp1, err := s.coreApiClient.AckAlert(ctx, &corepb.AckAlertRequest{
+		TriggeredAlertId: alertId,
+		Now:              now.UnixNano(),
+	})
+	if err != nil {
+		return nil, monsterax.ErrorToGRPC(err)
+	}
+
+	return &gatewaypb.AckAlertResponse{
+		TriggeredAlert: triggeredAlertToFront(resp1.TriggeredAlert),
+	}, nil
+}
+
+// isValidMonth checks that a month is either empty (no bound) or in "YYYY-MM" format
+func isValidMonth(month string) bool {
+	if month == "" {
+		return true
+	}
+	_, err := time.Parse("2006-01", month)
+	return err == nil
+}
+
+func isValidFollowerRead(followerRead *gatewaypb.FollowerRead) bool {
+	if followerRead == nil {
+		return true
+	}
+	if followerRead.MaxStalenessMs < 0 || followerRead.MinUpdatedAt < 0 {
+		return false
+	}
+	return followerRead.MaxStalenessMs > 0 || followerRead.MinUpdatedAt > 0
+}
+
+// isFreshEnough checks if a follower replica satisfies bounds of a follower read. Staleness is measured by the time
+// up to which the replica applied the shard log, i.e. by its last contact with the leader, so it is approximate.
+func isFreshEnough(followerRead *gatewaypb.FollowerRead, now time.Time, appliedAt int64, accountUpdatedAt int64) bool {
+	if followerRead.MaxStalenessMs > 0 && now.Sub(time.Unix(0, appliedAt)) > time.Duration(followerRead.MaxStalenessMs)*time.Millisecond {
+		return false
+	}
+	if followerRead.MinUpdatedAt > 0 && accountUpdatedAt < followerRead.MinUpdatedAt {
+		return false
+	}
+	return true
+}
+
+func isNotFound(err error) bool {
+	berr := &monsterax.Error{}
+	return errors.As(err, &berr) && berr.Code == monsterax.NotFound
+}
+
+// NewStandaloneApiServer creates a gateway server with all application cores running in-process on a single store,
+// without a Monstera cluster. Each core is a single shard covering the whole key space.
+func NewStandaloneApiServer(dataStore *monstera.BadgerStore) *LedgerServiceApiServer {
+	lowerBound := []byte{0x00, 0x00, 0x00, 0x00}
+	upperBound := []byte{0xff, 0xff, 0xff, 0xff}
+
+	accountsCore := NewAccountsCore(dataStore, lowerBound, upperBound)
+	customersCore := NewCustomersCore(dataStore, lowerBound, upperBound)
+
+	// standalone stub ignores shard ids
+	return NewLedgerServiceApiServer(NewLedgerServiceCoreApiStandaloneStub(accountsCore, customersCore), []string{"standalone"})
+}
+
+func NewLedgerServiceApiServer(coreApiClient LedgerServiceCoreApi, accountsShardIds []string) *LedgerServiceApiServer {
 	return &LedgerServiceApiServer{
-		coreApiClient: coreApiClient,
+		coreApiClient:    coreApiClient,
+		accountsShardIds: accountsShardIds,
 	}
 }
@@ -0,0 +1,156 @@
+package ledger
+
+import (
+	"context"
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/evrblk/monstera-example/ledger/corepb"
+	"github.com/evrblk/monstera-example/ledger/gatewaypb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFollowerReads(t *testing.T) {
+	require := require.New(t)
+
+	// follower applied every committed entry, but heard from the leader 2h ago
+	followerStats := map[string]string{
+		"applied_index": "10",
+		"commit_index":  "10",
+		"last_contact":  "2h0m0s",
+	}
+
+	leader := newAccountsCore()
+	follower := newAccountsCore().WithRaftStats(func() map[string]string {
+		return followerStats
+	})
+
+	server := NewLedgerServiceApiServer(&laggingFollowerCoreApi{
+		LedgerServiceCoreApi: NewLedgerServiceCoreApiStandaloneStub(leader, newCustomersCore()),
+		follower:             follower,
+	}, []string{"standalone"})
+
+	ctx := context.Background()
+	now := time.Now()
+
+	accountId := rand.Uint64()
+
+	// T-2h: account is created on both replicas
+	for _, core := range []*AccountsCore{leader, follower} {
+		_, err := core.CreateAccount(&corepb.CreateAccountRequest{
+			AccountId: accountId,
+			Now:       now.Add(-2 * time.Hour).UnixNano(),
+		})
+		require.NoError(err)
+	}
+
+	// T-1m: topup +100 is applied on the leader only
+	response1, err := leader.CreateTransaction(&corepb.CreateTransactionRequest{
+		TransactionId: &corepb.TransactionId{
+			AccountId:     accountId,
+			TransactionId: rand.Uint64(),
+		},
+		Amount:  100,
+		Settled: true,
+		Now:     now.Add(-time.Minute).UnixNano(),
+	})
+	require.NoError(err)
+	leaderUpdatedAt := response1.Transaction.UpdatedAt
+
+	getAvailableBalance := func(followerRead *gatewaypb.FollowerRead) int64 {
+		response, err := server.GetAccount(ctx, &gatewaypb.GetAccountRequest{
+			AccountId:    EncodeAccountId(accountId),
+			FollowerRead: followerRead,
+		})
+		require.NoError(err)
+		return response.Account.AvailableBalance
+	}
+
+	// leader read
+	require.EqualValues(100, getAvailableBalance(nil))
+
+	// follower is 2h behind, it is too stale for 1h bound
+	require.EqualValues(100, getAvailableBalance(&gatewaypb.FollowerRead{
+		MaxStalenessMs: time.Hour.Milliseconds(),
+	}))
+
+	// 3h bound is satisfied by the follower
+	require.EqualValues(0, getAvailableBalance(&gatewaypb.FollowerRead{
+		MaxStalenessMs: 3 * time.Hour.Milliseconds(),
+	}))
+
+	// follower has not seen the topup yet, reading own writes falls back to the leader
+	require.EqualValues(100, getAvailableBalance(&gatewaypb.FollowerRead{
+		MinUpdatedAt: leaderUpdatedAt,
+	}))
+	require.EqualValues(0, getAvailableBalance(&gatewaypb.FollowerRead{
+		MinUpdatedAt: now.Add(-2 * time.Hour).UnixNano(),
+	}))
+
+	// follower read of transactions
+	response2, err := server.ListTransactions(ctx, &gatewaypb.ListTransactionsRequest{
+		AccountId:    EncodeAccountId(accountId),
+		FollowerRead: &gatewaypb.FollowerRead{MaxStalenessMs: 3 * time.Hour.Milliseconds()},
+	})
+	require.NoError(err)
+	require.Len(response2.Transactions, 0)
+
+	response3, err := server.ListTransactions(ctx, &gatewaypb.ListTransactionsRequest{
+		AccountId:    EncodeAccountId(accountId),
+		FollowerRead: &gatewaypb.FollowerRead{MinUpdatedAt: leaderUpdatedAt},
+	})
+	require.NoError(err)
+	require.Len(response3.Transactions, 1)
+
+	// heartbeats from the leader keep idle shards fresh, now the follower satisfies 1h bound
+	followerStats["last_contact"] = "50ms"
+	require.EqualValues(0, getAvailableBalance(&gatewaypb.FollowerRead{
+		MaxStalenessMs: time.Hour.Milliseconds(),
+	}))
+
+	// follower which is catching up with the leader is not fresh for any bound
+	followerStats["commit_index"] = "12"
+	require.EqualValues(100, getAvailableBalance(&gatewaypb.FollowerRead{
+		MaxStalenessMs: 3 * time.Hour.Milliseconds(),
+	}))
+	followerStats["commit_index"] = "10"
+
+	// account which is not replicated to the follower yet is read from the leader
+	accountId2 := rand.Uint64()
+	_, err = leader.CreateAccount(&corepb.CreateAccountRequest{
+		AccountId: accountId2,
+		Now:       now.UnixNano(),
+	})
+	require.NoError(err)
+
+	_, err = server.GetAccount(ctx, &gatewaypb.GetAccountRequest{
+		AccountId:    EncodeAccountId(accountId2),
+		FollowerRead: &gatewaypb.FollowerRead{MaxStalenessMs: time.Hour.Milliseconds()},
+	})
+	require.NoError(err)
+
+	// follower read without bounds is invalid
+	_, err = server.GetAccount(ctx, &gatewaypb.GetAccountRequest{
+		AccountId:    EncodeAccountId(accountId),
+		FollowerRead: &gatewaypb.FollowerRead{},
+	})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+// laggingFollowerCoreApi serves follower reads from a separate core, which plays a follower replica lagging behind
+// the leader
+type laggingFollowerCoreApi struct {
+	LedgerServiceCoreApi
+	follower *AccountsCore
+}
+
+func (a *laggingFollowerCoreApi) GetAccountFromFollower(ctx context.Context, request *corepb.GetAccountFromFollowerRequest) (*corepb.GetAccountFromFollowerResponse, error) {
+	return a.follower.GetAccountFromFollower(request)
+}
+
+func (a *laggingFollowerCoreApi) ListTransactionsFromFollower(ctx context.Context, request *corepb.ListTransactionsFromFollowerRequest) (*corepb.ListTransactionsFromFollowerResponse, error) {
+	return a.follower.ListTransactionsFromFollower(request)
+}
@@ -9,6 +9,10 @@ func shardByAccount(accountId uint64) []byte {
 	return monstera.GetShardKey(monstera.ConcatBytes(accountId), 4)
 }
 
+func shardByCustomer(customerId uint64) []byte {
+	return monstera.GetShardKey(monstera.ConcatBytes(customerId), 4)
+}
+
 type ShardKeyCalculator struct{}
 
 var _ LedgerServiceMonsteraShardKeyCalculator = &ShardKeyCalculator{}
@@ -21,6 +25,10 @@ func (g *ShardKeyCalculator) ListTransactionsShardKey(request *corepb.ListTransa
 	return shardByAccount(request.AccountId)
 }
 
+func (g *ShardKeyCalculator) ListTransactionsFromFollowerShardKey(request *corepb.ListTransactionsFromFollowerRequest) []byte {
+	return shardByAccount(request.AccountId)
+}
+
 func (g *ShardKeyCalculator) GetTransactionShardKey(request *corepb.GetTransactionRequest) []byte {
 	return shardByAccount(request.TransactionId.AccountId)
 }
@@ -40,3 +48,83 @@ func (g *ShardKeyCalculator) SettleTransactionShardKey(request *corepb.SettleTra
 func (g *ShardKeyCalculator) GetAccountShardKey(request *corepb.GetAccountRequest) []byte {
 	return shardByAccount(request.AccountId)
 }
+
+func (g *ShardKeyCalculator) GetAccountFromFollowerShardKey(request *corepb.GetAccountFromFollowerRequest) []byte {
+	return shardByAccount(request.AccountId)
+}
+
+func (g *ShardKeyCalculator) GetDisputeShardKey(request *corepb.GetDisputeRequest) []byte {
+	return shardByAccount(request.DisputeId.AccountId)
+}
+
+func (g *ShardKeyCalculator) GetSpendingSummaryShardKey(request *corepb.GetSpendingSummaryRequest) []byte {
+	return shardByAccount(request.AccountId)
+}
+
+func (g *ShardKeyCalculator) ListTransactionRollupsShardKey(request *corepb.ListTransactionRollupsRequest) []byte {
+	return shardByAccount(request.AccountId)
+}
+
+func (g *ShardKeyCalculator) ListDisputesShardKey(request *corepb.ListDisputesRequest) []byte {
+	return shardByAccount(request.AccountId)
+}
+
+func (g *ShardKeyCalculator) OpenDisputeShardKey(request *corepb.OpenDisputeRequest) []byte {
+	return shardByAccount(request.DisputeId.AccountId)
+}
+
+func (g *ShardKeyCalculator) ResolveDisputeShardKey(request *corepb.ResolveDisputeRequest) []byte {
+	return shardByAccount(request.DisputeId.AccountId)
+}
+
+func (g *ShardKeyCalculator) GetEscrowShardKey(request *corepb.GetEscrowRequest) []byte {
+	return shardByAccount(request.EscrowId.AccountId)
+}
+
+func (g *ShardKeyCalculator) ListEscrowsShardKey(request *corepb.ListEscrowsRequest) []byte {
+	return shardByAccount(request.AccountId)
+}
+
+func (g *ShardKeyCalculator) HoldEscrowShardKey(request *corepb.HoldEscrowRequest) []byte {
+	return shardByAccount(request.EscrowId.AccountId)
+}
+
+func (g *ShardKeyCalculator) ReleaseEscrowShardKey(request *corepb.ReleaseEscrowRequest) []byte {
+	return shardByAccount(request.EscrowId.AccountId)
+}
+
+func (g *ShardKeyCalculator) ListAlertRulesShardKey(request *corepb.ListAlertRulesRequest) []byte {
+	return shardByAccount(request.AccountId)
+}
+
+func (g *ShardKeyCalculator) ListTriggeredAlertsShardKey(request *corepb.ListTriggeredAlertsRequest) []byte {
+	return shardByAccount(request.AccountId)
+}
+
+func (g *ShardKeyCalculator) CreateAlertRuleShardKey(request *corepb.CreateAlertRuleRequest) []byte {
+	return shardByAccount(request.AlertRuleId.AccountId)
+}
+
+func (g *ShardKeyCalculator) DeleteAlertRuleShardKey(request *corepb.DeleteAlertRuleRequest) []byte {
+	return shardByAccount(request.AlertRuleId.AccountId)
+}
+
+func (g *ShardKeyCalculator) AckAlertShardKey(request *corepb.AckAlertRequest) []byte {
+	return shardByAccount(request.TriggeredAlertId.AccountId)
+}
+
+func (g *ShardKeyCalculator) GetCustomerShardKey(request *corepb.GetCustomerRequest) []byte {
+	return shardByCustomer(request.CustomerId)
+}
+
+func (g *ShardKeyCalculator) CreateCustomerShardKey(request *corepb.CreateCustomerRequest) []byte {
+	return shardByCustomer(request.CustomerId)
+}
+
+func (g *ShardKeyCalculator) AddCustomerAccountShardKey(request *corepb.AddCustomerAccountRequest) []byte {
+	return shardByCustomer(request.CustomerId)
+}
+
+func (g *ShardKeyCalculator) RemoveCustomerAccountShardKey(request *corepb.RemoveCustomerAccountRequest) []byte {
+	return shardByCustomer(request.CustomerId)
+}
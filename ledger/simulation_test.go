@@ -0,0 +1,374 @@
+package ledger
+
+import (
+	"flag"
+	"fmt"
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/evrblk/monstera"
+	"github.com/evrblk/monstera-example/ledger/corepb"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	simulationSeed  = flag.Uint64("simulation.seed", 0, "Run AccountsCore simulation only with this seed")
+	simulationSeeds = flag.Int("simulation.seeds", 20, "Number of seeds of AccountsCore simulation")
+	simulationSteps = flag.Int("simulation.steps", 500, "Number of steps of each AccountsCore simulation")
+)
+
+// TestSimulation runs long random sequences of commands against AccountsCore and checks it against a simple model
+// after every step. Every sequence is fully determined by its seed, a failure is reproduced with:
+//
+//	go test -run TestSimulation -simulation.seed=<seed>
+func TestSimulation(t *testing.T) {
+	seeds := make([]uint64, 0)
+	if *simulationSeed != 0 {
+		seeds = append(seeds, *simulationSeed)
+	} else {
+		for i := 1; i <= *simulationSeeds; i++ {
+			seeds = append(seeds, uint64(i))
+		}
+	}
+
+	for _, seed := range seeds {
+		t.Run(fmt.Sprintf("seed=%d", seed), func(t *testing.T) {
+			newSimulation(t, seed).run(*simulationSteps)
+		})
+	}
+}
+
+// simulatedTransaction is what the model knows about a transaction
+type simulatedTransaction struct {
+	id          *corepb.TransactionId
+	amount      int64
+	status      corepb.TransactionStatus
+	availableAt int64
+	settledAt   int64
+}
+
+// simulatedAccount is what the model knows about an account. Balances are not stored, they are always computed
+// from transactions at a given moment.
+type simulatedAccount struct {
+	id           uint64
+	transactions []*simulatedTransaction
+}
+
+// balances returns expected available, settled and pending availability balances at the moment now
+func (a *simulatedAccount) balances(now int64) (available int64, settled int64, pendingAvailability int64) {
+	for _, transaction := range a.transactions {
+		switch transaction.status {
+		case corepb.TransactionStatus_TRANSACTION_STATUS_SETTLED:
+			settled += transaction.amount
+			if transaction.amount > 0 && transaction.availableAt > transaction.settledAt && transaction.availableAt > now {
+				pendingAvailability += transaction.amount
+			} else {
+				available += transaction.amount
+			}
+		case corepb.TransactionStatus_TRANSACTION_STATUS_PENDING:
+			// pending purchases are already taken from available balance, pending topups are not counted
+			if transaction.amount < 0 {
+				available += transaction.amount
+			}
+		}
+	}
+	return
+}
+
+func (a *simulatedAccount) pendingTransactions() []*simulatedTransaction {
+	result := make([]*simulatedTransaction, 0)
+	for _, transaction := range a.transactions {
+		if transaction.status == corepb.TransactionStatus_TRANSACTION_STATUS_PENDING {
+			result = append(result, transaction)
+		}
+	}
+	return result
+}
+
+func (a *simulatedAccount) finalTransactions() []*simulatedTransaction {
+	result := make([]*simulatedTransaction, 0)
+	for _, transaction := range a.transactions {
+		if transaction.status != corepb.TransactionStatus_TRANSACTION_STATUS_PENDING {
+			result = append(result, transaction)
+		}
+	}
+	return result
+}
+
+type simulation struct {
+	t    *testing.T
+	seed uint64
+	rnd  *rand.Rand
+
+	accountsCore *AccountsCore
+
+	now      time.Time
+	step     int
+	accounts []*simulatedAccount
+}
+
+func newSimulation(t *testing.T, seed uint64) *simulation {
+	return &simulation{
+		t:            t,
+		seed:         seed,
+		rnd:          rand.New(rand.NewPCG(seed, seed)),
+		accountsCore: NewAccountsCore(monstera.NewBadgerInMemoryStore(), []byte{0x00, 0x00}, []byte{0xff, 0xff}),
+		now:          time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func (s *simulation) run(steps int) {
+	s.createAccount()
+
+	for s.step = 1; s.step <= steps; s.step++ {
+		// time moves forward by up to an hour between commands
+		s.now = s.now.Add(time.Duration(s.rnd.Int64N(int64(time.Hour))))
+
+		switch p := s.rnd.IntN(100); {
+		case p < 5:
+			s.createAccount()
+		case p < 30:
+			s.createTopup()
+		case p < 60:
+			s.createPurchase()
+		case p < 75:
+			s.settleTransaction()
+		case p < 85:
+			s.cancelTransaction()
+		case p < 90:
+			s.changeFinalTransaction()
+		default:
+			s.sweep()
+		}
+
+		if s.step%50 == 0 {
+			s.checkShardStats()
+		}
+	}
+
+	s.checkShardStats()
+}
+
+func (s *simulation) msg(format string, args ...any) string {
+	return fmt.Sprintf("seed %d, step %d: %s", s.seed, s.step, fmt.Sprintf(format, args...))
+}
+
+func (s *simulation) randomAccount() *simulatedAccount {
+	return s.accounts[s.rnd.IntN(len(s.accounts))]
+}
+
+func (s *simulation) createAccount() {
+	account := &simulatedAccount{
+		id: s.rnd.Uint64(),
+	}
+
+	_, err := s.accountsCore.CreateAccount(&corepb.CreateAccountRequest{
+		AccountId: account.id,
+		Now:       s.now.UnixNano(),
+	})
+	require.NoError(s.t, err, s.msg("create account"))
+
+	s.accounts = append(s.accounts, account)
+	s.checkAccount(account)
+}
+
+func (s *simulation) createTopup() {
+	account := s.randomAccount()
+
+	settled := s.rnd.IntN(2) == 0
+
+	// some topups are value-dated up to a day ahead
+	var availableAt int64
+	if s.rnd.IntN(3) == 0 {
+		availableAt = s.now.Add(time.Duration(s.rnd.Int64N(int64(24 * time.Hour)))).UnixNano()
+	}
+
+	s.createTransaction(account, 1+s.rnd.Int64N(1000), settled, availableAt)
+}
+
+func (s *simulation) createPurchase() {
+	account := s.randomAccount()
+
+	// amounts are large enough to regularly hit insufficient funds
+	s.createTransaction(account, -1-s.rnd.Int64N(500), s.rnd.IntN(2) == 0, 0)
+}
+
+func (s *simulation) createTransaction(account *simulatedAccount, amount int64, settled bool, availableAt int64) {
+	availableBefore, _, _ := account.balances(s.now.UnixNano())
+
+	transactionId := &corepb.TransactionId{
+		AccountId:     account.id,
+		TransactionId: s.rnd.Uint64(),
+	}
+
+	response, err := s.accountsCore.CreateTransaction(&corepb.CreateTransactionRequest{
+		TransactionId: transactionId,
+		Amount:        amount,
+		Settled:       settled,
+		AvailableAt:   availableAt,
+		Now:           s.now.UnixNano(),
+	})
+	require.NoError(s.t, err, s.msg("create transaction"))
+
+	transaction := &simulatedTransaction{
+		id:     transactionId,
+		amount: amount,
+	}
+	if amount >= 0 {
+		transaction.availableAt = availableAt
+	}
+
+	switch {
+	case amount < 0 && availableBefore+amount < 0:
+		transaction.status = corepb.TransactionStatus_TRANSACTION_STATUS_INSUFFICIENT_FUNDS
+	case settled:
+		transaction.status = corepb.TransactionStatus_TRANSACTION_STATUS_SETTLED
+		transaction.settledAt = s.now.UnixNano()
+	default:
+		transaction.status = corepb.TransactionStatus_TRANSACTION_STATUS_PENDING
+	}
+	require.Equal(s.t, transaction.status, response.Transaction.Status,
+		s.msg("create transaction %d with available balance %d", amount, availableBefore))
+
+	account.transactions = append(account.transactions, transaction)
+	s.checkAccount(account)
+}
+
+func (s *simulation) settleTransaction() {
+	account := s.randomAccount()
+	pending := account.pendingTransactions()
+	if len(pending) == 0 {
+		return
+	}
+	transaction := pending[s.rnd.IntN(len(pending))]
+
+	response, err := s.accountsCore.SettleTransaction(&corepb.SettleTransactionRequest{
+		TransactionId: transaction.id,
+		Now:           s.now.UnixNano(),
+	})
+	require.NoError(s.t, err, s.msg("settle transaction"))
+	require.Equal(s.t, corepb.TransactionStatus_TRANSACTION_STATUS_SETTLED, response.Transaction.Status, s.msg("settle transaction"))
+
+	transaction.status = corepb.TransactionStatus_TRANSACTION_STATUS_SETTLED
+	transaction.settledAt = s.now.UnixNano()
+	s.checkAccount(account)
+}
+
+func (s *simulation) cancelTransaction() {
+	account := s.randomAccount()
+	pending := account.pendingTransactions()
+	if len(pending) == 0 {
+		return
+	}
+	transaction := pending[s.rnd.IntN(len(pending))]
+
+	response, err := s.accountsCore.CancelTransaction(&corepb.CancelTransactionRequest{
+		TransactionId: transaction.id,
+		Now:           s.now.UnixNano(),
+	})
+	require.NoError(s.t, err, s.msg("cancel transaction"))
+	require.Equal(s.t, corepb.TransactionStatus_TRANSACTION_STATUS_CANCELLED, response.Transaction.Status, s.msg("cancel transaction"))
+
+	transaction.status = corepb.TransactionStatus_TRANSACTION_STATUS_CANCELLED
+	s.checkAccount(account)
+}
+
+// changeFinalTransaction tries to settle or cancel a transaction which is not pending, it must fail and change nothing
+func (s *simulation) changeFinalTransaction() {
+	account := s.randomAccount()
+	final := account.finalTransactions()
+	if len(final) == 0 {
+		return
+	}
+	transaction := final[s.rnd.IntN(len(final))]
+
+	var err error
+	if s.rnd.IntN(2) == 0 {
+		_, err = s.accountsCore.SettleTransaction(&corepb.SettleTransactionRequest{
+			TransactionId: transaction.id,
+			Now:           s.now.UnixNano(),
+		})
+	} else {
+		_, err = s.accountsCore.CancelTransaction(&corepb.CancelTransactionRequest{
+			TransactionId: transaction.id,
+			Now:           s.now.UnixNano(),
+		})
+	}
+	require.Error(s.t, err, s.msg("change %s transaction", transaction.status))
+
+	s.checkAccount(account)
+}
+
+func (s *simulation) sweep() {
+	_, err := s.accountsCore.SweepPendingAvailability(&corepb.SweepPendingAvailabilityRequest{
+		Now:   s.now.UnixNano(),
+		Limit: int32(1 + s.rnd.IntN(len(s.accounts))),
+	})
+	require.NoError(s.t, err, s.msg("sweep"))
+
+	for _, account := range s.accounts {
+		s.checkAccount(account)
+	}
+}
+
+// checkAccount compares an account and its transactions with the model, and checks invariants which must hold
+// regardless of the model
+func (s *simulation) checkAccount(account *simulatedAccount) {
+	response1, err := s.accountsCore.GetAccount(&corepb.GetAccountRequest{
+		AccountId: account.id,
+		Now:       s.now.UnixNano(),
+	})
+	require.NoError(s.t, err, s.msg("get account"))
+
+	actual := response1.Account
+
+	// purchases never make available balance negative
+	require.GreaterOrEqual(s.t, actual.AvailableBalance, int64(0), s.msg("negative available balance"))
+
+	// available balance is settled balance without pending purchases and funds which are not available yet
+	require.LessOrEqual(s.t, actual.AvailableBalance, actual.SettledBalance, s.msg("available balance is above settled"))
+	require.LessOrEqual(s.t, actual.AvailableBalance+actual.PendingAvailabilityBalance, actual.SettledBalance,
+		s.msg("available balance with pending availability is above settled"))
+
+	available, settled, pendingAvailability := account.balances(s.now.UnixNano())
+	require.Equal(s.t, available, actual.AvailableBalance, s.msg("available balance"))
+	require.Equal(s.t, settled, actual.SettledBalance, s.msg("settled balance"))
+	require.Equal(s.t, pendingAvailability, actual.PendingAvailabilityBalance, s.msg("pending availability balance"))
+
+	response2, err := s.accountsCore.ListTransactions(&corepb.ListTransactionsRequest{
+		AccountId: account.id,
+	})
+	require.NoError(s.t, err, s.msg("list transactions"))
+	require.Len(s.t, response2.Transactions, len(account.transactions), s.msg("list transactions"))
+
+	statuses := make(map[uint64]corepb.TransactionStatus)
+	for _, transaction := range response2.Transactions {
+		statuses[transaction.Id.TransactionId] = transaction.Status
+	}
+
+	// the model only makes legal transitions, so any difference is an illegal transition in the core
+	for _, transaction := range account.transactions {
+		status, ok := statuses[transaction.id.TransactionId]
+		require.True(s.t, ok, s.msg("transaction %d is missing", transaction.id.TransactionId))
+		require.Equal(s.t, transaction.status, status, s.msg("status of transaction %d", transaction.id.TransactionId))
+	}
+}
+
+// checkShardStats compares running aggregates of the shard with aggregates recomputed from scratch
+func (s *simulation) checkShardStats() {
+	response1, err := s.accountsCore.GetShardStats(&corepb.GetShardStatsRequest{})
+	require.NoError(s.t, err, s.msg("get shard stats"))
+
+	response2, err := s.accountsCore.RebuildShardStats(&corepb.RebuildShardStatsRequest{
+		Now: s.now.UnixNano(),
+	})
+	require.NoError(s.t, err, s.msg("rebuild shard stats"))
+
+	require.EqualValues(s.t, len(s.accounts), response1.Stats.AccountsCount, s.msg("accounts count"))
+	require.Equal(s.t, response2.Stats.AccountsCount, response1.Stats.AccountsCount, s.msg("accounts count"))
+	require.Equal(s.t, response2.Stats.TotalAvailableBalance, response1.Stats.TotalAvailableBalance, s.msg("total available balance"))
+	require.Equal(s.t, response2.Stats.TotalSettledBalance, response1.Stats.TotalSettledBalance, s.msg("total settled balance"))
+	require.Equal(s.t, response2.Stats.TotalPendingAvailabilityBalance, response1.Stats.TotalPendingAvailabilityBalance, s.msg("total pending availability balance"))
+	require.Equal(s.t, response2.Stats.PendingTransactionsCount, response1.Stats.PendingTransactionsCount, s.msg("pending transactions count"))
+}
@@ -15,113 +15,903 @@ type LedgerServiceMonsteraShardKeyCalculator interface {
 	ListTransactionsShardKey(request *corepb.ListTransactionsRequest) []byte
 	GetTransactionShardKey(request *corepb.GetTransactionRequest) []byte
 	GetAccountShardKey(request *corepb.GetAccountRequest) []byte
+	GetDisputeShardKey(request *corepb.GetDisputeRequest) []byte
+	ListDisputesShardKey(request *corepb.ListDisputesRequest) []byte
+	ListAlertRulesShardKey(request *corepb.ListAlertRulesRequest) []byte
+	ListTriggeredAlertsShardKey(request *corepb.ListTriggeredAlertsRequest) []byte
+	GetSpendingSummaryShardKey(request *corepb.GetSpendingSummaryRequest) []byte
+	ListTransactionRollupsShardKey(request *corepb.ListTransactionRollupsRequest) []byte
+	GetAccountFromFollowerShardKey(request *corepb.GetAccountFromFollowerRequest) []byte
+	ListTransactionsFromFollowerShardKey(request *corepb.ListTransactionsFromFollowerRequest) []byte
+	GetEscrowShardKey(request *corepb.GetEscrowRequest) []byte
+	ListEscrowsShardKey(request *corepb.ListEscrowsRequest) []byte
 	CreateTransactionShardKey(request *corepb.CreateTransactionRequest) []byte
 	CancelTransactionShardKey(request *corepb.CancelTransactionRequest) []byte
 	SettleTransactionShardKey(request *corepb.SettleTransactionRequest) []byte
 	CreateAccountShardKey(request *corepb.CreateAccountRequest) []byte
+	OpenDisputeShardKey(request *corepb.OpenDisputeRequest) []byte
+	ResolveDisputeShardKey(request *corepb.ResolveDisputeRequest) []byte
+	CreateAlertRuleShardKey(request *corepb.CreateAlertRuleRequest) []byte
+	DeleteAlertRuleShardKey(request *corepb.DeleteAlertRuleRequest) []byte
+	AckAlertShardKey(request *corepb.AckAlertRequest) []byte
+	HoldEscrowShardKey(request *corepb.HoldEscrowRequest) []byte
+	ReleaseEscrowShardKey(request *corepb.ReleaseEscrowRequest) []byte
+
+	GetCustomerShardKey(request *corepb.GetCustomerRequest) []byte
+	CreateCustomerShardKey(request *corepb.CreateCustomerRequest) []byte
+	AddCustomerAccountShardKey(request *corepb.AddCustomerAccountRequest) []byte
+	RemoveCustomerAccountShardKey(request *corepb.RemoveCustomerAccountRequest) []byte
+}
+
+type LedgerServiceCoreApiMonsteraStub struct {
+	monsteraClient     *monstera.MonsteraClient
+	shardKeyCalculator LedgerServiceMonsteraShardKeyCalculator
+}
+
+var _ LedgerServiceCoreApi = &LedgerServiceCoreApiMonsteraStub{}
+
+func (s *LedgerServiceCoreApiMonsteraStub) ListTransactions(ctx context.Context, request *corepb.ListTransactionsRequest) (*corepb.ListTransactionsResponse, error) {
+	readRequest := &corepb.ReadRequest{Request: &corepb.ReadRequest_ListTransactionsRequest{ListTransactionsRequest: request}}
+	requestBytes, err := proto.Marshal(readRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.ListTransactionsShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Read(ctx, "Accounts", shardKey, false, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	readResponse := &corepb.ReadResponse{}
+	err = proto.Unmarshal(responseBytes, readResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := readResponse.Response.(*corepb.ReadResponse_ListTransactionsResponse)
+	if ok {
+		return response.ListTransactionsResponse, nilifyIfEmpty(readResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": readResponse.String()})
+	}
+}
+
+func (s *LedgerServiceCoreApiMonsteraStub) GetTransaction(ctx context.Context, request *corepb.GetTransactionRequest) (*corepb.GetTransactionResponse, error) {
+	readRequest := &corepb.ReadRequest{Request: &corepb.ReadRequest_GetTransactionRequest{GetTransactionRequest: request}}
+	requestBytes, err := proto.Marshal(readRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.GetTransactionShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Read(ctx, "Accounts", shardKey, false, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	readResponse := &corepb.ReadResponse{}
+	err = proto.Unmarshal(responseBytes, readResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := readResponse.Response.(*corepb.ReadResponse_GetTransactionResponse)
+	if ok {
+		return response.GetTransactionResponse, nilifyIfEmpty(readResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": readResponse.String()})
+	}
+}
+
+func (s *LedgerServiceCoreApiMonsteraStub) GetAccount(ctx context.Context, request *corepb.GetAccountRequest) (*corepb.GetAccountResponse, error) {
+	readRequest := &corepb.ReadRequest{Request: &corepb.ReadRequest_GetAccountRequest{GetAccountRequest: request}}
+	requestBytes, err := proto.Marshal(readRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.GetAccountShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Read(ctx, "Accounts", shardKey, false, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	readResponse := &corepb.ReadResponse{}
+	err = proto.Unmarshal(responseBytes, readResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := readResponse.Response.(*corepb.ReadResponse_GetAccountResponse)
+	if ok {
+		return response.GetAccountResponse, nilifyIfEmpty(readResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": readResponse.String()})
+	}
+}
+
+func (s *LedgerServiceCoreApiMonsteraStub) GetDispute(ctx context.Context, request *corepb.GetDisputeRequest) (*corepb.GetDisputeResponse, error) {
+	readRequest := &corepb.ReadRequest{Request: &corepb.ReadRequest_GetDisputeRequest{GetDisputeRequest: request}}
+	requestBytes, err := proto.Marshal(readRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.GetDisputeShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Read(ctx, "Accounts", shardKey, false, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	readResponse := &corepb.ReadResponse{}
+	err = proto.Unmarshal(responseBytes, readResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := readResponse.Response.(*corepb.ReadResponse_GetDisputeResponse)
+	if ok {
+		return response.GetDisputeResponse, nilifyIfEmpty(readResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": readResponse.String()})
+	}
+}
+
+func (s *LedgerServiceCoreApiMonsteraStub) ListDisputes(ctx context.Context, request *corepb.ListDisputesRequest) (*corepb.ListDisputesResponse, error) {
+	readRequest := &corepb.ReadRequest{Request: &corepb.ReadRequest_ListDisputesRequest{ListDisputesRequest: request}}
+	requestBytes, err := proto.Marshal(readRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.ListDisputesShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Read(ctx, "Accounts", shardKey, false, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	readResponse := &corepb.ReadResponse{}
+	err = proto.Unmarshal(responseBytes, readResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := readResponse.Response.(*corepb.ReadResponse_ListDisputesResponse)
+	if ok {
+		return response.ListDisputesResponse, nilifyIfEmpty(readResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": readResponse.String()})
+	}
+}
+
+func (s *LedgerServiceCoreApiMonsteraStub) ListAlertRules(ctx context.Context, request *corepb.ListAlertRulesRequest) (*corepb.ListAlertRulesResponse, error) {
+	readRequest := &corepb.ReadRequest{Request: &corepb.ReadRequest_ListAlertRulesRequest{ListAlertRulesRequest: request}}
+	requestBytes, err := proto.Marshal(readRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.ListAlertRulesShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Read(ctx, "Accounts", shardKey, false, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	readResponse := &corepb.ReadResponse{}
+	err = proto.Unmarshal(responseBytes, readResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := readResponse.Response.(*corepb.ReadResponse_ListAlertRulesResponse)
+	if ok {
+		return response.ListAlertRulesResponse, nilifyIfEmpty(readResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": readResponse.String()})
+	}
+}
+
+func (s *LedgerServiceCoreApiMonsteraStub) ListTriggeredAlerts(ctx context.Context, request *corepb.ListTriggeredAlertsRequest) (*corepb.ListTriggeredAlertsResponse, error) {
+	readRequest := &corepb.ReadRequest{Request: &corepb.ReadRequest_ListTriggeredAlertsRequest{ListTriggeredAlertsRequest: request}}
+	requestBytes, err := proto.Marshal(readRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.ListTriggeredAlertsShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Read(ctx, "Accounts", shardKey, false, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	readResponse := &corepb.ReadResponse{}
+	err = proto.Unmarshal(responseBytes, readResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := readResponse.Response.(*corepb.ReadResponse_ListTriggeredAlertsResponse)
+	if ok {
+		return response.ListTriggeredAlertsResponse, nilifyIfEmpty(readResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": readResponse.String()})
+	}
+}
+
+func (s *LedgerServiceCoreApiMonsteraStub) ReadOutbox(ctx context.Context, request *corepb.ReadOutboxRequest, shardId string) (*corepb.ReadOutboxResponse, error) {
+	readRequest := &corepb.ReadRequest{Request: &corepb.ReadRequest_ReadOutboxRequest{ReadOutboxRequest: request}}
+	requestBytes, err := proto.Marshal(readRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	responseBytes, err := s.monsteraClient.ReadShard(ctx, "Accounts", shardId, false, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	readResponse := &corepb.ReadResponse{}
+	err = proto.Unmarshal(responseBytes, readResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := readResponse.Response.(*corepb.ReadResponse_ReadOutboxResponse)
+	if ok {
+		return response.ReadOutboxResponse, nilifyIfEmpty(readResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": readResponse.String()})
+	}
+}
+
+func (s *LedgerServiceCoreApiMonsteraStub) GetSpendingSummary(ctx context.Context, request *corepb.GetSpendingSummaryRequest) (*corepb.GetSpendingSummaryResponse, error) {
+	readRequest := &corepb.ReadRequest{Request: &corepb.ReadRequest_GetSpendingSummaryRequest{GetSpendingSummaryRequest: request}}
+	requestBytes, err := proto.Marshal(readRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.GetSpendingSummaryShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Read(ctx, "Accounts", shardKey, false, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	readResponse := &corepb.ReadResponse{}
+	err = proto.Unmarshal(responseBytes, readResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := readResponse.Response.(*corepb.ReadResponse_GetSpendingSummaryResponse)
+	if ok {
+		return response.GetSpendingSummaryResponse, nilifyIfEmpty(readResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": readResponse.String()})
+	}
+}
+
+func (s *LedgerServiceCoreApiMonsteraStub) ListArchivableTransactions(ctx context.Context, request *corepb.ListArchivableTransactionsRequest, shardId string) (*corepb.ListArchivableTransactionsResponse, error) {
+	readRequest := &corepb.ReadRequest{Request: &corepb.ReadRequest_ListArchivableTransactionsRequest{ListArchivableTransactionsRequest: request}}
+	requestBytes, err := proto.Marshal(readRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	responseBytes, err := s.monsteraClient.ReadShard(ctx, "Accounts", shardId, false, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	readResponse := &corepb.ReadResponse{}
+	err = proto.Unmarshal(responseBytes, readResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := readResponse.Response.(*corepb.ReadResponse_ListArchivableTransactionsResponse)
+	if ok {
+		return response.ListArchivableTransactionsResponse, nilifyIfEmpty(readResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": readResponse.String()})
+	}
+}
+
+func (s *LedgerServiceCoreApiMonsteraStub) ListTransactionRollups(ctx context.Context, request *corepb.ListTransactionRollupsRequest) (*corepb.ListTransactionRollupsResponse, error) {
+	readRequest := &corepb.ReadRequest{Request: &corepb.ReadRequest_ListTransactionRollupsRequest{ListTransactionRollupsRequest: request}}
+	requestBytes, err := proto.Marshal(readRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.ListTransactionRollupsShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Read(ctx, "Accounts", shardKey, false, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	readResponse := &corepb.ReadResponse{}
+	err = proto.Unmarshal(responseBytes, readResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := readResponse.Response.(*corepb.ReadResponse_ListTransactionRollupsResponse)
+	if ok {
+		return response.ListTransactionRollupsResponse, nilifyIfEmpty(readResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": readResponse.String()})
+	}
+}
+
+func (s *LedgerServiceCoreApiMonsteraStub) GetShardStats(ctx context.Context, request *corepb.GetShardStatsRequest, shardId string) (*corepb.GetShardStatsResponse, error) {
+	readRequest := &corepb.ReadRequest{Request: &corepb.ReadRequest_GetShardStatsRequest{GetShardStatsRequest: request}}
+	requestBytes, err := proto.Marshal(readRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	responseBytes, err := s.monsteraClient.ReadShard(ctx, "Accounts", shardId, false, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	readResponse := &corepb.ReadResponse{}
+	err = proto.Unmarshal(responseBytes, readResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := readResponse.Response.(*corepb.ReadResponse_GetShardStatsResponse)
+	if ok {
+		return response.GetShardStatsResponse, nilifyIfEmpty(readResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": readResponse.String()})
+	}
+}
+
+func (s *LedgerServiceCoreApiMonsteraStub) GetAccountFromFollower(ctx context.Context, request *corepb.GetAccountFromFollowerRequest) (*corepb.GetAccountFromFollowerResponse, error) {
+	readRequest := &corepb.ReadRequest{Request: &corepb.ReadRequest_GetAccountFromFollowerRequest{GetAccountFromFollowerRequest: request}}
+	requestBytes, err := proto.Marshal(readRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.GetAccountFromFollowerShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Read(ctx, "Accounts", shardKey, true, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	readResponse := &corepb.ReadResponse{}
+	err = proto.Unmarshal(responseBytes, readResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := readResponse.Response.(*corepb.ReadResponse_GetAccountFromFollowerResponse)
+	if ok {
+		return response.GetAccountFromFollowerResponse, nilifyIfEmpty(readResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": readResponse.String()})
+	}
+}
+
+func (s *LedgerServiceCoreApiMonsteraStub) ListTransactionsFromFollower(ctx context.Context, request *corepb.ListTransactionsFromFollowerRequest) (*corepb.ListTransactionsFromFollowerResponse, error) {
+	readRequest := &corepb.ReadRequest{Request: &corepb.ReadRequest_ListTransactionsFromFollowerRequest{ListTransactionsFromFollowerRequest: request}}
+	requestBytes, err := proto.Marshal(readRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.ListTransactionsFromFollowerShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Read(ctx, "Accounts", shardKey, true, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	readResponse := &corepb.ReadResponse{}
+	err = proto.Unmarshal(responseBytes, readResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := readResponse.Response.(*corepb.ReadResponse_ListTransactionsFromFollowerResponse)
+	if ok {
+		return response.ListTransactionsFromFollowerResponse, nilifyIfEmpty(readResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": readResponse.String()})
+	}
+}
+
+func (s *LedgerServiceCoreApiMonsteraStub) GetEscrow(ctx context.Context, request *corepb.GetEscrowRequest) (*corepb.GetEscrowResponse, error) {
+	readRequest := &corepb.ReadRequest{Request: &corepb.ReadRequest_GetEscrowRequest{GetEscrowRequest: request}}
+	requestBytes, err := proto.Marshal(readRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.GetEscrowShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Read(ctx, "Accounts", shardKey, false, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	readResponse := &corepb.ReadResponse{}
+	err = proto.Unmarshal(responseBytes, readResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := readResponse.Response.(*corepb.ReadResponse_GetEscrowResponse)
+	if ok {
+		return response.GetEscrowResponse, nilifyIfEmpty(readResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": readResponse.String()})
+	}
+}
+
+func (s *LedgerServiceCoreApiMonsteraStub) ListEscrows(ctx context.Context, request *corepb.ListEscrowsRequest) (*corepb.ListEscrowsResponse, error) {
+	readRequest := &corepb.ReadRequest{Request: &corepb.ReadRequest_ListEscrowsRequest{ListEscrowsRequest: request}}
+	requestBytes, err := proto.Marshal(readRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.ListEscrowsShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Read(ctx, "Accounts", shardKey, false, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	readResponse := &corepb.ReadResponse{}
+	err = proto.Unmarshal(responseBytes, readResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := readResponse.Response.(*corepb.ReadResponse_ListEscrowsResponse)
+	if ok {
+		return response.ListEscrowsResponse, nilifyIfEmpty(readResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": readResponse.String()})
+	}
+}
+
+func (s *LedgerServiceCoreApiMonsteraStub) CreateTransaction(ctx context.Context, request *corepb.CreateTransactionRequest) (*corepb.CreateTransactionResponse, error) {
+	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_CreateTransactionRequest{CreateTransactionRequest: request}}
+	requestBytes, err := proto.Marshal(updateRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.CreateTransactionShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Update(ctx, "Accounts", shardKey, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	updateResponse := &corepb.UpdateResponse{}
+	err = proto.Unmarshal(responseBytes, updateResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := updateResponse.Response.(*corepb.UpdateResponse_CreateTransactionResponse)
+	if ok {
+		return response.CreateTransactionResponse, nilifyIfEmpty(updateResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": updateResponse.String()})
+	}
+}
+
+func (s *LedgerServiceCoreApiMonsteraStub) CancelTransaction(ctx context.Context, request *corepb.CancelTransactionRequest) (*corepb.CancelTransactionResponse, error) {
+	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_CancelTransactionRequest{CancelTransactionRequest: request}}
+	requestBytes, err := proto.Marshal(updateRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.CancelTransactionShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Update(ctx, "Accounts", shardKey, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	updateResponse := &corepb.UpdateResponse{}
+	err = proto.Unmarshal(responseBytes, updateResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := updateResponse.Response.(*corepb.UpdateResponse_CancelTransactionResponse)
+	if ok {
+		return response.CancelTransactionResponse, nilifyIfEmpty(updateResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": updateResponse.String()})
+	}
+}
+
+func (s *LedgerServiceCoreApiMonsteraStub) SettleTransaction(ctx context.Context, request *corepb.SettleTransactionRequest) (*corepb.SettleTransactionResponse, error) {
+	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_SettleTransactionRequest{SettleTransactionRequest: request}}
+	requestBytes, err := proto.Marshal(updateRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.SettleTransactionShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Update(ctx, "Accounts", shardKey, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	updateResponse := &corepb.UpdateResponse{}
+	err = proto.Unmarshal(responseBytes, updateResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := updateResponse.Response.(*corepb.UpdateResponse_SettleTransactionResponse)
+	if ok {
+		return response.SettleTransactionResponse, nilifyIfEmpty(updateResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": updateResponse.String()})
+	}
+}
+
+func (s *LedgerServiceCoreApiMonsteraStub) CreateAccount(ctx context.Context, request *corepb.CreateAccountRequest) (*corepb.CreateAccountResponse, error) {
+	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_CreateAccountRequest{CreateAccountRequest: request}}
+	requestBytes, err := proto.Marshal(updateRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.CreateAccountShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Update(ctx, "Accounts", shardKey, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	updateResponse := &corepb.UpdateResponse{}
+	err = proto.Unmarshal(responseBytes, updateResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := updateResponse.Response.(*corepb.UpdateResponse_CreateAccountResponse)
+	if ok {
+		return response.CreateAccountResponse, nilifyIfEmpty(updateResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": updateResponse.String()})
+	}
+}
+
+func (s *LedgerServiceCoreApiMonsteraStub) OpenDispute(ctx context.Context, request *corepb.OpenDisputeRequest) (*corepb.OpenDisputeResponse, error) {
+	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_OpenDisputeRequest{OpenDisputeRequest: request}}
+	requestBytes, err := proto.Marshal(updateRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.OpenDisputeShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Update(ctx, "Accounts", shardKey, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	updateResponse := &corepb.UpdateResponse{}
+	err = proto.Unmarshal(responseBytes, updateResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := updateResponse.Response.(*corepb.UpdateResponse_OpenDisputeResponse)
+	if ok {
+		return response.OpenDisputeResponse, nilifyIfEmpty(updateResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": updateResponse.String()})
+	}
+}
+
+func (s *LedgerServiceCoreApiMonsteraStub) ResolveDispute(ctx context.Context, request *corepb.ResolveDisputeRequest) (*corepb.ResolveDisputeResponse, error) {
+	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_ResolveDisputeRequest{ResolveDisputeRequest: request}}
+	requestBytes, err := proto.Marshal(updateRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.ResolveDisputeShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Update(ctx, "Accounts", shardKey, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	updateResponse := &corepb.UpdateResponse{}
+	err = proto.Unmarshal(responseBytes, updateResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := updateResponse.Response.(*corepb.UpdateResponse_ResolveDisputeResponse)
+	if ok {
+		return response.ResolveDisputeResponse, nilifyIfEmpty(updateResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": updateResponse.String()})
+	}
+}
+
+func (s *LedgerServiceCoreApiMonsteraStub) SweepPendingAvailability(ctx context.Context, request *corepb.SweepPendingAvailabilityRequest, shardId string) (*corepb.SweepPendingAvailabilityResponse, error) {
+	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_SweepPendingAvailabilityRequest{SweepPendingAvailabilityRequest: request}}
+	requestBytes, err := proto.Marshal(updateRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	responseBytes, err := s.monsteraClient.UpdateShard(ctx, "Accounts", shardId, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	updateResponse := &corepb.UpdateResponse{}
+	err = proto.Unmarshal(responseBytes, updateResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := updateResponse.Response.(*corepb.UpdateResponse_SweepPendingAvailabilityResponse)
+	if ok {
+		return response.SweepPendingAvailabilityResponse, nilifyIfEmpty(updateResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": updateResponse.String()})
+	}
+}
+
+func (s *LedgerServiceCoreApiMonsteraStub) CreateAlertRule(ctx context.Context, request *corepb.CreateAlertRuleRequest) (*corepb.CreateAlertRuleResponse, error) {
+	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_CreateAlertRuleRequest{CreateAlertRuleRequest: request}}
+	requestBytes, err := proto.Marshal(updateRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.CreateAlertRuleShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Update(ctx, "Accounts", shardKey, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	updateResponse := &corepb.UpdateResponse{}
+	err = proto.Unmarshal(responseBytes, updateResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := updateResponse.Response.(*corepb.UpdateResponse_CreateAlertRuleResponse)
+	if ok {
+		return response.CreateAlertRuleResponse, nilifyIfEmpty(updateResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": updateResponse.String()})
+	}
+}
+
+func (s *LedgerServiceCoreApiMonsteraStub) DeleteAlertRule(ctx context.Context, request *corepb.DeleteAlertRuleRequest) (*corepb.DeleteAlertRuleResponse, error) {
+	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_DeleteAlertRuleRequest{DeleteAlertRuleRequest: request}}
+	requestBytes, err := proto.Marshal(updateRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.DeleteAlertRuleShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Update(ctx, "Accounts", shardKey, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	updateResponse := &corepb.UpdateResponse{}
+	err = proto.Unmarshal(responseBytes, updateResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := updateResponse.Response.(*corepb.UpdateResponse_DeleteAlertRuleResponse)
+	if ok {
+		return response.DeleteAlertRuleResponse, nilifyIfEmpty(updateResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": updateResponse.String()})
+	}
+}
+
+func (s *LedgerServiceCoreApiMonsteraStub) AckAlert(ctx context.Context, request *corepb.AckAlertRequest) (*corepb.AckAlertResponse, error) {
+	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_AckAlertRequest{AckAlertRequest: request}}
+	requestBytes, err := proto.Marshal(updateRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.AckAlertShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Update(ctx, "Accounts", shardKey, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	updateResponse := &corepb.UpdateResponse{}
+	err = proto.Unmarshal(responseBytes, updateResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := updateResponse.Response.(*corepb.UpdateResponse_AckAlertResponse)
+	if ok {
+		return response.AckAlertResponse, nilifyIfEmpty(updateResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": updateResponse.String()})
+	}
+}
+
+func (s *LedgerServiceCoreApiMonsteraStub) AckOutbox(ctx context.Context, request *corepb.AckOutboxRequest, shardId string) (*corepb.AckOutboxResponse, error) {
+	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_AckOutboxRequest{AckOutboxRequest: request}}
+	requestBytes, err := proto.Marshal(updateRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	responseBytes, err := s.monsteraClient.UpdateShard(ctx, "Accounts", shardId, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	updateResponse := &corepb.UpdateResponse{}
+	err = proto.Unmarshal(responseBytes, updateResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := updateResponse.Response.(*corepb.UpdateResponse_AckOutboxResponse)
+	if ok {
+		return response.AckOutboxResponse, nilifyIfEmpty(updateResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": updateResponse.String()})
+	}
 }
 
-type LedgerServiceCoreApiMonsteraStub struct {
-	monsteraClient     *monstera.MonsteraClient
-	shardKeyCalculator LedgerServiceMonsteraShardKeyCalculator
-}
+func (s *LedgerServiceCoreApiMonsteraStub) ArchiveTransactions(ctx context.Context, request *corepb.ArchiveTransactionsRequest, shardId string) (*corepb.ArchiveTransactionsResponse, error) {
+	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_ArchiveTransactionsRequest{ArchiveTransactionsRequest: request}}
+	requestBytes, err := proto.Marshal(updateRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	responseBytes, err := s.monsteraClient.UpdateShard(ctx, "Accounts", shardId, requestBytes)
+	if err != nil {
+		return nil, err
+	}
 
-var _ LedgerServiceCoreApi = &LedgerServiceCoreApiMonsteraStub{}
+	updateResponse := &corepb.UpdateResponse{}
+	err = proto.Unmarshal(responseBytes, updateResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
 
-func (s *LedgerServiceCoreApiMonsteraStub) ListTransactions(ctx context.Context, request *corepb.ListTransactionsRequest) (*corepb.ListTransactionsResponse, error) {
-	readRequest := &corepb.ReadRequest{Request: &corepb.ReadRequest_ListTransactionsRequest{ListTransactionsRequest: request}}
-	requestBytes, err := proto.Marshal(readRequest)
+	response, ok := updateResponse.Response.(*corepb.UpdateResponse_ArchiveTransactionsResponse)
+	if ok {
+		return response.ArchiveTransactionsResponse, nilifyIfEmpty(updateResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": updateResponse.String()})
+	}
+}
+
+func (s *LedgerServiceCoreApiMonsteraStub) RebuildShardStats(ctx context.Context, request *corepb.RebuildShardStatsRequest, shardId string) (*corepb.RebuildShardStatsResponse, error) {
+	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_RebuildShardStatsRequest{RebuildShardStatsRequest: request}}
+	requestBytes, err := proto.Marshal(updateRequest)
 	if err != nil {
 		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
 	}
 
-	shardKey := s.shardKeyCalculator.ListTransactionsShardKey(request)
-
-	responseBytes, err := s.monsteraClient.Read(ctx, "Accounts", shardKey, false, requestBytes)
+	responseBytes, err := s.monsteraClient.UpdateShard(ctx, "Accounts", shardId, requestBytes)
 	if err != nil {
 		return nil, err
 	}
 
-	readResponse := &corepb.ReadResponse{}
-	err = proto.Unmarshal(responseBytes, readResponse)
+	updateResponse := &corepb.UpdateResponse{}
+	err = proto.Unmarshal(responseBytes, updateResponse)
 	if err != nil {
 		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
 	}
 
-	response, ok := readResponse.Response.(*corepb.ReadResponse_ListTransactionsResponse)
+	response, ok := updateResponse.Response.(*corepb.UpdateResponse_RebuildShardStatsResponse)
 	if ok {
-		return response.ListTransactionsResponse, nilifyIfEmpty(readResponse.Error)
+		return response.RebuildShardStatsResponse, nilifyIfEmpty(updateResponse.Error)
 	} else {
-		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": readResponse.String()})
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": updateResponse.String()})
 	}
 }
 
-func (s *LedgerServiceCoreApiMonsteraStub) GetTransaction(ctx context.Context, request *corepb.GetTransactionRequest) (*corepb.GetTransactionResponse, error) {
-	readRequest := &corepb.ReadRequest{Request: &corepb.ReadRequest_GetTransactionRequest{GetTransactionRequest: request}}
-	requestBytes, err := proto.Marshal(readRequest)
+func (s *LedgerServiceCoreApiMonsteraStub) HoldEscrow(ctx context.Context, request *corepb.HoldEscrowRequest) (*corepb.HoldEscrowResponse, error) {
+	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_HoldEscrowRequest{HoldEscrowRequest: request}}
+	requestBytes, err := proto.Marshal(updateRequest)
 	if err != nil {
 		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
 	}
 
-	shardKey := s.shardKeyCalculator.GetTransactionShardKey(request)
+	shardKey := s.shardKeyCalculator.HoldEscrowShardKey(request)
 
-	responseBytes, err := s.monsteraClient.Read(ctx, "Accounts", shardKey, false, requestBytes)
+	responseBytes, err := s.monsteraClient.Update(ctx, "Accounts", shardKey, requestBytes)
 	if err != nil {
 		return nil, err
 	}
 
-	readResponse := &corepb.ReadResponse{}
-	err = proto.Unmarshal(responseBytes, readResponse)
+	updateResponse := &corepb.UpdateResponse{}
+	err = proto.Unmarshal(responseBytes, updateResponse)
 	if err != nil {
 		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
 	}
 
-	response, ok := readResponse.Response.(*corepb.ReadResponse_GetTransactionResponse)
+	response, ok := updateResponse.Response.(*corepb.UpdateResponse_HoldEscrowResponse)
 	if ok {
-		return response.GetTransactionResponse, nilifyIfEmpty(readResponse.Error)
+		return response.HoldEscrowResponse, nilifyIfEmpty(updateResponse.Error)
 	} else {
-		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": readResponse.String()})
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": updateResponse.String()})
 	}
 }
 
-func (s *LedgerServiceCoreApiMonsteraStub) GetAccount(ctx context.Context, request *corepb.GetAccountRequest) (*corepb.GetAccountResponse, error) {
-	readRequest := &corepb.ReadRequest{Request: &corepb.ReadRequest_GetAccountRequest{GetAccountRequest: request}}
-	requestBytes, err := proto.Marshal(readRequest)
+func (s *LedgerServiceCoreApiMonsteraStub) ReleaseEscrow(ctx context.Context, request *corepb.ReleaseEscrowRequest) (*corepb.ReleaseEscrowResponse, error) {
+	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_ReleaseEscrowRequest{ReleaseEscrowRequest: request}}
+	requestBytes, err := proto.Marshal(updateRequest)
 	if err != nil {
 		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
 	}
 
-	shardKey := s.shardKeyCalculator.GetAccountShardKey(request)
+	shardKey := s.shardKeyCalculator.ReleaseEscrowShardKey(request)
 
-	responseBytes, err := s.monsteraClient.Read(ctx, "Accounts", shardKey, false, requestBytes)
+	responseBytes, err := s.monsteraClient.Update(ctx, "Accounts", shardKey, requestBytes)
 	if err != nil {
 		return nil, err
 	}
 
-	readResponse := &corepb.ReadResponse{}
-	err = proto.Unmarshal(responseBytes, readResponse)
+	updateResponse := &corepb.UpdateResponse{}
+	err = proto.Unmarshal(responseBytes, updateResponse)
 	if err != nil {
 		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
 	}
 
-	response, ok := readResponse.Response.(*corepb.ReadResponse_GetAccountResponse)
+	response, ok := updateResponse.Response.(*corepb.UpdateResponse_ReleaseEscrowResponse)
 	if ok {
-		return response.GetAccountResponse, nilifyIfEmpty(readResponse.Error)
+		return response.ReleaseEscrowResponse, nilifyIfEmpty(updateResponse.Error)
 	} else {
-		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": readResponse.String()})
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": updateResponse.String()})
 	}
 }
 
-func (s *LedgerServiceCoreApiMonsteraStub) CreateTransaction(ctx context.Context, request *corepb.CreateTransactionRequest) (*corepb.CreateTransactionResponse, error) {
-	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_CreateTransactionRequest{CreateTransactionRequest: request}}
+func (s *LedgerServiceCoreApiMonsteraStub) SweepEscrows(ctx context.Context, request *corepb.SweepEscrowsRequest, shardId string) (*corepb.SweepEscrowsResponse, error) {
+	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_SweepEscrowsRequest{SweepEscrowsRequest: request}}
 	requestBytes, err := proto.Marshal(updateRequest)
 	if err != nil {
 		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
 	}
 
-	shardKey := s.shardKeyCalculator.CreateTransactionShardKey(request)
-
-	responseBytes, err := s.monsteraClient.Update(ctx, "Accounts", shardKey, requestBytes)
+	responseBytes, err := s.monsteraClient.UpdateShard(ctx, "Accounts", shardId, requestBytes)
 	if err != nil {
 		return nil, err
 	}
@@ -132,24 +922,52 @@ func (s *LedgerServiceCoreApiMonsteraStub) CreateTransaction(ctx context.Context
 		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
 	}
 
-	response, ok := updateResponse.Response.(*corepb.UpdateResponse_CreateTransactionResponse)
+	response, ok := updateResponse.Response.(*corepb.UpdateResponse_SweepEscrowsResponse)
 	if ok {
-		return response.CreateTransactionResponse, nilifyIfEmpty(updateResponse.Error)
+		return response.SweepEscrowsResponse, nilifyIfEmpty(updateResponse.Error)
 	} else {
 		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": updateResponse.String()})
 	}
 }
 
-func (s *LedgerServiceCoreApiMonsteraStub) CancelTransaction(ctx context.Context, request *corepb.CancelTransactionRequest) (*corepb.CancelTransactionResponse, error) {
-	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_CancelTransactionRequest{CancelTransactionRequest: request}}
+func (s *LedgerServiceCoreApiMonsteraStub) GetCustomer(ctx context.Context, request *corepb.GetCustomerRequest) (*corepb.GetCustomerResponse, error) {
+	readRequest := &corepb.ReadRequest{Request: &corepb.ReadRequest_GetCustomerRequest{GetCustomerRequest: request}}
+	requestBytes, err := proto.Marshal(readRequest)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
+	}
+
+	shardKey := s.shardKeyCalculator.GetCustomerShardKey(request)
+
+	responseBytes, err := s.monsteraClient.Read(ctx, "Customers", shardKey, false, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	readResponse := &corepb.ReadResponse{}
+	err = proto.Unmarshal(responseBytes, readResponse)
+	if err != nil {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
+	}
+
+	response, ok := readResponse.Response.(*corepb.ReadResponse_GetCustomerResponse)
+	if ok {
+		return response.GetCustomerResponse, nilifyIfEmpty(readResponse.Error)
+	} else {
+		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": readResponse.String()})
+	}
+}
+
+func (s *LedgerServiceCoreApiMonsteraStub) CreateCustomer(ctx context.Context, request *corepb.CreateCustomerRequest) (*corepb.CreateCustomerResponse, error) {
+	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_CreateCustomerRequest{CreateCustomerRequest: request}}
 	requestBytes, err := proto.Marshal(updateRequest)
 	if err != nil {
 		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
 	}
 
-	shardKey := s.shardKeyCalculator.CancelTransactionShardKey(request)
+	shardKey := s.shardKeyCalculator.CreateCustomerShardKey(request)
 
-	responseBytes, err := s.monsteraClient.Update(ctx, "Accounts", shardKey, requestBytes)
+	responseBytes, err := s.monsteraClient.Update(ctx, "Customers", shardKey, requestBytes)
 	if err != nil {
 		return nil, err
 	}
@@ -160,24 +978,24 @@ func (s *LedgerServiceCoreApiMonsteraStub) CancelTransaction(ctx context.Context
 		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
 	}
 
-	response, ok := updateResponse.Response.(*corepb.UpdateResponse_CancelTransactionResponse)
+	response, ok := updateResponse.Response.(*corepb.UpdateResponse_CreateCustomerResponse)
 	if ok {
-		return response.CancelTransactionResponse, nilifyIfEmpty(updateResponse.Error)
+		return response.CreateCustomerResponse, nilifyIfEmpty(updateResponse.Error)
 	} else {
 		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": updateResponse.String()})
 	}
 }
 
-func (s *LedgerServiceCoreApiMonsteraStub) SettleTransaction(ctx context.Context, request *corepb.SettleTransactionRequest) (*corepb.SettleTransactionResponse, error) {
-	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_SettleTransactionRequest{SettleTransactionRequest: request}}
+func (s *LedgerServiceCoreApiMonsteraStub) AddCustomerAccount(ctx context.Context, request *corepb.AddCustomerAccountRequest) (*corepb.AddCustomerAccountResponse, error) {
+	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_AddCustomerAccountRequest{AddCustomerAccountRequest: request}}
 	requestBytes, err := proto.Marshal(updateRequest)
 	if err != nil {
 		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
 	}
 
-	shardKey := s.shardKeyCalculator.SettleTransactionShardKey(request)
+	shardKey := s.shardKeyCalculator.AddCustomerAccountShardKey(request)
 
-	responseBytes, err := s.monsteraClient.Update(ctx, "Accounts", shardKey, requestBytes)
+	responseBytes, err := s.monsteraClient.Update(ctx, "Customers", shardKey, requestBytes)
 	if err != nil {
 		return nil, err
 	}
@@ -188,24 +1006,24 @@ func (s *LedgerServiceCoreApiMonsteraStub) SettleTransaction(ctx context.Context
 		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
 	}
 
-	response, ok := updateResponse.Response.(*corepb.UpdateResponse_SettleTransactionResponse)
+	response, ok := updateResponse.Response.(*corepb.UpdateResponse_AddCustomerAccountResponse)
 	if ok {
-		return response.SettleTransactionResponse, nilifyIfEmpty(updateResponse.Error)
+		return response.AddCustomerAccountResponse, nilifyIfEmpty(updateResponse.Error)
 	} else {
 		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": updateResponse.String()})
 	}
 }
 
-func (s *LedgerServiceCoreApiMonsteraStub) CreateAccount(ctx context.Context, request *corepb.CreateAccountRequest) (*corepb.CreateAccountResponse, error) {
-	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_CreateAccountRequest{CreateAccountRequest: request}}
+func (s *LedgerServiceCoreApiMonsteraStub) RemoveCustomerAccount(ctx context.Context, request *corepb.RemoveCustomerAccountRequest) (*corepb.RemoveCustomerAccountResponse, error) {
+	updateRequest := &corepb.UpdateRequest{Request: &corepb.UpdateRequest_RemoveCustomerAccountRequest{RemoveCustomerAccountRequest: request}}
 	requestBytes, err := proto.Marshal(updateRequest)
 	if err != nil {
 		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to marshal request", map[string]string{"error": err.Error()})
 	}
 
-	shardKey := s.shardKeyCalculator.CreateAccountShardKey(request)
+	shardKey := s.shardKeyCalculator.RemoveCustomerAccountShardKey(request)
 
-	responseBytes, err := s.monsteraClient.Update(ctx, "Accounts", shardKey, requestBytes)
+	responseBytes, err := s.monsteraClient.Update(ctx, "Customers", shardKey, requestBytes)
 	if err != nil {
 		return nil, err
 	}
@@ -216,9 +1034,9 @@ func (s *LedgerServiceCoreApiMonsteraStub) CreateAccount(ctx context.Context, re
 		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "failed to unmarshal response", map[string]string{"error": err.Error()})
 	}
 
-	response, ok := updateResponse.Response.(*corepb.UpdateResponse_CreateAccountResponse)
+	response, ok := updateResponse.Response.(*corepb.UpdateResponse_RemoveCustomerAccountResponse)
 	if ok {
-		return response.CreateAccountResponse, nilifyIfEmpty(updateResponse.Error)
+		return response.RemoveCustomerAccountResponse, nilifyIfEmpty(updateResponse.Error)
 	} else {
 		return nil, monsterax.NewErrorWithContext(monsterax.Internal, "invalid response type", map[string]string{"response": updateResponse.String()})
 	}
@@ -237,7 +1055,8 @@ func nilifyIfEmpty(err *monsterax.Error) error {
 }
 
 type LedgerServiceCoreApiStandaloneStub struct {
-	accountsCore AccountsCoreApi
+	accountsCore  AccountsCoreApi
+	customersCore CustomersCoreApi
 
 	mu sync.RWMutex
 }
@@ -265,6 +1084,97 @@ func (s *LedgerServiceCoreApiStandaloneStub) GetAccount(ctx context.Context, req
 	return s.accountsCore.GetAccount(request)
 }
 
+func (s *LedgerServiceCoreApiStandaloneStub) GetDispute(ctx context.Context, request *corepb.GetDisputeRequest) (*corepb.GetDisputeResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.accountsCore.GetDispute(request)
+}
+
+func (s *LedgerServiceCoreApiStandaloneStub) ListDisputes(ctx context.Context, request *corepb.ListDisputesRequest) (*corepb.ListDisputesResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.accountsCore.ListDisputes(request)
+}
+
+func (s *LedgerServiceCoreApiStandaloneStub) ListAlertRules(ctx context.Context, request *corepb.ListAlertRulesRequest) (*corepb.ListAlertRulesResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.accountsCore.ListAlertRules(request)
+}
+
+func (s *LedgerServiceCoreApiStandaloneStub) ListTriggeredAlerts(ctx context.Context, request *corepb.ListTriggeredAlertsRequest) (*corepb.ListTriggeredAlertsResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.accountsCore.ListTriggeredAlerts(request)
+}
+
+func (s *LedgerServiceCoreApiStandaloneStub) ReadOutbox(ctx context.Context, request *corepb.ReadOutboxRequest, shardId string) (*corepb.ReadOutboxResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.accountsCore.ReadOutbox(request)
+}
+
+func (s *LedgerServiceCoreApiStandaloneStub) GetSpendingSummary(ctx context.Context, request *corepb.GetSpendingSummaryRequest) (*corepb.GetSpendingSummaryResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.accountsCore.GetSpendingSummary(request)
+}
+
+func (s *LedgerServiceCoreApiStandaloneStub) ListArchivableTransactions(ctx context.Context, request *corepb.ListArchivableTransactionsRequest, shardId string) (*corepb.ListArchivableTransactionsResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.accountsCore.ListArchivableTransactions(request)
+}
+
+func (s *LedgerServiceCoreApiStandaloneStub) ListTransactionRollups(ctx context.Context, request *corepb.ListTransactionRollupsRequest) (*corepb.ListTransactionRollupsResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.accountsCore.ListTransactionRollups(request)
+}
+
+func (s *LedgerServiceCoreApiStandaloneStub) GetShardStats(ctx context.Context, request *corepb.GetShardStatsRequest, shardId string) (*corepb.GetShardStatsResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.accountsCore.GetShardStats(request)
+}
+
+func (s *LedgerServiceCoreApiStandaloneStub) GetAccountFromFollower(ctx context.Context, request *corepb.GetAccountFromFollowerRequest) (*corepb.GetAccountFromFollowerResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.accountsCore.GetAccountFromFollower(request)
+}
+
+func (s *LedgerServiceCoreApiStandaloneStub) ListTransactionsFromFollower(ctx context.Context, request *corepb.ListTransactionsFromFollowerRequest) (*corepb.ListTransactionsFromFollowerResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.accountsCore.ListTransactionsFromFollower(request)
+}
+
+func (s *LedgerServiceCoreApiStandaloneStub) GetEscrow(ctx context.Context, request *corepb.GetEscrowRequest) (*corepb.GetEscrowResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.accountsCore.GetEscrow(request)
+}
+
+func (s *LedgerServiceCoreApiStandaloneStub) ListEscrows(ctx context.Context, request *corepb.ListEscrowsRequest) (*corepb.ListEscrowsResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.accountsCore.ListEscrows(request)
+}
+
 func (s *LedgerServiceCoreApiStandaloneStub) CreateTransaction(ctx context.Context, request *corepb.CreateTransactionRequest) (*corepb.CreateTransactionResponse, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -293,6 +1203,118 @@ func (s *LedgerServiceCoreApiStandaloneStub) CreateAccount(ctx context.Context,
 	return s.accountsCore.CreateAccount(request)
 }
 
-func NewLedgerServiceCoreApiStandaloneStub(accountsCore AccountsCoreApi) *LedgerServiceCoreApiStandaloneStub {
-	return &LedgerServiceCoreApiStandaloneStub{accountsCore: accountsCore}
+func (s *LedgerServiceCoreApiStandaloneStub) OpenDispute(ctx context.Context, request *corepb.OpenDisputeRequest) (*corepb.OpenDisputeResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.accountsCore.OpenDispute(request)
+}
+
+func (s *LedgerServiceCoreApiStandaloneStub) ResolveDispute(ctx context.Context, request *corepb.ResolveDisputeRequest) (*corepb.ResolveDisputeResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.accountsCore.ResolveDispute(request)
+}
+
+func (s *LedgerServiceCoreApiStandaloneStub) SweepPendingAvailability(ctx context.Context, request *corepb.SweepPendingAvailabilityRequest, shardId string) (*corepb.SweepPendingAvailabilityResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.accountsCore.SweepPendingAvailability(request)
+}
+
+func (s *LedgerServiceCoreApiStandaloneStub) CreateAlertRule(ctx context.Context, request *corepb.CreateAlertRuleRequest) (*corepb.CreateAlertRuleResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.accountsCore.CreateAlertRule(request)
+}
+
+func (s *LedgerServiceCoreApiStandaloneStub) DeleteAlertRule(ctx context.Context, request *corepb.DeleteAlertRuleRequest) (*corepb.DeleteAlertRuleResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.accountsCore.DeleteAlertRule(request)
+}
+
+func (s *LedgerServiceCoreApiStandaloneStub) AckAlert(ctx context.Context, request *corepb.AckAlertRequest) (*corepb.AckAlertResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.accountsCore.AckAlert(request)
+}
+
+func (s *LedgerServiceCoreApiStandaloneStub) AckOutbox(ctx context.Context, request *corepb.AckOutboxRequest, shardId string) (*corepb.AckOutboxResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.accountsCore.AckOutbox(request)
+}
+
+func (s *LedgerServiceCoreApiStandaloneStub) ArchiveTransactions(ctx context.Context, request *corepb.ArchiveTransactionsRequest, shardId string) (*corepb.ArchiveTransactionsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.accountsCore.ArchiveTransactions(request)
+}
+
+func (s *LedgerServiceCoreApiStandaloneStub) RebuildShardStats(ctx context.Context, request *corepb.RebuildShardStatsRequest, shardId string) (*corepb.RebuildShardStatsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.accountsCore.RebuildShardStats(request)
+}
+
+func (s *LedgerServiceCoreApiStandaloneStub) HoldEscrow(ctx context.Context, request *corepb.HoldEscrowRequest) (*corepb.HoldEscrowResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.accountsCore.HoldEscrow(request)
+}
+
+func (s *LedgerServiceCoreApiStandaloneStub) ReleaseEscrow(ctx context.Context, request *corepb.ReleaseEscrowRequest) (*corepb.ReleaseEscrowResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.accountsCore.ReleaseEscrow(request)
+}
+
+func (s *LedgerServiceCoreApiStandaloneStub) SweepEscrows(ctx context.Context, request *corepb.SweepEscrowsRequest, shardId string) (*corepb.SweepEscrowsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.accountsCore.SweepEscrows(request)
+}
+
+func (s *LedgerServiceCoreApiStandaloneStub) GetCustomer(ctx context.Context, request *corepb.GetCustomerRequest) (*corepb.GetCustomerResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.customersCore.GetCustomer(request)
+}
+
+func (s *LedgerServiceCoreApiStandaloneStub) CreateCustomer(ctx context.Context, request *corepb.CreateCustomerRequest) (*corepb.CreateCustomerResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.customersCore.CreateCustomer(request)
+}
+
+func (s *LedgerServiceCoreApiStandaloneStub) AddCustomerAccount(ctx context.Context, request *corepb.AddCustomerAccountRequest) (*corepb.AddCustomerAccountResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.customersCore.AddCustomerAccount(request)
+}
+
+func (s *LedgerServiceCoreApiStandaloneStub) RemoveCustomerAccount(ctx context.Context, request *corepb.RemoveCustomerAccountRequest) (*corepb.RemoveCustomerAccountResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.customersCore.RemoveCustomerAccount(request)
+}
+
+func NewLedgerServiceCoreApiStandaloneStub(accountsCore AccountsCoreApi, customersCore CustomersCoreApi) *LedgerServiceCoreApiStandaloneStub {
+	return &LedgerServiceCoreApiStandaloneStub{accountsCore: accountsCore, customersCore: customersCore}
 }
@@ -0,0 +1,105 @@
+package ledger
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/evrblk/monstera-example/ledger/corepb"
+)
+
+const sweepBatchSize = 100
+
+// PendingAvailabilitySweeper periodically releases value-dated topups which became available on all shards
+// of Accounts core. Releasing also happens lazily on every update of an account, the sweeper only makes sure that
+// balances of idle accounts are up to date in storage. The same sweep auto-releases escrows whose deadline has
+// passed.
+type PendingAvailabilitySweeper struct {
+	coreApiClient LedgerServiceCoreApi
+	shardIds      []string
+	interval      time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func (s *PendingAvailabilitySweeper) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				for _, shardId := range s.shardIds {
+					s.sweepShard(ctx, shardId)
+					s.sweepEscrows(ctx, shardId)
+				}
+			}
+		}
+	}()
+}
+
+func (s *PendingAvailabilitySweeper) Stop() {
+	log.Println("Stopping PendingAvailabilitySweeper...")
+
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+}
+
+func (s *PendingAvailabilitySweeper) sweepShard(ctx context.Context, shardId string) {
+	for {
+		now := time.Now()
+
+		resp, err := s.coreApiClient.SweepPendingAvailability(ctx, &corepb.SweepPendingAvailabilityRequest{
+			Now:   now.UnixNano(),
+			Limit: sweepBatchSize,
+		}, shardId)
+		if err != nil {
+			log.Printf("failed to sweep pending availability on shard %s: %v", shardId, err)
+			return
+		}
+
+		if resp.ReleasedTransactions == 0 {
+			return
+		}
+	}
+}
+
+func (s *PendingAvailabilitySweeper) sweepEscrows(ctx context.Context, shardId string) {
+	for {
+		now := time.Now()
+
+		resp, err := s.coreApiClient.SweepEscrows(ctx, &corepb.SweepEscrowsRequest{
+			Now:   now.UnixNano(),
+			Limit: sweepBatchSize,
+		}, shardId)
+		if err != nil {
+			log.Printf("failed to sweep escrows on shard %s: %v", shardId, err)
+			return
+		}
+
+		if resp.ReleasedEscrows == 0 {
+			return
+		}
+	}
+}
+
+func NewPendingAvailabilitySweeper(coreApiClient LedgerServiceCoreApi, shardIds []string, interval time.Duration) *PendingAvailabilitySweeper {
+	return &PendingAvailabilitySweeper{
+		coreApiClient: coreApiClient,
+		shardIds:      shardIds,
+		interval:      interval,
+	}
+}
@@ -4,4 +4,19 @@ var (
 	// Unique table prefixes to avoid collisions between tables while sharding the same instance of BadgerDB store.
 	accountsTableId     = []byte{0x00, 0x00}
 	transactionsTableId = []byte{0x00, 0x01}
+	disputesTableId     = []byte{0x00, 0x02}
+	alertRulesTableId   = []byte{0x00, 0x03}
+	alertsTableId       = []byte{0x00, 0x04}
+	outboxTableId       = []byte{0x00, 0x05}
+	outboxSequenceId    = []byte{0x00, 0x06}
+	spendingTableId     = []byte{0x00, 0x07}
+	rollupsTableId      = []byte{0x00, 0x08}
+	shardStatsTableId   = []byte{0x00, 0x09}
+	escrowsTableId      = []byte{0x00, 0x0a}
+
+	pendingAvailabilityIndexId        = []byte{0x01, 0x00}
+	escrowDeadlineIndexId             = []byte{0x01, 0x01}
+	accountPendingAvailabilityIndexId = []byte{0x01, 0x02}
+
+	customersTableId = []byte{0x02, 0x00}
 )
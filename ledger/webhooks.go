@@ -0,0 +1,226 @@
+package ledger
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/evrblk/monstera-example/ledger/corepb"
+	"github.com/evrblk/monstera-example/ledger/gatewaypb"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	dispatchBatchSize = 100
+
+	webhookMinBackoff = 100 * time.Millisecond
+	webhookMaxBackoff = 30 * time.Second
+	webhookTimeout    = 10 * time.Second
+
+	WebhookEventIdHeader   = "X-Ledger-Event-Id"
+	WebhookTimestampHeader = "X-Ledger-Timestamp"
+	WebhookSignatureHeader = "X-Ledger-Signature"
+)
+
+// Webhook is a registered receiver of outbox events. An empty list of event types means all events.
+type Webhook struct {
+	URL        string   `json:"url"`
+	Secret     string   `json:"secret"`
+	EventTypes []string `json:"event_types"`
+}
+
+func (w *Webhook) accepts(eventType string) bool {
+	return len(w.EventTypes) == 0 || slices.Contains(w.EventTypes, eventType)
+}
+
+// LoadWebhooks reads a JSON array of webhooks from a file.
+func LoadWebhooks(path string) ([]*Webhook, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var webhooks []*Webhook
+	err = json.Unmarshal(data, &webhooks)
+	if err != nil {
+		return nil, err
+	}
+
+	return webhooks, nil
+}
+
+// WebhookDispatcher periodically drains outboxes of all shards of Accounts core and POSTs every record as
+// gatewaypb.WebhookEvent JSON to all webhooks accepting its type. Records are acknowledged only after every
+// webhook has received them, so delivery is at-least-once: a failing webhook is retried with exponential backoff
+// and holds back all further events of the shard until it succeeds.
+type WebhookDispatcher struct {
+	coreApiClient LedgerServiceCoreApi
+	shardIds      []string
+	webhooks      []*Webhook
+	interval      time.Duration
+	httpClient    *http.Client
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func (d *WebhookDispatcher) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	d.cancel = cancel
+
+	for _, shardId := range d.shardIds {
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+
+			ticker := time.NewTicker(d.interval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					d.drainShard(ctx, shardId)
+				}
+			}
+		}()
+	}
+}
+
+func (d *WebhookDispatcher) Stop() {
+	log.Println("Stopping WebhookDispatcher...")
+
+	if d.cancel != nil {
+		d.cancel()
+	}
+	d.wg.Wait()
+}
+
+func (d *WebhookDispatcher) drainShard(ctx context.Context, shardId string) {
+	for {
+		resp1, err := d.coreApiClient.ReadOutbox(ctx, &corepb.ReadOutboxRequest{
+			Limit: dispatchBatchSize,
+		}, shardId)
+		if err != nil {
+			log.Printf("failed to read outbox on shard %s: %v", shardId, err)
+			return
+		}
+
+		if len(resp1.Records) == 0 {
+			return
+		}
+
+		for _, record := range resp1.Records {
+			err = d.dispatch(ctx, outboxRecordToWebhookEvent(shardId, record))
+			if err != nil {
+				// stopping, records will be delivered again after restart
+				return
+			}
+		}
+
+		_, err = d.coreApiClient.AckOutbox(ctx, &corepb.AckOutboxRequest{
+			UpToId: resp1.Records[len(resp1.Records)-1].Id,
+		}, shardId)
+		if err != nil {
+			log.Printf("failed to ack outbox on shard %s: %v", shardId, err)
+			return
+		}
+	}
+}
+
+// dispatch delivers an event to all webhooks accepting it. It returns an error only if ctx is done.
+func (d *WebhookDispatcher) dispatch(ctx context.Context, event *gatewaypb.WebhookEvent) error {
+	body, err := protojson.Marshal(event)
+	panicIfNotNil(err)
+
+	for _, webhook := range d.webhooks {
+		if !webhook.accepts(event.Type) {
+			continue
+		}
+
+		backoff := webhookMinBackoff
+		for {
+			err = d.deliver(ctx, webhook, event.Id, body)
+			if err == nil {
+				break
+			}
+
+			log.Printf("failed to deliver event %s to %s: %v", event.Id, webhook.URL, err)
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff):
+			}
+
+			backoff = min(backoff*2, webhookMaxBackoff)
+		}
+	}
+
+	return nil
+}
+
+func (d *WebhookDispatcher) deliver(ctx context.Context, webhook *Webhook, eventId string, body []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventIdHeader, eventId)
+	req.Header.Set(WebhookTimestampHeader, timestamp)
+	req.Header.Set(WebhookSignatureHeader, SignWebhook(webhook.Secret, timestamp, body))
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// SignWebhook returns a signature of a webhook body, which is sent in X-Ledger-Signature header. It is
+// "sha256=" followed by hex encoded HMAC-SHA256 of "<timestamp>.<body>" with the webhook secret.
+func SignWebhook(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhookSignature checks a signature of a received webhook in constant time.
+func VerifyWebhookSignature(secret string, timestamp string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(SignWebhook(secret, timestamp, body)), []byte(signature))
+}
+
+func NewWebhookDispatcher(coreApiClient LedgerServiceCoreApi, shardIds []string, webhooks []*Webhook, interval time.Duration) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		coreApiClient: coreApiClient,
+		shardIds:      shardIds,
+		webhooks:      webhooks,
+		interval:      interval,
+		httpClient:    &http.Client{},
+	}
+}
@@ -0,0 +1,141 @@
+package ledger
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/evrblk/monstera-example/ledger/corepb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookDispatcher(t *testing.T) {
+	require := require.New(t)
+
+	accountsCore := newAccountsCore()
+	coreApiClient := NewLedgerServiceCoreApiStandaloneStub(accountsCore, newCustomersCore())
+
+	now := time.Now()
+
+	accountId := rand.Uint64()
+
+	_, err := accountsCore.CreateAccount(&corepb.CreateAccountRequest{
+		AccountId: accountId,
+		Now:       now.UnixNano(),
+	})
+	require.NoError(err)
+
+	response1, err := accountsCore.CreateTransaction(&corepb.CreateTransactionRequest{
+		TransactionId: &corepb.TransactionId{
+			AccountId:     accountId,
+			TransactionId: rand.Uint64(),
+		},
+		Now:     now.UnixNano(),
+		Amount:  100,
+		Settled: true,
+	})
+	require.NoError(err)
+
+	// receiver fails the first delivery, then accepts everything
+	var mu sync.Mutex
+	attempts := 0
+	received := make([]map[string]any, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		body, _ := io.ReadAll(r.Body)
+		if !VerifyWebhookSignature("secret", r.Header.Get(WebhookTimestampHeader), body, r.Header.Get(WebhookSignatureHeader)) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		var event map[string]any
+		_ = json.Unmarshal(body, &event)
+		event["header_id"] = r.Header.Get(WebhookEventIdHeader)
+		received = append(received, event)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	dispatcher := NewWebhookDispatcher(coreApiClient, []string{"shard1"}, []*Webhook{
+		{URL: server.URL, Secret: "secret", EventTypes: []string{"transaction.created"}},
+	}, time.Second)
+
+	dispatcher.drainShard(context.Background(), "shard1")
+
+	// only the matching event is delivered, after one retry
+	require.Equal(2, attempts)
+	require.Len(received, 1)
+	require.Equal("transaction.created", received[0]["type"])
+	require.Equal("shard1-2", received[0]["id"])
+	require.Equal("shard1-2", received[0]["header_id"])
+	transaction := received[0]["transaction"].(map[string]any)
+	require.Equal(EncodeTransactionId(response1.Transaction.Id), transaction["id"])
+
+	// outbox is acknowledged
+	response2, err := accountsCore.ReadOutbox(&corepb.ReadOutboxRequest{
+		Limit: 10,
+	})
+	require.NoError(err)
+	require.Empty(response2.Records)
+}
+
+func TestWebhookDispatcherDoesNotAckOnStop(t *testing.T) {
+	require := require.New(t)
+
+	accountsCore := newAccountsCore()
+	coreApiClient := NewLedgerServiceCoreApiStandaloneStub(accountsCore, newCustomersCore())
+
+	_, err := accountsCore.CreateAccount(&corepb.CreateAccountRequest{
+		AccountId: rand.Uint64(),
+		Now:       time.Now().UnixNano(),
+	})
+	require.NoError(err)
+
+	// receiver is always down
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	dispatcher := NewWebhookDispatcher(coreApiClient, []string{"shard1"}, []*Webhook{
+		{URL: server.URL, Secret: "secret"},
+	}, time.Second)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	dispatcher.drainShard(ctx, "shard1")
+
+	// record is kept for redelivery
+	response1, err := accountsCore.ReadOutbox(&corepb.ReadOutboxRequest{
+		Limit: 10,
+	})
+	require.NoError(err)
+	require.Len(response1.Records, 1)
+}
+
+func TestWebhookSignature(t *testing.T) {
+	require := require.New(t)
+
+	body := []byte(`{"id":"shard1-1"}`)
+	signature := SignWebhook("secret", "1700000000", body)
+
+	require.True(VerifyWebhookSignature("secret", "1700000000", body, signature))
+	require.False(VerifyWebhookSignature("other", "1700000000", body, signature))
+	require.False(VerifyWebhookSignature("secret", "1700000001", body, signature))
+	require.False(VerifyWebhookSignature("secret", "1700000000", []byte(`{"id":"shard1-2"}`), signature))
+}